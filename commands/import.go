@@ -0,0 +1,161 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/conf"
+	"github.com/hiltpold/lakelandcup-fantasy-service/importer"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// importOptions are the flags of the import commands
+type importOptions struct {
+	file    string
+	format  string
+	columns []string
+	dryRun  bool
+}
+
+var importFlags = importOptions{}
+
+// rankingFlags name the imported ranking
+var rankingFlags = struct {
+	source string
+	year   int
+}{}
+
+// standingFlags name the season of the imported standings
+var standingFlags = struct {
+	league string
+	year   int
+}{}
+
+var importCmd = cobra.Command{
+	Use:  "import",
+	Long: "Import data from local csv or json files",
+}
+
+var importStatsCmd = cobra.Command{
+	Use:  "stats",
+	Long: "Import prospect season stats, rows are matched to prospects by id, nhl draft or name and birthdate",
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runImport(cmd, importer.StatsFields, importer.ImportStats)
+	},
+}
+
+var importProspectsCmd = cobra.Command{
+	Use:  "prospects",
+	Long: "Import an nhl draft class or prospect corrections, rows that match no prospect are inserted and the others update the matched prospect",
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runImport(cmd, importer.ProspectFields, importer.ImportProspects)
+	},
+}
+
+var importRankingsCmd = cobra.Command{
+	Use:  "rankings",
+	Long: "Import the prospect ranking of a source for a draft, it replaces the source's earlier ranking for the draft",
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runImport(cmd, importer.RankingFields, func(ctx context.Context, r storage.Repository, records []importer.Record, dryRun bool) (*importer.Report, error) {
+			return importer.ImportRankings(ctx, r, records, rankingFlags.source, rankingFlags.year, dryRun)
+		})
+	},
+}
+
+var importStandingsCmd = cobra.Command{
+	Use:  "standings",
+	Long: "Import the standings of a league's season, rows are matched to franchises by id or name and replace the season's standings",
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		leagueID, err := uuid.Parse(standingFlags.league)
+		if err != nil {
+			logrus.Fatal("Invalid league id: ", err)
+		}
+		runImport(cmd, importer.StandingFields, func(ctx context.Context, r storage.Repository, records []importer.Record, dryRun bool) (*importer.Report, error) {
+			return importer.ImportStandings(ctx, r, records, leagueID, standingFlags.year, dryRun)
+		})
+	},
+}
+
+// addImportFlags adds the shared flags to the import commands
+func addImportFlags(cmds ...*cobra.Command) {
+	for _, cmd := range cmds {
+		cmd.Flags().StringVarP(&importFlags.file, "file", "f", "", "the file to import")
+		cmd.Flags().StringVar(&importFlags.format, "format", "", "csv or json, the file extension if unset")
+		cmd.Flags().StringSliceVar(&importFlags.columns, "map", nil, "field=column mappings for columns with other names")
+		cmd.Flags().BoolVar(&importFlags.dryRun, "dry-run", false, "report the changes without saving them")
+		cmd.MarkFlagRequired("file")
+	}
+}
+
+type importFunc func(ctx context.Context, r storage.Repository, records []importer.Record, dryRun bool) (*importer.Report, error)
+
+func runImport(cmd *cobra.Command, fields importer.Fields, run importFunc) {
+	records, err := readImport(fields)
+	if err != nil {
+		logrus.Fatal("Unable to read import file: ", err)
+	}
+	runWithConfig(cmd, func(c *conf.Configuration) {
+		report, err := run(context.Background(), storage.Dial(&c.DB), records, importFlags.dryRun)
+		if err != nil {
+			logrus.Fatal("Import failed: ", err)
+		}
+		printReport(report)
+		if len(report.Errors) > 0 {
+			os.Exit(1)
+		}
+	})
+}
+
+func readImport(fields importer.Fields) ([]importer.Record, error) {
+	format, err := importer.ParseFormat(importFlags.format, importFlags.file)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := importer.ParseColumns(importFlags.columns)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(importFlags.file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return importer.Read(f, format, fields, columns)
+}
+
+func printReport(report *importer.Report) {
+	counts := map[importer.Action]int{}
+	for _, c := range report.Changes {
+		counts[c.Action]++
+		fmt.Printf("line %d: %s %s (%s)\n", c.Line, c.Action, c.Description, c.ID)
+		for _, d := range c.Diff {
+			fmt.Printf("    %s\n", d)
+		}
+	}
+	for _, e := range report.Errors {
+		fmt.Printf("%v\n", e)
+	}
+	if ids := report.IDs(importer.Insert); len(ids) > 0 {
+		fmt.Printf("inserted: %s\n", strings.Join(ids, ","))
+	}
+	if ids := report.IDs(importer.Update); len(ids) > 0 {
+		fmt.Printf("updated: %s\n", strings.Join(ids, ","))
+	}
+	summary := fmt.Sprintf("%d inserted, %d updated, %d unchanged, %d failed", counts[importer.Insert], counts[importer.Update], counts[importer.Unchanged], len(report.Errors))
+	if counts[importer.Delete] > 0 {
+		summary += fmt.Sprintf(", %d deleted", counts[importer.Delete])
+	}
+	if report.DryRun {
+		summary += ", dry run: nothing was saved"
+	}
+	fmt.Println(summary)
+}
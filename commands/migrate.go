@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hiltpold/lakelandcup-fantasy-service/conf"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var migrateCmd = cobra.Command{
+	Use:  "migrate",
+	Long: "Migrate the database schema",
+}
+
+var migrateUpCmd = cobra.Command{
+	Use:  "up",
+	Long: "Apply all pending migrations",
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runWithMigrator(cmd, func(m *storage.Migrator) error {
+			return m.Up(context.Background())
+		})
+	},
+}
+
+var migrateDownCmd = cobra.Command{
+	Use:  "down",
+	Long: "Revert the last applied migration",
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runWithMigrator(cmd, func(m *storage.Migrator) error {
+			return m.Down(context.Background())
+		})
+	},
+}
+
+var migrateToCmd = cobra.Command{
+	Use:  "to <version>",
+	Long: "Migrate up or down to the version, 0 reverts all migrations",
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		version, err := strconv.Atoi(args[0])
+		if err != nil {
+			logrus.Fatalf("Invalid migration version %q", args[0])
+		}
+		runWithMigrator(cmd, func(m *storage.Migrator) error {
+			return m.To(context.Background(), version)
+		})
+	},
+}
+
+var migrateStatusCmd = cobra.Command{
+	Use:  "status",
+	Long: "Show applied and pending migrations",
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runWithMigrator(cmd, showMigrationStatus)
+	},
+}
+
+func runWithMigrator(cmd *cobra.Command, fn func(m *storage.Migrator) error) {
+	runWithConfig(cmd, func(c *conf.Configuration) {
+		db, err := storage.Open(&c.DB).DB()
+		if err != nil {
+			logrus.Fatal("Unable to get database connection: ", err)
+		}
+		defer db.Close()
+
+		m, err := storage.NewMigrator(db)
+		if err != nil {
+			logrus.Fatal("Unable to load migrations: ", err)
+		}
+		if err := fn(m); err != nil {
+			logrus.Fatal("Migration failed: ", err)
+		}
+	})
+}
+
+func showMigrationStatus(m *storage.Migrator) error {
+	status, err := m.Status(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, s := range status {
+		applied := "pending"
+		if s.AppliedAt != nil {
+			applied = s.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Printf("%04d %-30s %s\n", s.Version, s.Name, applied)
+	}
+	return nil
+}
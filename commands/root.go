@@ -19,7 +19,18 @@ var rootCmd = cobra.Command{
 // RootCommand will setup and return the root command
 func RootCommand() *cobra.Command {
 	rootCmd.PersistentFlags().StringVarP(&configFile, "config", "c", "", "the config file to use")
-	rootCmd.AddCommand(&serveCmd, &versionCmd)
+	migrateCmd.AddCommand(&migrateUpCmd, &migrateDownCmd, &migrateToCmd, &migrateStatusCmd)
+	addImportFlags(&importProspectsCmd, &importStatsCmd, &importRankingsCmd, &importStandingsCmd)
+	importRankingsCmd.Flags().StringVar(&rankingFlags.source, "source", "", "the name of the ranking's source")
+	importRankingsCmd.Flags().IntVar(&rankingFlags.year, "year", 0, "the year of the draft the ranking is for")
+	importRankingsCmd.MarkFlagRequired("source")
+	importRankingsCmd.MarkFlagRequired("year")
+	importStandingsCmd.Flags().StringVar(&standingFlags.league, "league", "", "the id of the league")
+	importStandingsCmd.Flags().IntVar(&standingFlags.year, "year", 0, "the year of the draft that ends the season")
+	importStandingsCmd.MarkFlagRequired("league")
+	importStandingsCmd.MarkFlagRequired("year")
+	importCmd.AddCommand(&importProspectsCmd, &importStatsCmd, &importRankingsCmd, &importStandingsCmd)
+	rootCmd.AddCommand(&serveCmd, &migrateCmd, &importCmd, &versionCmd)
 	return &rootCmd
 }
 
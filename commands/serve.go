@@ -3,11 +3,14 @@ package commands
 import (
 	"fmt"
 	"net"
+	"strconv"
+	"strings"
 
 	"github.com/hiltpold/lakelandcup-fantasy-service/conf"
 	api "github.com/hiltpold/lakelandcup-fantasy-service/service"
 	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
 	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+	"github.com/hiltpold/lakelandcup-fantasy-service/utils"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
@@ -36,6 +39,14 @@ func serve(c *conf.Configuration) {
 
 	s := api.Server{
 		R: h,
+		Jwt: &utils.JwtWrapper{
+			TokenKey:        c.API.TokenSecretKey,
+			TokenExpires:    c.API.TokenExpires,
+			Issuer:          c.API.App,
+			ExpirationHours: c.API.TokenExpires,
+		},
+		Mail:   api.SendGridMailer{Key: c.Mail.SGSecretKey},
+		Values: valueChart(&c.Values),
 	}
 
 	grpcServer := grpc.NewServer()
@@ -46,3 +57,26 @@ func serve(c *conf.Configuration) {
 		logrus.Fatalln("Failed to serve:", err)
 	}
 }
+
+// valueChart is the default pick value chart with the configured values
+func valueChart(c *conf.ValueConfiguration) *api.ValueChart {
+	chart := api.DefaultValueChart()
+	if c.PickValues != "" {
+		values := []float64{}
+		for _, v := range strings.Split(c.PickValues, ",") {
+			value, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				logrus.Fatal("Invalid PICK_VALUES: ", err)
+			}
+			values = append(values, value)
+		}
+		chart.Picks = values
+	}
+	if c.FutureDecay != 0 {
+		chart.FutureDecay = c.FutureDecay
+	}
+	if c.UnknownDiscount != 0 {
+		chart.UnknownDiscount = c.UnknownDiscount
+	}
+	return chart
+}
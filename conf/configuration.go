@@ -42,11 +42,19 @@ type MailConfiguration struct {
 	SGSecretKey string `mapstructure:"SENDGRID_KEY"`
 }
 
+// Pick value chart of the trade evaluation, unset values keep the defaults
+type ValueConfiguration struct {
+	PickValues      string  `mapstructure:"PICK_VALUES"`
+	FutureDecay     float64 `mapstructure:"PICK_VALUE_FUTURE_DECAY"`
+	UnknownDiscount float64 `mapstructure:"PICK_VALUE_UNKNOWN_DISCOUNT"`
+}
+
 // Configuration holds the api configuration
 type Configuration struct {
-	API  ApiConfiguration      `mapstructure:",squash"`
-	DB   PostgresConfiguration `mapstructure:",squash"`
-	Mail MailConfiguration     `mapstructure:",squash"`
+	API    ApiConfiguration      `mapstructure:",squash"`
+	DB     PostgresConfiguration `mapstructure:",squash"`
+	Mail   MailConfiguration     `mapstructure:",squash"`
+	Values ValueConfiguration    `mapstructure:",squash"`
 }
 
 // Load the environment set with the environment file
@@ -0,0 +1,132 @@
+package importer
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+// DefaultDuplicateSimilarity is the name similarity from which prospects are reported as duplicates.
+const DefaultDuplicateSimilarity = 0.6
+
+// Duplicate is a pair of prospects that are probably the same player, First is the older record.
+type Duplicate struct {
+	First      models.Prospect
+	Second     models.Prospect
+	Similarity float64
+	Reason     string
+}
+
+// FindDuplicates reports the prospects of the draft class, 0 for all, whose normalized names are at least
+// minSimilarity alike and whose birthdates don't contradict, the most similar pairs first.
+func FindDuplicates(ctx context.Context, r storage.Repository, nhlDraftYear int, minSimilarity float64) ([]Duplicate, error) {
+	prospects, err := draftClass(ctx, r, nhlDraftYear)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(prospects))
+	for i, p := range prospects {
+		names[i] = NormalizeName(p.FullName)
+	}
+
+	duplicates := []Duplicate{}
+	for i := range prospects {
+		for j := i + 1; j < len(prospects); j++ {
+			if names[i] == "" || names[j] == "" {
+				continue
+			}
+			similarity := storage.Similarity(names[i], names[j])
+			if names[i] == names[j] {
+				similarity = 1
+			}
+			if similarity < minSimilarity {
+				continue
+			}
+			reason, ok := birthdates(prospects[i].Birthdate, prospects[j].Birthdate)
+			if !ok {
+				continue
+			}
+			if similarity == 1 {
+				reason = "same name, " + reason
+			} else {
+				reason = "similar name, " + reason
+			}
+			first, second := prospects[i], prospects[j]
+			if older(second, first) {
+				first, second = second, first
+			}
+			duplicates = append(duplicates, Duplicate{First: first, Second: second, Similarity: similarity, Reason: reason})
+		}
+	}
+	sort.SliceStable(duplicates, func(i, j int) bool {
+		if duplicates[i].Similarity != duplicates[j].Similarity {
+			return duplicates[i].Similarity > duplicates[j].Similarity
+		}
+		return older(duplicates[i].First, duplicates[j].First)
+	})
+	return duplicates, nil
+}
+
+// older orders the prospects by creation, then name, the older one is usually kept
+func older(a, b models.Prospect) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.Before(b.CreatedAt)
+	}
+	return a.FullName < b.FullName
+}
+
+// birthdates tells whether the birthdates can be of the same player, sources swapping day and month included
+func birthdates(a, b *time.Time) (string, bool) {
+	switch {
+	case a == nil || b == nil:
+		return "birthdate unknown", true
+	case sameDay(*a, *b):
+		return "same birthdate", true
+	case a.Year() == b.Year() && int(a.Month()) == b.Day() && a.Day() == int(b.Month()):
+		return "birthdate with day and month swapped", true
+	}
+	return "", false
+}
+
+func sameDay(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
+
+// FillProspect sets the empty values of the kept prospect to the values of the dropped duplicate and returns the changes.
+func FillProspect(keep *models.Prospect, drop models.Prospect) []string {
+	fill := drop
+	if keep.FullName != "" {
+		fill.FullName = ""
+	}
+	if keep.FirstName != "" {
+		fill.FirstName = ""
+	}
+	if keep.LastName != "" {
+		fill.LastName = ""
+	}
+	if keep.NhlTeam != "" {
+		fill.NhlTeam = ""
+	}
+	if keep.PositionCode != "" {
+		fill.PositionCode = ""
+	}
+	if keep.Birthdate != nil {
+		fill.Birthdate = nil
+	}
+	for _, field := range []struct{ keep, fill *int }{
+		{&keep.Height, &fill.Height},
+		{&keep.Weight, &fill.Weight},
+		{&keep.NhlDraftYear, &fill.NhlDraftYear},
+		{&keep.NhlDraftRound, &fill.NhlDraftRound},
+		{&keep.NhlDraftPickOverall, &fill.NhlDraftPickOverall},
+		{&keep.NhlDraftPickInRound, &fill.NhlDraftPickInRound},
+	} {
+		if *field.keep != 0 {
+			*field.fill = 0
+		}
+	}
+	return MergeProspect(keep, fill)
+}
@@ -0,0 +1,469 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+func date(value string) *time.Time {
+	d, _ := ParseDate(value)
+	return d
+}
+
+func TestRead(t *testing.T) {
+	csv := "Player,DOB,Season,League,Team,GP,G,A,TP,PIM,Club Notes\n" +
+		"Max Muster,2005-03-01,2023-24,OHL,Kitchener,68,30,41,71,22,captain\n"
+	records, err := Read(strings.NewReader(csv), CSV, StatsFields, nil)
+	if err != nil || len(records) != 1 {
+		t.Fatalf("Reading csv failed: %v %v", err, records)
+	}
+	r := records[0]
+	if r.Line != 2 || r.Get("name") != "Max Muster" || r.Get("birthdate") != "2005-03-01" || r.Get("pts") != "71" || r.Get("level") != "OHL" {
+		t.Errorf("Record %+v not as expected", r)
+	}
+	if _, ok := r.Values["Club Notes"]; ok || len(r.Values) != 10 {
+		t.Errorf("Unknown columns were read: %v", r.Values)
+	}
+
+	json := `[{"prospect": "Max Muster", "year": "2023-24", "lg": "OHL", "team": "Kitchener", "gp": 68, "sv%": null}]`
+	columns, err := ParseColumns([]string{"name=prospect", "season=year"})
+	if err != nil {
+		t.Fatalf("Parsing columns failed: %v", err)
+	}
+	records, err = Read(strings.NewReader(json), JSON, StatsFields, columns)
+	if err != nil || len(records) != 1 {
+		t.Fatalf("Reading json failed: %v %v", err, records)
+	}
+	if r := records[0]; r.Line != 1 || r.Get("name") != "Max Muster" || r.Get("season") != "2023-24" || r.Get("gp") != "68" || r.Get("sv_pct") != "" {
+		t.Errorf("Record %+v not as expected", r)
+	}
+
+	if _, err := Read(strings.NewReader(json), JSON, StatsFields, Columns{"salary": "cap"}); err == nil {
+		t.Error("Unknown fields must not be mapped")
+	}
+	if _, err := ParseFormat("", "stats.xlsx"); err == nil {
+		t.Error("Unknown formats must fail")
+	}
+	if f, _ := ParseFormat("", "stats.JSON"); f != JSON {
+		t.Errorf("Format %q not equal to expected %q", f, JSON)
+	}
+}
+
+func TestParse(t *testing.T) {
+	for value, expected := range map[string]int{"2023": 2023, "2023-24": 2023, "2023-2024": 2023, "2023/24": 2023, "20232024": 2023, "1999-00": 1999} {
+		if season, err := ParseSeason(value); err != nil || season != expected {
+			t.Errorf("Season %q is %d, expected %d: %v", value, season, expected, err)
+		}
+	}
+	for _, value := range []string{"2023-25", "23-24", "last season"} {
+		if _, err := ParseSeason(value); err == nil {
+			t.Errorf("Season %q must be invalid", value)
+		}
+	}
+	if label := SeasonLabel(1999); label != "1999-00" {
+		t.Errorf("Label %q not equal to expected %q", label, "1999-00")
+	}
+
+	for _, value := range []string{"2005-03-01", "01.03.2005", "03/01/2005", "20050301", "2005-03-01T00:00:00Z"} {
+		if d, err := ParseDate(value); err != nil || d.Format("2006-01-02") != "2005-03-01" {
+			t.Errorf("Date %q is %v: %v", value, d, err)
+		}
+	}
+
+	for name, expected := range map[string]string{"Jiří  Kulich": "jiri kulich", "Muster, Max": "max muster", "Jean-Luc O'Neil": "jean luc o neil"} {
+		if n := NormalizeName(name); n != expected {
+			t.Errorf("Name %q normalized to %q, expected %q", name, n, expected)
+		}
+	}
+}
+
+func TestMatchProspect(t *testing.T) {
+	ctx := context.Background()
+	r := storage.NewMemoryRepository()
+	prospects := []models.Prospect{
+		{FullName: "Jiří Kulich", NhlTeam: "BUF", NhlDraftYear: 2022, NhlDraftPickOverall: 28, Birthdate: date("2004-04-14")},
+		{FullName: "Elias Pettersson", NhlTeam: "VAN", NhlDraftYear: 2017, NhlDraftPickOverall: 5, Birthdate: date("1998-11-12")},
+		{FullName: "Elias Pettersson", NhlTeam: "VAN", NhlDraftYear: 2022, NhlDraftPickOverall: 80, Birthdate: date("2004-02-16")},
+		{FullName: "Max Muster", NhlTeam: "EDM"},
+		{FullName: "Max Muster", NhlTeam: "CGY"},
+	}
+	if err := r.CreateProspects(ctx, prospects); err != nil {
+		t.Fatalf("Creating prospects failed: %v", err)
+	}
+	var tests = []struct {
+		key      ProspectKey
+		expected string
+		err      error
+	}{
+		{ProspectKey{FullName: "Jiri Kulich"}, "Jiří Kulich BUF 2022", nil},
+		{ProspectKey{FullName: "KULICH, Jiri", Birthdate: date("2004-04-14")}, "Jiří Kulich BUF 2022", nil},
+		{ProspectKey{FullName: "Jiri Kulich", Birthdate: date("2004-04-15")}, "", ErrNoMatch},
+		{ProspectKey{NhlDraftYear: 2022, NhlDraftPickOverall: 28}, "Jiří Kulich BUF 2022", nil},
+		{ProspectKey{FullName: "Elias Pettersson"}, "", ErrAmbiguous},
+		{ProspectKey{FullName: "Elias Pettersson", Birthdate: date("2004-02-16")}, "Elias Pettersson VAN 2022", nil},
+		{ProspectKey{FullName: "Elias Pettersson", NhlDraftYear: 2017}, "Elias Pettersson VAN 2017", nil},
+		{ProspectKey{FullName: "Max Muster", NhlTeam: "cgy"}, "Max Muster CGY 0", nil},
+		{ProspectKey{FullName: "Moritz Muster"}, "", ErrNoMatch},
+	}
+	for _, test := range tests {
+		p, err := MatchProspect(ctx, r, test.key)
+		if test.err != nil {
+			if !errors.Is(err, test.err) {
+				t.Errorf("Key %+v: error %v not equal to expected %v", test.key, err, test.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Key %+v: matching failed: %v", test.key, err)
+			continue
+		}
+		if description := fmt.Sprintf("%s %s %d", p.FullName, p.NhlTeam, p.NhlDraftYear); description != test.expected {
+			t.Errorf("Key %+v matched %q, expected %q", test.key, description, test.expected)
+		}
+	}
+}
+
+func TestImportStats(t *testing.T) {
+	ctx := context.Background()
+	r := storage.NewMemoryRepository()
+	if err := r.CreateProspects(ctx, []models.Prospect{{FullName: "Max Muster", PositionCode: models.Goalie}}); err != nil {
+		t.Fatalf("Creating prospects failed: %v", err)
+	}
+	csv := "name,season,league,team,gp,g,a,pim,sv%,gaa\n" +
+		"Max Muster,2023-24,OHL,Kitchener,40,0,2,4,91.5,\"2,71\"\n" +
+		"Max Muster,2023-24,WJC,Germany,5,0,0,0,.901,3.1\n" +
+		"Moritz Muster,2023-24,OHL,Kitchener,1,0,0,0,,\n" +
+		"Max Muster,2023-24,,Kitchener,1,0,0,0,,\n"
+	records, err := Read(strings.NewReader(csv), CSV, StatsFields, nil)
+	if err != nil {
+		t.Fatalf("Reading csv failed: %v", err)
+	}
+
+	// a dry run saves nothing
+	report, err := ImportStats(ctx, r, records, true)
+	if err != nil || len(report.IDs(Insert)) != 2 || len(report.Errors) != 2 {
+		t.Fatalf("Report %+v not as expected: %v", report, err)
+	}
+	if stats, _ := r.ListProspectStats(ctx, storage.StatsFilter{}); len(stats) != 0 {
+		t.Errorf("Dry run saved %v", stats)
+	}
+	if report.Errors[0].Line != 4 || !errors.Is(report.Errors[0].Err, ErrNoMatch) || report.Errors[1].Line != 5 {
+		t.Errorf("Errors %v not as expected", report.Errors)
+	}
+
+	if _, err := ImportStats(ctx, r, records, false); err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	stats, _ := r.ListProspectStats(ctx, storage.StatsFilter{})
+	if len(stats) != 2 || stats[0].Level != "OHL" || stats[0].Points != 2 || *stats[0].SavePercentage != 0.915 || *stats[0].GoalsAgainstAverage != 2.71 {
+		t.Fatalf("Stats %+v not as expected", stats)
+	}
+
+	// importing again updates the lines of the same season, level and team
+	update := []Record{{Line: 2, Values: map[string]string{"name": "Max Muster", "season": "2023", "level": "ohl", "team": "KITCHENER", "gp": "41", "g": "0", "a": "2", "pim": "4", "sv_pct": "0.915", "gaa": "2.71"}}}
+	report, err = ImportStats(ctx, r, update, false)
+	if err != nil || len(report.Changes) != 1 || report.Changes[0].Action != Update || strings.Join(report.Changes[0].Diff, ";") != "gp: 40 -> 41" {
+		t.Fatalf("Report %+v not as expected: %v", report, err)
+	}
+	report, _ = ImportStats(ctx, r, update, false)
+	if report.Changes[0].Action != Unchanged {
+		t.Errorf("Action %q not equal to expected %q", report.Changes[0].Action, Unchanged)
+	}
+	if stats, _ := r.ListProspectStats(ctx, storage.StatsFilter{}); len(stats) != 2 || stats[0].GamesPlayed != 41 || stats[0].Team != "Kitchener" {
+		t.Errorf("Stats %+v not as expected", stats)
+	}
+}
+
+func TestImportProspects(t *testing.T) {
+	ctx := context.Background()
+	r := storage.NewMemoryRepository()
+	csv := "Player,DOB,Team,Pos,Ht,Wt,Year,Rd,Pick,Ovr\n" +
+		"Max Muster,2006-03-01,EDM,LW,\"6' 1\"\"\",190,2024,1,5,5\n" +
+		"\"Muster, Moritz\",12.07.2006,CGY,RD,74,201,2024,1,9,9\n" +
+		"Mia Muster,2006-01-01,CGY,X,70,150,2024,2,1,33\n" +
+		",,CGY,C,70,150,,,,\n"
+	records, err := Read(strings.NewReader(csv), CSV, ProspectFields, nil)
+	if err != nil {
+		t.Fatalf("Reading csv failed: %v", err)
+	}
+
+	report, err := ImportProspects(ctx, r, records, true)
+	if err != nil || len(report.IDs(Insert)) != 2 || len(report.Errors) != 2 {
+		t.Fatalf("Report %+v not as expected: %v", report, err)
+	}
+	if prospects, _, _ := r.ListProspects(ctx, storage.ProspectFilter{}, storage.Page{}); len(prospects) != 0 {
+		t.Errorf("Dry run saved %v", prospects)
+	}
+
+	report, err = ImportProspects(ctx, r, records, false)
+	if err != nil || len(report.IDs(Insert)) != 2 {
+		t.Fatalf("Report %+v not as expected: %v", report, err)
+	}
+	max, err := MatchProspect(ctx, r, ProspectKey{NhlDraftYear: 2024, NhlDraftPickOverall: 5})
+	if err != nil || max.ID.String() != report.IDs(Insert)[0] {
+		t.Fatalf("Prospect %+v not as expected: %v", max, err)
+	}
+	if max.FirstName != "Max" || max.LastName != "Muster" || max.PositionCode != models.LeftWing || max.Height != 73 || max.NhlDraftRound != 1 || max.NhlTeam != "EDM" {
+		t.Errorf("Prospect %+v not as expected", max)
+	}
+	moritz, err := MatchProspect(ctx, r, ProspectKey{FullName: "Moritz Muster"})
+	if err != nil || moritz.FullName != "Moritz Muster" || moritz.PositionCode != models.Defenseman || moritz.Birthdate.Format("2006-01-02") != "2006-07-12" {
+		t.Errorf("Prospect %+v not as expected: %v", moritz, err)
+	}
+
+	// corrections update the matched prospect, empty values are kept
+	corrections := "name,birthdate,nhl_team,weight\n" +
+		"max muster,01.03.2006,ANA,\n" +
+		"Moritz Muster,2006-07-12,CGY,201\n"
+	records, _ = Read(strings.NewReader(corrections), CSV, ProspectFields, nil)
+	report, err = ImportProspects(ctx, r, records, false)
+	if err != nil || len(report.Changes) != 2 || report.Changes[0].Action != Update || report.Changes[1].Action != Unchanged {
+		t.Fatalf("Report %+v not as expected: %v", report, err)
+	}
+	if diff := strings.Join(report.Changes[0].Diff, ";"); diff != `nhl_team: "EDM" -> "ANA"` {
+		t.Errorf("Diff %q not as expected", diff)
+	}
+	if max, _ := r.FindProspect(ctx, max.ID); max.FullName != "Max Muster" || max.NhlTeam != "ANA" || max.Weight != 190 {
+		t.Errorf("Prospect %+v not as expected", max)
+	}
+}
+
+func TestFindDuplicates(t *testing.T) {
+	ctx := context.Background()
+	r := storage.NewMemoryRepository()
+	prospects := []models.Prospect{
+		{FullName: "Jiří Kulich", NhlDraftYear: 2022, Birthdate: date("2004-04-14")},
+		{FullName: "Kulich, Jiri", NhlDraftYear: 2022},
+		{FullName: "Elias Pettersson", NhlDraftYear: 2017, Birthdate: date("1998-11-12")},
+		{FullName: "Elias Pettersson", NhlDraftYear: 2022, Birthdate: date("2004-02-16")},
+		{FullName: "Matvei Michkov", NhlDraftYear: 2023, Birthdate: date("2004-12-09")},
+		{FullName: "Matvey Michkov", NhlDraftYear: 2023, Birthdate: date("2004-09-12")},
+		{FullName: "Adam Fantilli", NhlDraftYear: 2023, Birthdate: date("2004-10-12")},
+	}
+	if err := r.CreateProspects(ctx, prospects); err != nil {
+		t.Fatalf("Creating prospects failed: %v", err)
+	}
+
+	duplicates, err := FindDuplicates(ctx, r, 0, DefaultDuplicateSimilarity)
+	if err != nil {
+		t.Fatalf("Finding duplicates failed: %v", err)
+	}
+	found := []string{}
+	for _, d := range duplicates {
+		found = append(found, fmt.Sprintf("%s/%s: %s", d.First.FullName, d.Second.FullName, d.Reason))
+	}
+	expected := []string{
+		"Jiří Kulich/Kulich, Jiri: same name, birthdate unknown",
+		"Matvei Michkov/Matvey Michkov: similar name, birthdate with day and month swapped",
+	}
+	if len(found) != len(expected) {
+		t.Fatalf("Duplicates %q not equal to expected %q", found, expected)
+	}
+	for i := range expected {
+		if found[i] != expected[i] {
+			t.Errorf("Duplicate %q not equal to expected %q", found[i], expected[i])
+		}
+	}
+
+	if duplicates, _ := FindDuplicates(ctx, r, 2023, 0.9); len(duplicates) != 0 {
+		t.Errorf("Expected no duplicates in the 2023 class above 0.9, got %v", len(duplicates))
+	}
+}
+
+func TestFillProspect(t *testing.T) {
+	keep := models.Prospect{FullName: "Jiří Kulich", NhlTeam: "BUF", Height: 72}
+	drop := models.Prospect{FullName: "Jiri Kulich", NhlTeam: "CHI", Height: 71, Weight: 179, Birthdate: date("2004-04-14"), PositionCode: models.Center}
+
+	changes := FillProspect(&keep, drop)
+	if keep.FullName != "Jiří Kulich" || keep.NhlTeam != "BUF" || keep.Height != 72 {
+		t.Errorf("Set values of the kept prospect were replaced: %+v", keep)
+	}
+	if keep.Weight != 179 || keep.Birthdate == nil || keep.PositionCode != models.Center {
+		t.Errorf("Empty values of the kept prospect were not filled: %+v", keep)
+	}
+	if len(changes) != 3 {
+		t.Errorf("Expected 3 changes, got %q", changes)
+	}
+}
+
+func TestChangeStatus(t *testing.T) {
+	ctx := context.Background()
+	r := storage.NewMemoryRepository()
+	for value, expected := range map[string]models.ProspectStatus{"ELC": models.SignedELC, "rights expired": models.RightsExpired, "nhl-graduated": models.NhlGraduated, "": ""} {
+		if s, err := ParseStatus(value); err != nil || s != expected {
+			t.Errorf("Status %q parsed to %q, expected %q: %v", value, s, expected, err)
+		}
+	}
+	if _, err := ParseStatus("injured"); err == nil {
+		t.Errorf("Expected an error for an unknown status")
+	}
+
+	league := models.League{Name: "Lakelandcup", StatusRules: models.StatusRules{models.Retired: models.Release, models.NhlGraduated: models.Unprotect}}
+	if err := r.CreateLeague(ctx, &league); err != nil {
+		t.Fatalf("Creating league failed: %v", err)
+	}
+	franchise := models.Franchise{Name: "TestFranchise", LeagueID: league.ID}
+	if err := r.CreateFranchise(ctx, &franchise); err != nil {
+		t.Fatalf("Creating franchise failed: %v", err)
+	}
+	prospects := []models.Prospect{
+		{FullName: "Max Muster", LeagueID: &league.ID, FranchiseID: &franchise.ID, Protected: true},
+		{FullName: "Moritz Muster", LeagueID: &league.ID, FranchiseID: &franchise.ID, Protected: true},
+	}
+	if err := r.CreateProspects(ctx, prospects); err != nil {
+		t.Fatalf("Creating prospects failed: %v", err)
+	}
+	max, moritz := &prospects[0], &prospects[1]
+	if max.Status != models.Unsigned {
+		t.Errorf("Status %q of a new prospect not %q", max.Status, models.Unsigned)
+	}
+
+	changes, err := ChangeStatus(ctx, r, max, models.NhlGraduated, *date("2024-10-01"))
+	if err != nil || strings.Join(changes, ";") != "status: UNSIGNED -> NHL_GRADUATED;protected: true -> false" {
+		t.Errorf("Changes %q not as expected: %v", changes, err)
+	}
+	if max.FranchiseID == nil || max.Protected || max.StatusSince.Format("2006-01-02") != "2024-10-01" {
+		t.Errorf("Prospect %+v not as expected", max)
+	}
+	// earlier statuses only add to the history, repeated ones are ignored
+	if changes, err := ChangeStatus(ctx, r, max, models.SignedELC, *date("2023-07-01")); err != nil || len(changes) != 1 || max.Status != models.NhlGraduated {
+		t.Errorf("Changes %q not as expected: %v", changes, err)
+	}
+	if changes, _ := ChangeStatus(ctx, r, max, models.SignedELC, *date("2023-07-01")); len(changes) != 0 {
+		t.Errorf("Repeated status changed %q", changes)
+	}
+	if history, _ := r.ListProspectStatuses(ctx, max.ID); len(history) != 2 || history[0].Status != models.SignedELC {
+		t.Errorf("History %+v not as expected", history)
+	}
+	// the current status can be back-dated as well
+	if changes, err := ChangeStatus(ctx, r, max, models.NhlGraduated, *date("2024-03-01")); err != nil || len(changes) != 1 || max.StatusSince.Format("2006-01-02") != "2024-10-01" {
+		t.Errorf("Changes %q not as expected: %v", changes, err)
+	}
+	if history, _ := r.ListProspectStatuses(ctx, max.ID); len(history) != 3 || history[1].Status != models.NhlGraduated {
+		t.Errorf("History %+v not as expected", history)
+	}
+
+	if changes, err := ChangeStatus(ctx, r, moritz, models.Retired, time.Now()); err != nil || len(changes) != 2 {
+		t.Errorf("Changes %q not as expected: %v", changes, err)
+	}
+	if moritz.FranchiseID != nil || moritz.LeagueID != nil || moritz.Protected {
+		t.Errorf("Retired prospect %+v was not released", moritz)
+	}
+	if _, err := ChangeStatus(ctx, r, moritz, models.AHL, time.Now().AddDate(0, 0, 2)); err == nil {
+		t.Errorf("Expected an error for a future date")
+	}
+
+	// imports change the status with its history
+	csv := "name,status,status_date\nMax Muster,retired,2025-06-01\nMia Muster,ELC,\n"
+	records, _ := Read(strings.NewReader(csv), CSV, ProspectFields, nil)
+	report, err := ImportProspects(ctx, r, records, false)
+	if err != nil || len(report.Changes) != 2 || report.Changes[0].Action != Update || report.Changes[1].Action != Insert {
+		t.Fatalf("Report %+v not as expected: %v", report, err)
+	}
+	if max, _ := r.FindProspect(ctx, max.ID); max.Status != models.Retired || max.FranchiseID != nil {
+		t.Errorf("Prospect %+v not as expected", max)
+	}
+	if mia, _ := MatchProspect(ctx, r, ProspectKey{FullName: "Mia Muster"}); mia.Status != models.SignedELC || mia.StatusSince == nil {
+		t.Errorf("Prospect %+v not as expected", mia)
+	}
+}
+
+func TestImportRankings(t *testing.T) {
+	ctx := context.Background()
+	r := storage.NewMemoryRepository()
+	prospects := []models.Prospect{
+		{FullName: "Max Muster", NhlDraftYear: 2024, NhlDraftPickOverall: 5},
+		{FullName: "Moritz Muster", NhlDraftYear: 2024, NhlDraftPickOverall: 9},
+		{FullName: "Mia Muster", NhlDraftYear: 2024, NhlDraftPickOverall: 33},
+	}
+	if err := r.CreateProspects(ctx, prospects); err != nil {
+		t.Fatalf("Creating prospects failed: %v", err)
+	}
+
+	csv := "player\nMoritz Muster\nMax Muster\nMoritz Muster\nMira Muster\n"
+	records, _ := Read(strings.NewReader(csv), CSV, RankingFields, nil)
+	if _, err := ImportRankings(ctx, r, records, " ", 2024, false); err == nil {
+		t.Errorf("Expected an error for a ranking without source")
+	}
+	report, err := ImportRankings(ctx, r, records, "Scouting", 2024, false)
+	if err != nil || len(report.IDs(Insert)) != 2 || len(report.Errors) != 2 {
+		t.Fatalf("Report %+v not as expected: %v", report, err)
+	}
+	rankings, _ := r.ListRankings(ctx, storage.RankingFilter{Year: 2024, Source: "Scouting"})
+	if len(rankings) != 2 || rankings[0].ProspectID != prospects[1].ID || rankings[1].Rank != 2 {
+		t.Errorf("Rankings %+v not as expected", rankings)
+	}
+
+	// a new ranking of the source replaces the old one
+	csv = "rk,name\n1,Max Muster\n3,Mia Muster\n"
+	records, _ = Read(strings.NewReader(csv), CSV, RankingFields, nil)
+	report, err = ImportRankings(ctx, r, records, "Scouting", 2024, false)
+	if err != nil || len(report.Changes) != 3 || report.Changes[0].Action != Update || report.Changes[1].Action != Insert || report.Changes[2].Action != Delete {
+		t.Fatalf("Report %+v not as expected: %v", report, err)
+	}
+	if diff := strings.Join(report.Changes[0].Diff, ";"); diff != "rank: 2 -> 1" {
+		t.Errorf("Diff %q not as expected", diff)
+	}
+	if rankings, _ := r.ListRankings(ctx, storage.RankingFilter{Year: 2024}); len(rankings) != 2 || rankings[1].Rank != 3 {
+		t.Errorf("Rankings %+v not as expected", rankings)
+	}
+}
+
+func TestImportStandings(t *testing.T) {
+	ctx := context.Background()
+	r := storage.NewMemoryRepository()
+	league := models.League{Name: "Lakelandcup"}
+	if err := r.CreateLeague(ctx, &league); err != nil {
+		t.Fatalf("Creating league failed: %v", err)
+	}
+	franchises := []models.Franchise{{Name: "Alpha", LeagueID: league.ID}, {Name: "Beta", LeagueID: league.ID}, {Name: "Gamma", LeagueID: league.ID}}
+	for i := range franchises {
+		if err := r.CreateFranchise(ctx, &franchises[i]); err != nil {
+			t.Fatalf("Creating franchise failed: %v", err)
+		}
+	}
+	alpha, beta, gamma := franchises[0], franchises[1], franchises[2]
+
+	// without ranks the standings are ranked by points, then wins
+	csv := "team,w,l,pts\nalpha,10,5,20\nBeta,11,4,20\nGamma,2,13,4\nDelta,1,1,2\nBeta,1,1,1\n"
+	records, _ := Read(strings.NewReader(csv), CSV, StandingFields, nil)
+	if _, err := ImportStandings(ctx, r, records, league.ID, 0, false); err == nil {
+		t.Errorf("Expected an error for standings without year")
+	}
+	report, err := ImportStandings(ctx, r, records, league.ID, 2024, false)
+	if err != nil || len(report.IDs(Insert)) != 3 || len(report.Errors) != 2 || !errors.Is(report.Errors[0].Err, ErrNoMatch) {
+		t.Fatalf("Report %+v not as expected: %v", report, err)
+	}
+	standings, _ := r.ListStandings(ctx, league.ID, 2024)
+	if len(standings) != 3 || standings[0].FranchiseID != beta.ID || standings[1].FranchiseID != alpha.ID || standings[2].Rank != 3 {
+		t.Errorf("Standings %+v not as expected", standings)
+	}
+
+	// a new import replaces the standings of the year, given ranks are kept
+	csv = fmt.Sprintf("franchise_id,wins,losses,points,rank\n%s,12,5,24,1\n%s,11,6,22,2\n", alpha.ID, beta.ID)
+	records, _ = Read(strings.NewReader(csv), CSV, StandingFields, nil)
+	report, err = ImportStandings(ctx, r, records, league.ID, 2024, false)
+	if err != nil || len(report.Changes) != 3 || report.Changes[0].Action != Update || report.Changes[2].Action != Delete || report.Changes[2].ID != gamma.ID.String() {
+		t.Fatalf("Report %+v not as expected: %v", report, err)
+	}
+	if diff := strings.Join(report.Changes[0].Diff, ";"); diff != "wins: 10 -> 12;points: 20 -> 24;rank: 2 -> 1" {
+		t.Errorf("Diff %q not as expected", diff)
+	}
+	if standings, _ := r.ListStandings(ctx, league.ID, 2024); len(standings) != 2 || standings[0].FranchiseID != alpha.ID {
+		t.Errorf("Standings %+v not as expected", standings)
+	}
+
+	// ranks must be given for every standing or none
+	csv = "team,pts,rank\nAlpha,3,1\nBeta,2,\n"
+	records, _ = Read(strings.NewReader(csv), CSV, StandingFields, nil)
+	if _, err := ImportStandings(ctx, r, records, league.ID, 2024, false); err == nil {
+		t.Errorf("Expected an error for partly ranked standings")
+	}
+}
@@ -0,0 +1,190 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	// ErrNoMatch is returned if no prospect matches a row.
+	ErrNoMatch = errors.New("no matching prospect")
+	// ErrAmbiguous is returned if several prospects match a row equally well.
+	ErrAmbiguous = errors.New("several prospects match")
+)
+
+// dateLayouts are the accepted formats of dates, day first for dots and month first for slashes
+var dateLayouts = []string{"2006-01-02", "2006/01/02", "02.01.2006", "01/02/2006", "20060102", time.RFC3339}
+
+// ParseDate parses a date in one of the common formats, "" is nil.
+func ParseDate(value string) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid date %q", value)
+}
+
+// NormalizeName lowercases the name, strips accents and punctuation and turns "Last, First" into "first last".
+func NormalizeName(name string) string {
+	if last, first, ok := strings.Cut(name, ","); ok {
+		name = first + " " + last
+	}
+	var b strings.Builder
+	for _, r := range norm.NFD.String(name) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// ProspectKey are the attributes of a row that identify its prospect, zero values are unknown.
+type ProspectKey struct {
+	ID                  *uuid.UUID
+	FullName            string
+	Birthdate           *time.Time
+	NhlDraftYear        int
+	NhlDraftPickOverall int
+	NhlTeam             string
+}
+
+// prospectKey reads the key of the record
+func prospectKey(record Record) (ProspectKey, error) {
+	key := ProspectKey{FullName: record.Get("name"), NhlTeam: record.Get("nhl_team")}
+	if key.FullName == "" && (record.Get("first_name") != "" || record.Get("last_name") != "") {
+		key.FullName = strings.TrimSpace(record.Get("first_name") + " " + record.Get("last_name"))
+	}
+	if id := record.Get("prospect_id"); id != "" {
+		pId, err := uuid.Parse(id)
+		if err != nil {
+			return key, fmt.Errorf("invalid prospect id %q", id)
+		}
+		key.ID = &pId
+	}
+	var err error
+	if key.Birthdate, err = ParseDate(record.Get("birthdate")); err != nil {
+		return key, err
+	}
+	if key.NhlDraftYear, err = integer(record, "nhl_draft_year"); err != nil {
+		return key, err
+	}
+	if key.NhlDraftPickOverall, err = integer(record, "nhl_draft_pick_overall"); err != nil {
+		return key, err
+	}
+	if key.ID == nil && key.FullName == "" && (key.NhlDraftYear == 0 || key.NhlDraftPickOverall == 0) {
+		return key, errors.New("a prospect id, a name or the nhl draft year and pick are required")
+	}
+	return key, nil
+}
+
+// MatchProspect finds the prospect of the key. An id must match, the nhl draft year and overall
+// pick identify a drafted prospect. Otherwise the normalized names must be equal and known
+// birthdates and nhl draft data must not differ, the nhl team decides between the rest.
+func MatchProspect(ctx context.Context, r storage.Repository, key ProspectKey) (*models.Prospect, error) {
+	if key.ID != nil {
+		prospect, err := r.FindProspect(ctx, *key.ID)
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("%w with id %v", ErrNoMatch, *key.ID)
+		}
+		return prospect, err
+	}
+
+	if key.NhlDraftYear != 0 && key.NhlDraftPickOverall != 0 {
+		drafted, err := draftClass(ctx, r, key.NhlDraftYear)
+		if err != nil {
+			return nil, err
+		}
+		for i, p := range drafted {
+			if p.NhlDraftPickOverall == key.NhlDraftPickOverall && (key.FullName == "" || sameLastName(p.FullName, key.FullName)) {
+				return &drafted[i], nil
+			}
+		}
+	}
+	if key.FullName == "" {
+		return nil, fmt.Errorf("%w drafted %d at %d", ErrNoMatch, key.NhlDraftYear, key.NhlDraftPickOverall)
+	}
+
+	found, err := r.SearchProspects(ctx, storage.ProspectSearch{Text: key.FullName, Limit: storage.DefaultPageSize})
+	if err != nil {
+		return nil, err
+	}
+	name := NormalizeName(key.FullName)
+	candidates := []models.Prospect{}
+	for _, p := range found {
+		if NormalizeName(p.FullName) == name && key.compatible(p) {
+			candidates = append(candidates, p)
+		}
+	}
+	if len(candidates) > 1 && key.NhlTeam != "" {
+		sameTeam := []models.Prospect{}
+		for _, p := range candidates {
+			if strings.EqualFold(p.NhlTeam, key.NhlTeam) {
+				sameTeam = append(sameTeam, p)
+			}
+		}
+		if len(sameTeam) > 0 {
+			candidates = sameTeam
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("%w named %q", ErrNoMatch, key.FullName)
+	case 1:
+		return &candidates[0], nil
+	default:
+		return nil, fmt.Errorf("%w named %q", ErrAmbiguous, key.FullName)
+	}
+}
+
+// compatible reports whether the attributes known on both sides are equal
+func (key ProspectKey) compatible(p models.Prospect) bool {
+	if key.Birthdate != nil && p.Birthdate != nil && !key.Birthdate.Equal(p.Birthdate.UTC()) {
+		return false
+	}
+	if key.NhlDraftYear != 0 && p.NhlDraftYear != 0 && key.NhlDraftYear != p.NhlDraftYear {
+		return false
+	}
+	return key.NhlDraftPickOverall == 0 || p.NhlDraftPickOverall == 0 || key.NhlDraftPickOverall == p.NhlDraftPickOverall
+}
+
+// sameLastName compares the last words of the normalized names
+func sameLastName(a, b string) bool {
+	fa, fb := strings.Fields(NormalizeName(a)), strings.Fields(NormalizeName(b))
+	return len(fa) > 0 && len(fb) > 0 && fa[len(fa)-1] == fb[len(fb)-1]
+}
+
+// draftClass returns the prospects drafted into the nhl in the year
+func draftClass(ctx context.Context, r storage.Repository, year int) ([]models.Prospect, error) {
+	all := []models.Prospect{}
+	page := storage.Page{Size: storage.MaxPageSize}
+	for {
+		prospects, next, err := r.ListProspects(ctx, storage.ProspectFilter{NhlDraftYear: year}, page)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, prospects...)
+		if next == "" {
+			return all, nil
+		}
+		page.Token = next
+	}
+}
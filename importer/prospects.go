@@ -0,0 +1,236 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+// ProspectFields are the fields of a prospect import with the column names they are known by.
+var ProspectFields = Fields{
+	"prospect_id":             {"prospect_id"},
+	"name":                    {"name", "full_name", "player", "player_name"},
+	"first_name":              {"first_name"},
+	"last_name":               {"last_name"},
+	"birthdate":               {"birthdate", "birth_date", "date_of_birth", "dob"},
+	"nhl_team":                {"nhl_team", "team"},
+	"position":                {"position", "position_code", "pos"},
+	"height":                  {"height", "ht"},
+	"weight":                  {"weight", "wt"},
+	"nhl_draft_year":          {"nhl_draft_year", "draft_year", "year"},
+	"nhl_draft_round":         {"nhl_draft_round", "draft_round", "round", "rd"},
+	"nhl_draft_pick_overall":  {"nhl_draft_pick_overall", "draft_pick_overall", "overall", "ovr"},
+	"nhl_draft_pick_in_round": {"nhl_draft_pick_in_round", "draft_pick_in_round", "pick_in_round", "pick"},
+	"status":                  {"status", "contract_status"},
+	"status_date":             {"status_date", "status_since", "effective_date"},
+}
+
+// feetAndInches matches heights like 6' 1" and 6-1
+var feetAndInches = regexp.MustCompile(`^(\d+)\s*(?:'|-|ft)\s*(\d+)\s*(?:"|in)?$`)
+
+// positionCodes maps the position names of common sources to the nhl codes
+var positionCodes = map[string]models.Position{"LW": models.LeftWing, "RW": models.RightWing, "LD": models.Defenseman, "RD": models.Defenseman}
+
+// parseHeight returns inches of heights in inches or feet and inches, "" is 0
+func parseHeight(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	if m := feetAndInches.FindStringSubmatch(value); m != nil {
+		feet, _ := strconv.Atoi(m[1])
+		inches, _ := strconv.Atoi(m[2])
+		return feet*12 + inches, nil
+	}
+	inches, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid height %q", value)
+	}
+	return inches, nil
+}
+
+// ParsePosition returns the nhl code of the position, "" if unset.
+func ParsePosition(value string) (models.Position, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if p, ok := positionCodes[value]; ok {
+		return p, nil
+	}
+	p := models.Position(value)
+	if !p.Valid() {
+		return "", fmt.Errorf("invalid position %q", value)
+	}
+	return p, nil
+}
+
+// parseProspect reads the prospect of the record, unset fields stay zero
+func parseProspect(record Record) (models.Prospect, error) {
+	prospect := models.Prospect{FirstName: record.Get("first_name"), LastName: record.Get("last_name"), NhlTeam: record.Get("nhl_team")}
+	prospect.FullName = record.Get("name")
+	if last, first, ok := strings.Cut(prospect.FullName, ","); ok {
+		prospect.FullName = strings.TrimSpace(first) + " " + strings.TrimSpace(last)
+	}
+	if prospect.FullName == "" {
+		prospect.FullName = strings.TrimSpace(prospect.FirstName + " " + prospect.LastName)
+	}
+	if i := strings.LastIndex(prospect.FullName, " "); i > 0 && prospect.FirstName == "" && prospect.LastName == "" {
+		prospect.FirstName, prospect.LastName = prospect.FullName[:i], prospect.FullName[i+1:]
+	}
+
+	var err error
+	if prospect.Birthdate, err = ParseDate(record.Get("birthdate")); err != nil {
+		return prospect, err
+	}
+	if prospect.PositionCode, err = ParsePosition(record.Get("position")); err != nil {
+		return prospect, err
+	}
+	if prospect.Height, err = parseHeight(record.Get("height")); err != nil {
+		return prospect, err
+	}
+	if prospect.Status, err = ParseStatus(record.Get("status")); err != nil {
+		return prospect, err
+	}
+	if prospect.StatusSince, err = ParseDate(record.Get("status_date")); err != nil {
+		return prospect, err
+	}
+	if prospect.StatusSince != nil && prospect.Status == "" {
+		return prospect, errors.New("a status date needs a status")
+	}
+	if prospect.StatusSince != nil && prospect.StatusSince.After(time.Now()) {
+		return prospect, fmt.Errorf("the status date %s lies in the future", formatDate(prospect.StatusSince))
+	}
+	for field, value := range map[string]*int{
+		"weight":                  &prospect.Weight,
+		"nhl_draft_year":          &prospect.NhlDraftYear,
+		"nhl_draft_round":         &prospect.NhlDraftRound,
+		"nhl_draft_pick_overall":  &prospect.NhlDraftPickOverall,
+		"nhl_draft_pick_in_round": &prospect.NhlDraftPickInRound,
+	} {
+		if *value, err = integer(record, field); err != nil {
+			return prospect, err
+		}
+	}
+	return prospect, nil
+}
+
+// ImportProspects inserts the prospects of the records that match no prospect and updates the matched
+// ones with the values of the row, empty values keep the prospect's value. Rows that fail are reported,
+// the others are imported.
+func ImportProspects(ctx context.Context, r storage.Repository, records []Record, dryRun bool) (*Report, error) {
+	report := &Report{DryRun: dryRun}
+	err := apply(ctx, r, dryRun, func(tx storage.Repository) error {
+		for _, record := range records {
+			row, err := parseProspect(record)
+			if err != nil {
+				report.fail(record.Line, err)
+				continue
+			}
+			// the status is changed with its history after the prospect is saved
+			status, since := row.Status, time.Now()
+			if row.StatusSince != nil {
+				since = *row.StatusSince
+			}
+			row.Status, row.StatusSince = "", nil
+			key, err := prospectKey(record)
+			if err != nil {
+				report.fail(record.Line, err)
+				continue
+			}
+			prospect, err := MatchProspect(ctx, tx, key)
+			switch {
+			case errors.Is(err, ErrNoMatch) && key.ID == nil:
+				if row.FullName == "" {
+					report.fail(record.Line, errors.New("a new prospect needs a name"))
+					continue
+				}
+				created := []models.Prospect{row}
+				if err := tx.CreateProspects(ctx, created); err != nil {
+					return err
+				}
+				if status != "" {
+					changes, err := ChangeStatus(ctx, tx, &created[0], status, since)
+					if err != nil {
+						return err
+					}
+					if len(changes) > 0 {
+						if err := tx.SaveProspect(ctx, &created[0]); err != nil {
+							return err
+						}
+					}
+				}
+				report.Changes = append(report.Changes, Change{Line: record.Line, Action: Insert, ID: created[0].ID.String(), Description: row.FullName})
+				continue
+			case errors.Is(err, ErrNoMatch) || errors.Is(err, ErrAmbiguous):
+				report.fail(record.Line, err)
+				continue
+			case err != nil:
+				return err
+			}
+
+			change := Change{Line: record.Line, Action: Unchanged, ID: prospect.ID.String(), Description: prospect.FullName}
+			change.Diff = MergeProspect(prospect, row)
+			if status != "" {
+				changes, err := ChangeStatus(ctx, tx, prospect, status, since)
+				if err != nil {
+					return err
+				}
+				change.Diff = append(change.Diff, changes...)
+			}
+			if len(change.Diff) > 0 {
+				change.Action = Update
+				prospect.Pick = nil
+				if err := tx.SaveProspect(ctx, prospect); err != nil {
+					return err
+				}
+			}
+			report.Changes = append(report.Changes, change)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// MergeProspect sets the values of the row that are set and returns the changes
+func MergeProspect(p *models.Prospect, row models.Prospect) []string {
+	var changes []string
+	// case differences of sources don't change the spelling
+	text := func(field string, value *string, new string) {
+		if new != "" && !strings.EqualFold(*value, new) {
+			changes = diff(changes, field, *value, new)
+			*value = new
+		}
+	}
+	number := func(field string, value *int, new int) {
+		if new != 0 {
+			changes = diff(changes, field, *value, new)
+			*value = new
+		}
+	}
+	text("name", &p.FullName, row.FullName)
+	text("first_name", &p.FirstName, row.FirstName)
+	text("last_name", &p.LastName, row.LastName)
+	text("nhl_team", &p.NhlTeam, row.NhlTeam)
+	position := string(p.PositionCode)
+	text("position", &position, string(row.PositionCode))
+	p.PositionCode = models.Position(position)
+	if row.Birthdate != nil {
+		changes = diff(changes, "birthdate", formatDate(p.Birthdate), formatDate(row.Birthdate))
+		p.Birthdate = row.Birthdate
+	}
+	number("height", &p.Height, row.Height)
+	number("weight", &p.Weight, row.Weight)
+	number("nhl_draft_year", &p.NhlDraftYear, row.NhlDraftYear)
+	number("nhl_draft_round", &p.NhlDraftRound, row.NhlDraftRound)
+	number("nhl_draft_pick_overall", &p.NhlDraftPickOverall, row.NhlDraftPickOverall)
+	number("nhl_draft_pick_in_round", &p.NhlDraftPickInRound, row.NhlDraftPickInRound)
+	return changes
+}
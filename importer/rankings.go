@@ -0,0 +1,107 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+// RankingFields are the fields of a ranking import with the column names they are known by.
+var RankingFields = Fields{
+	"rank":                   {"rank", "rk", "ranking"},
+	"prospect_id":            {"prospect_id"},
+	"name":                   {"name", "full_name", "player", "player_name"},
+	"first_name":             {"first_name"},
+	"last_name":              {"last_name"},
+	"birthdate":              {"birthdate", "birth_date", "date_of_birth", "dob"},
+	"nhl_team":               {"nhl_team", "team"},
+	"nhl_draft_year":         {"nhl_draft_year", "draft_year"},
+	"nhl_draft_pick_overall": {"nhl_draft_pick_overall", "draft_pick_overall", "overall", "ovr"},
+}
+
+// ImportRankings replaces the ranking of the source for the draft year with the ranked prospects of the records.
+// Rows without a rank are ranked by their order. Rows that fail are reported, the others are imported.
+func ImportRankings(ctx context.Context, r storage.Repository, records []Record, source string, year int, dryRun bool) (*Report, error) {
+	source = strings.TrimSpace(source)
+	if source == "" || year <= 0 {
+		return nil, errors.New("a ranking needs a source and a draft year")
+	}
+	report := &Report{DryRun: dryRun}
+	err := apply(ctx, r, dryRun, func(tx storage.Repository) error {
+		previous, err := tx.ListRankings(ctx, storage.RankingFilter{Year: year, Source: source})
+		if err != nil {
+			return err
+		}
+		ranks := map[uuid.UUID]int{}
+		for _, p := range previous {
+			ranks[p.ProspectID] = p.Rank
+		}
+
+		rankings := []models.ProspectRanking{}
+		lines := map[uuid.UUID]int{}
+		for i, record := range records {
+			rank, err := integer(record, "rank")
+			if err != nil {
+				report.fail(record.Line, err)
+				continue
+			}
+			if record.Get("rank") == "" {
+				rank = i + 1
+			}
+			if rank <= 0 {
+				report.fail(record.Line, fmt.Errorf("invalid rank %d", rank))
+				continue
+			}
+			key, err := prospectKey(record)
+			if err != nil {
+				report.fail(record.Line, err)
+				continue
+			}
+			prospect, err := MatchProspect(ctx, tx, key)
+			if errors.Is(err, ErrNoMatch) || errors.Is(err, ErrAmbiguous) {
+				report.fail(record.Line, err)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if line, ok := lines[prospect.ID]; ok {
+				report.fail(record.Line, fmt.Errorf("%s is already ranked on line %d", prospect.FullName, line))
+				continue
+			}
+			lines[prospect.ID] = record.Line
+			rankings = append(rankings, models.ProspectRanking{ProspectID: prospect.ID, Rank: rank})
+
+			change := Change{Line: record.Line, Action: Insert, ID: prospect.ID.String(), Description: fmt.Sprintf("%d. %s", rank, prospect.FullName)}
+			if old, ok := ranks[prospect.ID]; ok {
+				change.Action = Unchanged
+				if change.Diff = diff(nil, "rank", old, rank); len(change.Diff) > 0 {
+					change.Action = Update
+				}
+			}
+			report.Changes = append(report.Changes, change)
+		}
+
+		// prospects the new ranking leaves out are dropped from it
+		for _, p := range previous {
+			if _, ok := lines[p.ProspectID]; ok {
+				continue
+			}
+			prospect, err := tx.FindProspect(ctx, p.ProspectID)
+			if err != nil {
+				return err
+			}
+			report.Changes = append(report.Changes, Change{Action: Delete, ID: p.ProspectID.String(), Description: fmt.Sprintf("%d. %s", p.Rank, prospect.FullName)})
+		}
+		return tx.ReplaceRanking(ctx, year, source, nil, rankings)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
@@ -0,0 +1,168 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// Format is the format of an import file.
+type Format string
+
+const (
+	CSV  Format = "csv"
+	JSON Format = "json"
+)
+
+// ParseFormat returns the format, the file's extension decides if it is empty.
+func ParseFormat(format string, file string) (Format, error) {
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(file), ".")
+	}
+	switch f := Format(strings.ToLower(format)); f {
+	case CSV, JSON:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown format %q, expected csv or json", format)
+	}
+}
+
+// Record is a row of an import file with its values by field.
+type Record struct {
+	// Line is the line of the row in a csv file or its position in a json array, starting at 1
+	Line   int
+	Values map[string]string
+}
+
+// Get returns the trimmed value of the field, "" if unset.
+func (r Record) Get(field string) string {
+	return strings.TrimSpace(r.Values[field])
+}
+
+// Fields maps the fields of an import to the column names they are known by.
+type Fields map[string][]string
+
+// Columns maps fields to the column names of a file, it overrides the known names.
+type Columns map[string]string
+
+// ParseColumns parses mappings like field=column.
+func ParseColumns(mappings []string) (Columns, error) {
+	columns := Columns{}
+	for _, m := range mappings {
+		field, column, ok := strings.Cut(m, "=")
+		if !ok || strings.TrimSpace(field) == "" || strings.TrimSpace(column) == "" {
+			return nil, fmt.Errorf("invalid column mapping %q, expected field=column", m)
+		}
+		columns[strings.TrimSpace(field)] = strings.TrimSpace(column)
+	}
+	return columns, nil
+}
+
+// resolve returns the field of the column, "" if the column is not imported
+func (f Fields) resolve(column string, columns Columns) string {
+	for field, c := range columns {
+		if key(c) == key(column) {
+			return field
+		}
+	}
+	for field, names := range f {
+		if _, mapped := columns[field]; mapped {
+			continue
+		}
+		for _, n := range names {
+			if key(n) == key(column) {
+				return field
+			}
+		}
+	}
+	return ""
+}
+
+// key compares column names by their letters and digits only
+func key(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
+
+// Read reads the records of a csv file with a header row or of a json array of objects.
+func Read(r io.Reader, format Format, fields Fields, columns Columns) ([]Record, error) {
+	for field := range columns {
+		if _, ok := fields[field]; !ok {
+			return nil, fmt.Errorf("unknown field %q", field)
+		}
+	}
+	switch format {
+	case CSV:
+		return readCSV(r, fields, columns)
+	case JSON:
+		return readJSON(r, fields, columns)
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func readCSV(r io.Reader, fields Fields, columns Columns) ([]Record, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return []Record{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	resolved := make([]string, len(header))
+	for i, column := range header {
+		resolved[i] = fields.resolve(strings.TrimPrefix(column, "\ufeff"), columns)
+	}
+
+	records := []Record{}
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		record := Record{Line: line, Values: map[string]string{}}
+		for i, value := range row {
+			if i < len(resolved) && resolved[i] != "" {
+				record.Values[resolved[i]] = value
+			}
+		}
+		records = append(records, record)
+	}
+}
+
+func readJSON(r io.Reader, fields Fields, columns Columns) ([]Record, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	var rows []map[string]interface{}
+	if err := decoder.Decode(&rows); err != nil {
+		return nil, fmt.Errorf("expected a json array of objects: %v", err)
+	}
+	records := []Record{}
+	for i, row := range rows {
+		record := Record{Line: i + 1, Values: map[string]string{}}
+		for column, value := range row {
+			field := fields.resolve(column, columns)
+			if field == "" || value == nil {
+				continue
+			}
+			record.Values[field] = fmt.Sprint(value)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
@@ -0,0 +1,144 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+// Action is what an import does with a row.
+type Action string
+
+const (
+	Insert    Action = "insert"
+	Update    Action = "update"
+	Unchanged Action = "unchanged"
+	// Delete removes a record the file no longer lists
+	Delete Action = "delete"
+)
+
+// Change is the outcome of a row that was imported.
+type Change struct {
+	Line        int
+	Action      Action
+	ID          string
+	Description string
+	// Diff lists the changed fields of an update as "field: old -> new"
+	Diff []string
+}
+
+// RowError is a row that could not be imported.
+type RowError struct {
+	Line int
+	Err  error
+}
+
+func (e RowError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Report lists the outcome of every row of an import.
+type Report struct {
+	DryRun  bool
+	Changes []Change
+	Errors  []RowError
+}
+
+// IDs returns the ids of the records the import inserted or updated.
+func (r *Report) IDs(action Action) []string {
+	ids := []string{}
+	for _, c := range r.Changes {
+		if c.Action == action {
+			ids = append(ids, c.ID)
+		}
+	}
+	return ids
+}
+
+func (r *Report) fail(line int, err error) {
+	r.Errors = append(r.Errors, RowError{Line: line, Err: err})
+}
+
+// errDryRun rolls back the transaction of a dry run
+var errDryRun = errors.New("dry run")
+
+// apply runs fn in a transaction, a dry run is rolled back
+func apply(ctx context.Context, r storage.Repository, dryRun bool, fn func(tx storage.Repository) error) error {
+	err := r.Transaction(ctx, func(tx storage.Repository) error {
+		if err := fn(tx); err != nil {
+			return err
+		}
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if errors.Is(err, errDryRun) {
+		return nil
+	}
+	return err
+}
+
+// diff appends the field if its value changes
+func diff(changes []string, field string, old interface{}, new interface{}) []string {
+	o, n := format(old), format(new)
+	if o == n {
+		return changes
+	}
+	return append(changes, fmt.Sprintf("%s: %s -> %s", field, o, n))
+}
+
+func format(value interface{}) string {
+	switch v := value.(type) {
+	case *float64:
+		if v == nil {
+			return "-"
+		}
+		return strconv.FormatFloat(*v, 'f', -1, 64)
+	case string:
+		if v == "" {
+			return "-"
+		}
+		return strconv.Quote(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
+
+// integer parses the field, "" is 0
+func integer(record Record, field string) (int, error) {
+	value := record.Get(field)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", field, value)
+	}
+	return n, nil
+}
+
+// decimal parses the field, "" is nil. Decimal commas are accepted.
+func decimal(record Record, field string) (*float64, error) {
+	value := strings.Replace(record.Get(field), ",", ".", 1)
+	if value == "" || value == "-" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q", field, value)
+	}
+	return &f, nil
+}
@@ -0,0 +1,194 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+// StandingFields are the fields of a standings import with the column names they are known by.
+var StandingFields = Fields{
+	"franchise_id": {"franchise_id"},
+	"franchise":    {"franchise", "franchise_name", "team", "name"},
+	"wins":         {"wins", "w"},
+	"losses":       {"losses", "l"},
+	"points":       {"points", "pts"},
+	"rank":         {"rank", "rk", "place"},
+}
+
+// RankStandings checks the standings of a season and ranks them by points, wins and losses unless
+// every standing has a rank already. Given ranks must be unique.
+func RankStandings(standings []models.Standing) error {
+	ranked := map[int]bool{}
+	franchises := map[uuid.UUID]bool{}
+	for _, s := range standings {
+		if s.Wins < 0 || s.Losses < 0 || s.Points < 0 || s.Rank < 0 {
+			return fmt.Errorf("the standing of franchise %v has negative values", s.FranchiseID)
+		}
+		if franchises[s.FranchiseID] {
+			return fmt.Errorf("franchise %v has two standings", s.FranchiseID)
+		}
+		franchises[s.FranchiseID] = true
+		if s.Rank == 0 {
+			continue
+		}
+		if ranked[s.Rank] {
+			return fmt.Errorf("rank %d is given twice", s.Rank)
+		}
+		ranked[s.Rank] = true
+	}
+	if len(ranked) > 0 && len(ranked) != len(standings) {
+		return errors.New("either every standing or none has a rank")
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if len(ranked) > 0 {
+			return a.Rank < b.Rank
+		}
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.Wins != b.Wins {
+			return a.Wins > b.Wins
+		}
+		return a.Losses < b.Losses
+	})
+	if len(ranked) == 0 {
+		for i := range standings {
+			standings[i].Rank = i + 1
+		}
+	}
+	return nil
+}
+
+// ImportStandings replaces the league's standings of the season ending with the draft of the year.
+// Rows are matched to the league's franchises by franchise_id or name. Rows that fail are reported,
+// the others are imported.
+func ImportStandings(ctx context.Context, r storage.Repository, records []Record, leagueID uuid.UUID, year int, dryRun bool) (*Report, error) {
+	if year <= 0 {
+		return nil, errors.New("standings need the year of the season's draft")
+	}
+	report := &Report{DryRun: dryRun}
+	err := apply(ctx, r, dryRun, func(tx storage.Repository) error {
+		league, err := tx.FindLeague(ctx, leagueID)
+		if err != nil {
+			return err
+		}
+		previous, err := tx.ListStandings(ctx, leagueID, year)
+		if err != nil {
+			return err
+		}
+		old := map[uuid.UUID]models.Standing{}
+		for _, s := range previous {
+			old[s.FranchiseID] = s
+		}
+
+		standings := []models.Standing{}
+		lines := map[uuid.UUID]int{}
+		names := map[uuid.UUID]string{}
+		for _, record := range records {
+			franchise, err := matchFranchise(league, record)
+			if err != nil {
+				report.fail(record.Line, err)
+				continue
+			}
+			if line, ok := lines[franchise.ID]; ok {
+				report.fail(record.Line, fmt.Errorf("%s already has a standing on line %d", franchise.Name, line))
+				continue
+			}
+			standing, err := parseStanding(record)
+			if err != nil {
+				report.fail(record.Line, err)
+				continue
+			}
+			standing.FranchiseID = franchise.ID
+			lines[franchise.ID] = record.Line
+			names[franchise.ID] = franchise.Name
+			standings = append(standings, standing)
+		}
+		if err := RankStandings(standings); err != nil {
+			return err
+		}
+
+		for _, s := range standings {
+			change := Change{Line: lines[s.FranchiseID], Action: Insert, ID: s.FranchiseID.String(), Description: fmt.Sprintf("%d. %s", s.Rank, names[s.FranchiseID])}
+			if o, ok := old[s.FranchiseID]; ok {
+				change.Diff = diff(change.Diff, "wins", o.Wins, s.Wins)
+				change.Diff = diff(change.Diff, "losses", o.Losses, s.Losses)
+				change.Diff = diff(change.Diff, "points", o.Points, s.Points)
+				change.Diff = diff(change.Diff, "rank", o.Rank, s.Rank)
+				change.Action = Unchanged
+				if len(change.Diff) > 0 {
+					change.Action = Update
+				}
+			}
+			report.Changes = append(report.Changes, change)
+		}
+		// franchises the new standings leave out are dropped from them
+		for _, s := range previous {
+			if _, ok := lines[s.FranchiseID]; !ok {
+				report.Changes = append(report.Changes, Change{Action: Delete, ID: s.FranchiseID.String(), Description: fmt.Sprintf("%d. %s", s.Rank, franchiseName(league, s.FranchiseID))})
+			}
+		}
+		return tx.ReplaceStandings(ctx, leagueID, year, standings)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func parseStanding(record Record) (models.Standing, error) {
+	standing := models.Standing{}
+	fields := []struct {
+		name  string
+		value *int
+	}{{"wins", &standing.Wins}, {"losses", &standing.Losses}, {"points", &standing.Points}, {"rank", &standing.Rank}}
+	for _, f := range fields {
+		n, err := integer(record, f.name)
+		if err != nil {
+			return standing, err
+		}
+		if n < 0 {
+			return standing, fmt.Errorf("invalid %s %d", f.name, n)
+		}
+		*f.value = n
+	}
+	return standing, nil
+}
+
+func franchiseName(league *models.League, id uuid.UUID) string {
+	for _, f := range league.Franchises {
+		if f.ID == id {
+			return f.Name
+		}
+	}
+	return id.String()
+}
+
+// matchFranchise finds the franchise of the record among the league's franchises, the orphaned picks have no standing
+func matchFranchise(league *models.League, record Record) (*models.Franchise, error) {
+	id, name := record.Get("franchise_id"), record.Get("franchise")
+	for i, f := range league.Franchises {
+		if f.Orphan {
+			continue
+		}
+		if id != "" && f.ID.String() == strings.ToLower(id) || id == "" && name != "" && strings.EqualFold(f.Name, name) {
+			return &league.Franchises[i], nil
+		}
+	}
+	if id == "" && name == "" {
+		return nil, errors.New("a franchise_id or franchise name is required")
+	}
+	if id != "" {
+		name = id
+	}
+	return nil, fmt.Errorf("%w: franchise %s is not in league %s", ErrNoMatch, name, league.Name)
+}
@@ -0,0 +1,171 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+// StatsFields are the fields of a stats import with the column names they are known by.
+var StatsFields = Fields{
+	"prospect_id":            {"prospect_id"},
+	"name":                   {"name", "full_name", "player", "player_name"},
+	"first_name":             {"first_name"},
+	"last_name":              {"last_name"},
+	"birthdate":              {"birthdate", "birth_date", "date_of_birth", "dob"},
+	"nhl_draft_year":         {"nhl_draft_year", "draft_year"},
+	"nhl_draft_pick_overall": {"nhl_draft_pick_overall", "draft_pick_overall", "overall"},
+	"nhl_team":               {"nhl_team"},
+	"season":                 {"season"},
+	"level":                  {"level", "league", "lg", "competition"},
+	"team":                   {"team", "club"},
+	"gp":                     {"gp", "games_played", "games"},
+	"g":                      {"g", "goals"},
+	"a":                      {"a", "assists"},
+	"pts":                    {"pts", "p", "tp", "points"},
+	"pim":                    {"pim", "penalty_minutes"},
+	"sv_pct":                 {"sv%", "sv_pct", "save_percentage"},
+	"gaa":                    {"gaa", "goals_against_average"},
+}
+
+// season matches 2023, 2023-24, 2023-2024, 2023/24 and 20232024
+var season = regexp.MustCompile(`^(\d{4})(?:\s*[-/]?\s*(\d{2}|\d{4}))?$`)
+
+// ParseSeason returns the year the season starts.
+func ParseSeason(value string) (int, error) {
+	m := season.FindStringSubmatch(strings.TrimSpace(value))
+	if m == nil {
+		return 0, fmt.Errorf("invalid season %q", value)
+	}
+	start, _ := strconv.Atoi(m[1])
+	if end := m[2]; end != "" {
+		e, _ := strconv.Atoi(end)
+		if len(end) == 2 {
+			e += start / 100 * 100
+			if e < start {
+				e += 100
+			}
+		}
+		if e != start+1 {
+			return 0, fmt.Errorf("invalid season %q", value)
+		}
+	}
+	return start, nil
+}
+
+// SeasonLabel formats the season like 2023-24.
+func SeasonLabel(start int) string {
+	return fmt.Sprintf("%d-%02d", start, (start+1)%100)
+}
+
+// parseStats reads the season line of the record
+func parseStats(record Record) (models.ProspectSeasonStats, error) {
+	stats := models.ProspectSeasonStats{Level: record.Get("level"), Team: record.Get("team")}
+	if stats.Level == "" || stats.Team == "" {
+		return stats, errors.New("level and team are required")
+	}
+	var err error
+	if stats.Season, err = ParseSeason(record.Get("season")); err != nil {
+		return stats, err
+	}
+	for field, value := range map[string]*int{"gp": &stats.GamesPlayed, "g": &stats.Goals, "a": &stats.Assists, "pts": &stats.Points, "pim": &stats.PenaltyMinutes} {
+		if *value, err = integer(record, field); err != nil {
+			return stats, err
+		}
+		if *value < 0 {
+			return stats, fmt.Errorf("%s must not be negative", field)
+		}
+	}
+	if record.Get("pts") == "" {
+		stats.Points = stats.Goals + stats.Assists
+	}
+	if stats.SavePercentage, err = decimal(record, "sv_pct"); err != nil {
+		return stats, err
+	}
+	// percentages like 91.5
+	if stats.SavePercentage != nil && *stats.SavePercentage > 1 {
+		*stats.SavePercentage /= 100
+	}
+	if stats.GoalsAgainstAverage, err = decimal(record, "gaa"); err != nil {
+		return stats, err
+	}
+	return stats, nil
+}
+
+// ImportStats upserts the season lines of the records, a line is identified by its prospect,
+// season, level and team. Rows that fail are reported, the others are imported.
+func ImportStats(ctx context.Context, r storage.Repository, records []Record, dryRun bool) (*Report, error) {
+	report := &Report{DryRun: dryRun}
+	err := apply(ctx, r, dryRun, func(tx storage.Repository) error {
+		for _, record := range records {
+			stats, err := parseStats(record)
+			if err != nil {
+				report.fail(record.Line, err)
+				continue
+			}
+			key, err := prospectKey(record)
+			if err != nil {
+				report.fail(record.Line, err)
+				continue
+			}
+			prospect, err := MatchProspect(ctx, tx, key)
+			if errors.Is(err, ErrNoMatch) || errors.Is(err, ErrAmbiguous) {
+				report.fail(record.Line, err)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			stats.ProspectID = prospect.ID
+			change, err := saveStats(ctx, tx, stats)
+			if err != nil {
+				return err
+			}
+			change.Line = record.Line
+			change.Description = fmt.Sprintf("%s %s %s %s", prospect.FullName, SeasonLabel(stats.Season), stats.Level, stats.Team)
+			report.Changes = append(report.Changes, change)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// saveStats inserts the line or updates the prospect's line of the season, level and team
+func saveStats(ctx context.Context, tx storage.Repository, stats models.ProspectSeasonStats) (Change, error) {
+	lines, err := tx.ListProspectStats(ctx, storage.StatsFilter{ProspectIDs: []uuid.UUID{stats.ProspectID}, Season: stats.Season})
+	if err != nil {
+		return Change{}, err
+	}
+	for _, existing := range lines {
+		if !strings.EqualFold(existing.Level, stats.Level) || !strings.EqualFold(existing.Team, stats.Team) {
+			continue
+		}
+		changes := diff(nil, "gp", existing.GamesPlayed, stats.GamesPlayed)
+		changes = diff(changes, "g", existing.Goals, stats.Goals)
+		changes = diff(changes, "a", existing.Assists, stats.Assists)
+		changes = diff(changes, "pts", existing.Points, stats.Points)
+		changes = diff(changes, "pim", existing.PenaltyMinutes, stats.PenaltyMinutes)
+		changes = diff(changes, "sv_pct", existing.SavePercentage, stats.SavePercentage)
+		changes = diff(changes, "gaa", existing.GoalsAgainstAverage, stats.GoalsAgainstAverage)
+		if len(changes) == 0 {
+			return Change{Action: Unchanged, ID: existing.ID.String()}, nil
+		}
+		stats.ID, stats.CreatedAt = existing.ID, existing.CreatedAt
+		stats.Level, stats.Team = existing.Level, existing.Team
+		return Change{Action: Update, ID: stats.ID.String(), Diff: changes}, tx.SaveProspectStats(ctx, &stats)
+	}
+	if err := tx.CreateProspectStats(ctx, &stats); err != nil {
+		return Change{}, err
+	}
+	return Change{Action: Insert, ID: stats.ID.String()}, nil
+}
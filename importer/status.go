@@ -0,0 +1,90 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+// statusNames maps the status names of common sources to the statuses
+var statusNames = map[string]models.ProspectStatus{
+	"UNSIGNED": models.Unsigned, "DRAFTED": models.Unsigned,
+	"SIGNED": models.SignedELC, "ELC": models.SignedELC, "SIGNED_ELC": models.SignedELC,
+	"AHL": models.AHL,
+	"NHL": models.NhlGraduated, "GRADUATED": models.NhlGraduated, "NHL_GRADUATED": models.NhlGraduated,
+	"EXPIRED": models.RightsExpired, "RIGHTS_EXPIRED": models.RightsExpired, "UFA": models.RightsExpired,
+	"RETIRED": models.Retired,
+}
+
+// ParseStatus returns the status of the name, "" if unset.
+func ParseStatus(value string) (models.ProspectStatus, error) {
+	name := strings.Join(strings.Fields(strings.ToUpper(strings.NewReplacer("-", " ", "_", " ").Replace(value))), "_")
+	if name == "" {
+		return "", nil
+	}
+	if s, ok := statusNames[name]; ok {
+		return s, nil
+	}
+	return "", fmt.Errorf("invalid status %q", value)
+}
+
+// ChangeStatus records that the prospect has the status from the effective date on and returns the changes.
+// A date before the current status's only adds to the history. A new current status applies the action
+// the prospect's league has for it, the prospect is changed but not saved.
+func ChangeStatus(ctx context.Context, tx storage.Repository, p *models.Prospect, status models.ProspectStatus, effective time.Time) ([]string, error) {
+	if !status.Valid() {
+		return nil, fmt.Errorf("invalid status %q", status)
+	}
+	effective = time.Date(effective.Year(), effective.Month(), effective.Day(), 0, 0, 0, 0, time.UTC)
+	if effective.After(time.Now()) {
+		return nil, fmt.Errorf("the effective date %s lies in the future", formatDate(&effective))
+	}
+	history, err := tx.ListProspectStatuses(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range history {
+		if c.Status == status && c.EffectiveDate.Equal(effective) {
+			return nil, nil
+		}
+	}
+	if p.StatusSince != nil && effective.Before(*p.StatusSince) {
+		if err := tx.AddProspectStatus(ctx, &models.ProspectStatusChange{ProspectID: p.ID, Status: status, EffectiveDate: effective}); err != nil {
+			return nil, err
+		}
+		return diff(nil, "status_history", "", fmt.Sprintf("%s %s", status, formatDate(&effective))), nil
+	}
+	if status == p.Status {
+		return nil, nil
+	}
+	if err := tx.AddProspectStatus(ctx, &models.ProspectStatusChange{ProspectID: p.ID, Status: status, EffectiveDate: effective}); err != nil {
+		return nil, err
+	}
+
+	changes := diff(nil, "status", p.Status, status)
+	p.Status = status
+	p.StatusSince = &effective
+	if p.LeagueID == nil || p.FranchiseID == nil {
+		return changes, nil
+	}
+	league, err := tx.FindLeague(ctx, *p.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+	switch league.StatusRules[status] {
+	case models.Release:
+		changes = diff(changes, "franchise", p.FranchiseID.String(), "")
+		p.LeagueID, p.FranchiseID, p.Protected = nil, nil, false
+		if err := tx.RemoveTradeBlockEntries(ctx, storage.TradeBlockFilter{AssetID: &p.ID}); err != nil {
+			return nil, err
+		}
+	case models.Unprotect:
+		changes = diff(changes, "protected", p.Protected, false)
+		p.Protected = false
+	}
+	return changes, nil
+}
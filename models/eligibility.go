@@ -0,0 +1,92 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// DraftYearOffsets bound the nhl draft year of a prospect relative to the year of the fantasy draft,
+// e.g. -1 and 0 allow the prospects of the nhl draft of the same and of the previous year.
+type DraftYearOffsets struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
+// Eligibility are the rules a prospect must meet to be drafted in a league, stored as a json object.
+// The zero value allows every prospect.
+type Eligibility struct {
+	DraftYears *DraftYearOffsets `json:"draftYears,omitempty"`
+	// AllowUndrafted keeps prospects without nhl draft year eligible despite the draft years
+	AllowUndrafted bool `json:"allowUndrafted,omitempty"`
+	// MaxAge is the highest age on the cut-off date of the draft year, 0 for no limit
+	MaxAge int `json:"maxAge,omitempty"`
+	// Positions are the eligible position codes, empty allows every position
+	Positions []Position `json:"positions,omitempty"`
+}
+
+// NhlDraftYears returns the range of eligible nhl draft years for the draft of the year.
+func (e Eligibility) NhlDraftYears(draftYear int) (from int, to int, ok bool) {
+	if e.DraftYears == nil {
+		return 0, 0, false
+	}
+	return draftYear + e.DraftYears.Min, draftYear + e.DraftYears.Max, true
+}
+
+// BornAfter returns the date eligible prospects are born after, prospects born on it turn MaxAge + 1
+// on September 15 of the draft year like the nhl's age cut-off. It is nil without age limit.
+func (e Eligibility) BornAfter(draftYear int) *time.Time {
+	if e.MaxAge <= 0 {
+		return nil
+	}
+	date := time.Date(draftYear-e.MaxAge-1, time.September, 15, 0, 0, 0, 0, time.UTC)
+	return &date
+}
+
+// Check returns why the prospect is not eligible for the draft of the year, nil if it is.
+// Prospects with unknown birthdate pass the age limit.
+func (e Eligibility) Check(p Prospect, draftYear int) error {
+	if from, to, ok := e.NhlDraftYears(draftYear); ok {
+		if p.NhlDraftYear == 0 && !e.AllowUndrafted {
+			return fmt.Errorf("prospect %v was not drafted by the nhl", p.ID)
+		}
+		if p.NhlDraftYear != 0 && (p.NhlDraftYear < from || p.NhlDraftYear > to) {
+			return fmt.Errorf("nhl draft year %d of prospect %v is not between %d and %d", p.NhlDraftYear, p.ID, from, to)
+		}
+	}
+	if after := e.BornAfter(draftYear); after != nil && p.Birthdate != nil {
+		y, m, d := p.Birthdate.Date()
+		if !time.Date(y, m, d, 0, 0, 0, 0, time.UTC).After(*after) {
+			return fmt.Errorf("prospect %v is older than %d", p.ID, e.MaxAge)
+		}
+	}
+	if len(e.Positions) > 0 {
+		for _, pos := range e.Positions {
+			if p.PositionCode == pos {
+				return nil
+			}
+		}
+		return fmt.Errorf("position %q of prospect %v is not eligible", p.PositionCode, p.ID)
+	}
+	return nil
+}
+
+func (e Eligibility) Value() (driver.Value, error) {
+	b, err := json.Marshal(e)
+	return string(b), err
+}
+
+func (e *Eligibility) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*e = Eligibility{}
+		return nil
+	case []byte:
+		return json.Unmarshal(v, e)
+	case string:
+		return json.Unmarshal([]byte(v), e)
+	default:
+		return fmt.Errorf("cannot scan %T into eligibility", value)
+	}
+}
@@ -12,12 +12,15 @@ type Franchise struct {
 	Name           string     `json:"name" gorm:"not null;type:string"`
 	UserID         uuid.UUID  `json:"userId" gorm:"not null;type:uuid;"`
 	UserName       string     `json:"userName" gorm:"not null;type:string;"`
-	FoundationYear string     `json:"foundationYear" gorm:"not null;type:string"`
-	LeagueID       uuid.UUID  `json:"leagueId" gorm:"not null;foreignKey:FranchiseID;constraint:OnUpdate:CASCADE;OnDelete:SET NULL"`
-	Prospects      []Prospect `json:"prospects" gorm:"foreignKey:FranchiseID;constraint:OnUpdate:CASCADE;OnDelete:SET NULL"`
-	Picks          []Pick     `json:"picks" gorm:"foreignKey:OwnerID;constraint:OnUpdate:CASCADE;OnDelete:SET NULL"`
+	FoundationYear int        `json:"foundationYear" gorm:"not null;type:int"`
+	LeagueID       uuid.UUID  `json:"leagueId" gorm:"not null;type:uuid"`
+	Prospects      []Prospect `json:"prospects" gorm:"foreignKey:FranchiseID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	Picks          []Pick     `json:"picks" gorm:"foreignKey:OwnerID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Orphan         bool       `json:"orphan" gorm:"not null;default:false"` // holds the picks of deleted franchises
+	LookingFor     string     `json:"lookingFor"`                           // what the franchise wants in trades
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
+	DeletedAt      gorm.DeletedAt `gorm:"index"`
 }
 
 func (franchise *Franchise) BeforeCreate(db *gorm.DB) error {
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	FranchiseCreated     = "created"
+	FranchiseUpdated     = "updated"
+	FranchiseTransferred = "transferred"
+	FranchiseDeleted     = "deleted"
+)
+
+// FranchiseHistory is the name and owner of a franchise after a change.
+type FranchiseHistory struct {
+	ID          uuid.UUID `json:"id" gorm:"primaryKey"`
+	FranchiseID uuid.UUID `json:"franchiseId" gorm:"not null;type:uuid"`
+	Change      string    `json:"change" gorm:"not null;type:string"`
+	Name        string    `json:"name" gorm:"not null;type:string"`
+	UserID      uuid.UUID `json:"userId" gorm:"not null;type:uuid"`
+	UserName    string    `json:"userName" gorm:"not null;type:string"`
+	CreatedAt   time.Time
+}
+
+func (history *FranchiseHistory) BeforeCreate(db *gorm.DB) error {
+	history.ID = uuid.New()
+	history.CreatedAt = time.Now().Local()
+	return nil
+}
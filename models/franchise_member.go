@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Role is the part a user plays in running a franchise.
+type Role string
+
+const (
+	Owner       Role = "owner"
+	CoOwner     Role = "co-owner"
+	AssistantGM Role = "assistant-gm"
+)
+
+// Permission is an action on a franchise that needs a role.
+type Permission string
+
+const (
+	// ManageFranchise allows renaming and updating the franchise
+	ManageFranchise Permission = "manage-franchise"
+	// ManageMembers allows adding and removing members, transferring and deleting the franchise
+	ManageMembers Permission = "manage-members"
+	Trade         Permission = "trade"
+	Draft         Permission = "draft"
+)
+
+var rolePermissions = map[Role][]Permission{
+	Owner:       {ManageFranchise, ManageMembers, Trade, Draft},
+	CoOwner:     {ManageFranchise, Trade, Draft},
+	AssistantGM: {Draft},
+}
+
+// Valid reports whether the role is one of the known roles.
+func (r Role) Valid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// Permissions returns the permissions of the role.
+func (r Role) Permissions() []Permission {
+	return rolePermissions[r]
+}
+
+// Can reports whether the role has the permission.
+func (r Role) Can(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// FranchiseMember is a user running a franchise. Every franchise has one owner, its UserID.
+type FranchiseMember struct {
+	ID          uuid.UUID `json:"id" gorm:"primaryKey"`
+	FranchiseID uuid.UUID `json:"franchiseId" gorm:"not null;type:uuid"`
+	UserID      uuid.UUID `json:"userId" gorm:"not null;type:uuid"`
+	UserName    string    `json:"userName" gorm:"not null;type:string"`
+	Role        Role      `json:"role" gorm:"not null;type:string"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (member *FranchiseMember) BeforeCreate(db *gorm.DB) error {
+	member.ID = uuid.New()
+	member.CreatedAt = time.Now().Local()
+	return nil
+}
+
+func (member *FranchiseMember) BeforeUpdate(db *gorm.DB) error {
+	member.UpdatedAt = time.Now().Local()
+	return nil
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Invitation reserves a franchise slot in a league until the invitee claims it.
+type Invitation struct {
+	ID            uuid.UUID  `json:"id" gorm:"primaryKey"`
+	LeagueID      uuid.UUID  `json:"leagueId" gorm:"not null;type:uuid"`
+	Email         string     `json:"email" gorm:"not null;type:string"`
+	Name          string     `json:"name" gorm:"type:string"`
+	FranchiseName string     `json:"franchiseName" gorm:"type:string"`
+	InvitedByID   uuid.UUID  `json:"invitedById" gorm:"not null;type:uuid"`
+	ExpiresAt     time.Time  `json:"expiresAt" gorm:"not null"`
+	FranchiseID   *uuid.UUID `json:"franchiseId" gorm:"type:uuid"`
+	ClaimedByID   *uuid.UUID `json:"claimedById" gorm:"type:uuid"`
+	ClaimedAt     *time.Time `json:"claimedAt"`
+	RevokedAt     *time.Time `json:"revokedAt"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Open reports whether the invitation still reserves its slot and can be claimed.
+func (invitation *Invitation) Open(now time.Time) bool {
+	return invitation.ClaimedAt == nil && invitation.RevokedAt == nil && now.Before(invitation.ExpiresAt)
+}
+
+func (invitation *Invitation) BeforeCreate(db *gorm.DB) error {
+	invitation.ID = uuid.New()
+	invitation.CreatedAt = time.Now().Local()
+	return nil
+}
+
+func (invitation *Invitation) BeforeUpdate(db *gorm.DB) error {
+	invitation.UpdatedAt = time.Now().Local()
+	return nil
+}
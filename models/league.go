@@ -14,13 +14,19 @@ type League struct {
 	AdminID           uuid.UUID   `json:"userId" gorm:"not null;type:uuid"`
 	Commissioner      string      `json:"commissioner" gorm:"not null;type:string"`
 	CommissionerID    uuid.UUID   `json:"commissionerID" gorm:"not null;type:uuid"`
-	FoundationYear    string      `json:"foundationYear" gorm:"not null;type:string"`
+	FoundationYear    int         `json:"foundationYear" gorm:"not null;type:int"`
 	MaxFranchises     int         `json:"maxFranchise" gorm:"not null;type:int"`
 	MaxProspects      int         `json:"maxProspects" gorm:"not null;type:int"`
 	DraftRightsGoalie int         `json:"DraftRightsGoalie" gorm:"not null;type:int"`
 	DraftRightsSkater int         `json:"draftRightsSkater" gorm:"not null;type:int"`
 	DraftRounds       int         `json:"draftRounds" gorm:"not null;type:int;default:2;"`
-	Franchises        []Franchise `json:"franchises" gorm:"foreignKey:LeagueID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	Season            int         `json:"season" gorm:"not null;type:int;default:0"`          // year of the season's draft, 0 for leagues from before seasons
+	FuturePickYears   int         `json:"futurePickYears" gorm:"not null;type:int;default:3"` // picks exist this many drafts after the season's
+	ArchivedAt        *time.Time  `json:"archivedAt"`                                         // archived leagues are read-only
+	ClonedFromID      *uuid.UUID  `json:"clonedFromId" gorm:"type:uuid"`
+	StatusRules       StatusRules `json:"statusRules" gorm:"type:jsonb"` // what happens to rostered prospects changing status
+	Eligibility       Eligibility `json:"eligibility" gorm:"type:jsonb"` // the prospects franchises may draft
+	Franchises        []Franchise `json:"franchises" gorm:"foreignKey:LeagueID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 	Prospects         []Prospect  `json:"prospects" gorm:"foreignKey:LeagueID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
@@ -9,20 +9,33 @@ import (
 
 type Pick struct {
 	ID               uuid.UUID  `json:"id" gorm:"primaryKey"`
-	DraftYear        string     `json:"draftYear" gorm:"type:integer"`
-	DraftRound       string     `json:"draftRound" gorm:"type:integer"`
-	DraftPickOverall *string    `json:"draftPickOverall" gorm:"type:integer;default:null"`
-	DraftPickInRound *string    `json:"draftPickInRound" gorm:"type:integer;default:null"`
+	DraftYear        int        `json:"draftYear" gorm:"type:integer"`
+	DraftRound       int        `json:"draftRound" gorm:"type:integer"`
+	DraftPickOverall *int       `json:"draftPickOverall" gorm:"type:integer;default:null"`
+	DraftPickInRound *int       `json:"draftPickInRound" gorm:"type:integer;default:null"`
 	ProspectID       *uuid.UUID `json:"prospectID" gorm:"foreignKey:ProspectID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
-	OwnerID          *uuid.UUID `json:"ownerID" gorm:"foreignKey:FranchiseID;constraint:OnUpdate:CASCADE;OnDelete:SET NULL"`
+	OwnerID          *uuid.UUID `json:"ownerID" gorm:"foreignKey:FranchiseID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	OwnerName        string     `json:"ownerName"`
-	LastOwnerID      *uuid.UUID `json:"lastOwnerID" gorm:"foreignKey:FranchiseID;constraint:OnUpdate:CASCADE;OnDelete:SET NULL"`
+	LastOwnerID      *uuid.UUID `json:"lastOwnerID" gorm:"foreignKey:FranchiseID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
 	LastOwnerName    string     `json:"lastOwnerName"`
-	OriginID         *uuid.UUID `json:"originID" gorm:"foreignKey:FranchiseID;constraint:OnUpdate:CASCADE;OnDelete:SET NULL"`
+	OriginID         *uuid.UUID `json:"originID" gorm:"foreignKey:FranchiseID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
 	OriginName       string     `json:"originName"`
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
-	DeletedAt        time.Time
+	// Slot orders the pick in its round before supplemental and forfeited picks are accounted for,
+	// it is the lottery position or the slot a supplemental pick was issued for. Supplemental picks
+	// without a slot are placed at the end of the round.
+	Slot         *int       `json:"slot" gorm:"type:integer;default:null"`
+	Supplemental bool       `json:"supplemental" gorm:"not null;default:false"`
+	ForfeitedAt  *time.Time `json:"forfeitedAt"`
+	// Reason is why the commissioner issued or forfeited the pick
+	Reason    string `json:"reason"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt time.Time
+}
+
+// Forfeited reports whether the pick is void, it stays for the history but is not part of the draft order.
+func (pick *Pick) Forfeited() bool {
+	return pick.ForfeitedAt != nil
 }
 
 func (pick *Pick) BeforeCreate(db *gorm.DB) error {
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ConditionStatus string
+
+const (
+	ConditionPending ConditionStatus = "pending"
+	// ConditionConveyed means the pick went to the receiving franchise
+	ConditionConveyed ConditionStatus = "conveyed"
+	// ConditionConverted means the protection applied, the substitute pick went to the receiving franchise instead
+	ConditionConverted ConditionStatus = "converted"
+	// ConditionExtinguished means the protection applied and nothing is conveyed
+	ConditionExtinguished ConditionStatus = "extinguished"
+)
+
+// PickCondition is a pick traded with a protection. The pick stays with the trading
+// franchise until its position in the round is set, it is conveyed if the position is
+// worse than ProtectedTop. Otherwise the substitute pick is conveyed, if there is one.
+type PickCondition struct {
+	ID               uuid.UUID       `json:"id" gorm:"primaryKey"`
+	LeagueID         uuid.UUID       `json:"leagueId" gorm:"not null;type:uuid"`
+	PickID           uuid.UUID       `json:"pickId" gorm:"not null;type:uuid"`
+	FromID           uuid.UUID       `json:"fromId" gorm:"not null;type:uuid"`
+	FromName         string          `json:"fromName"`
+	ToID             uuid.UUID       `json:"toId" gorm:"not null;type:uuid"`
+	ToName           string          `json:"toName"`
+	ProtectedTop     int             `json:"protectedTop" gorm:"not null;type:integer"`
+	SubstitutePickID *uuid.UUID      `json:"substitutePickId" gorm:"type:uuid"`
+	Status           ConditionStatus `json:"status" gorm:"not null;type:string"`
+	ResolvedAt       *time.Time      `json:"resolvedAt"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// Protected reports whether the protection applies to a pick at the position in its round.
+func (condition *PickCondition) Protected(pickInRound int) bool {
+	return pickInRound <= condition.ProtectedTop
+}
+
+func (condition *PickCondition) BeforeCreate(db *gorm.DB) error {
+	condition.ID = uuid.New()
+	condition.CreatedAt = time.Now().Local()
+	return nil
+}
+
+func (condition *PickCondition) BeforeUpdate(db *gorm.DB) error {
+	condition.UpdatedAt = time.Now().Local()
+	return nil
+}
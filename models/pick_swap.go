@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SwapStatus string
+
+const (
+	SwapPending SwapStatus = "pending"
+	// SwapExercised means the holder got the better pick of the granting franchise
+	SwapExercised SwapStatus = "exercised"
+	// SwapDeclined means the holder's own pick was the better one and both picks stayed
+	SwapDeclined SwapStatus = "declined"
+)
+
+// PickSwap is the right of the holding franchise to swap its pick with the pick of the
+// granting franchise. It is resolved once both picks have their overall position, the
+// resolved swap is the ledger entry of the exchange.
+type PickSwap struct {
+	ID            uuid.UUID  `json:"id" gorm:"primaryKey"`
+	LeagueID      uuid.UUID  `json:"leagueId" gorm:"not null;type:uuid"`
+	HolderID      uuid.UUID  `json:"holderId" gorm:"not null;type:uuid"`
+	HolderName    string     `json:"holderName"`
+	HolderPickID  uuid.UUID  `json:"holderPickId" gorm:"not null;type:uuid"`
+	GrantorID     uuid.UUID  `json:"grantorId" gorm:"not null;type:uuid"`
+	GrantorName   string     `json:"grantorName"`
+	GrantorPickID uuid.UUID  `json:"grantorPickId" gorm:"not null;type:uuid"`
+	Status        SwapStatus `json:"status" gorm:"not null;type:string"`
+	ResolvedAt    *time.Time `json:"resolvedAt"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (swap *PickSwap) BeforeCreate(db *gorm.DB) error {
+	swap.ID = uuid.New()
+	swap.CreatedAt = time.Now().Local()
+	return nil
+}
+
+func (swap *PickSwap) BeforeUpdate(db *gorm.DB) error {
+	swap.UpdatedAt = time.Now().Local()
+	return nil
+}
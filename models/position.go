@@ -0,0 +1,23 @@
+package models
+
+// Position is the position code of a prospect as used by the nhl.
+type Position string
+
+const (
+	Center     Position = "C"
+	LeftWing   Position = "L"
+	RightWing  Position = "R"
+	Defenseman Position = "D"
+	Goalie     Position = "G"
+	// Unknown is the position of prospects whose position isn't known
+	Unknown Position = ""
+)
+
+// Valid reports whether the position is one of the known codes or unknown, the codes the database allows.
+func (p Position) Valid() bool {
+	switch p {
+	case Center, LeftWing, RightWing, Defenseman, Goalie, Unknown:
+		return true
+	}
+	return false
+}
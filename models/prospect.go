@@ -8,23 +8,25 @@ import (
 )
 
 type Prospect struct {
-	ID                  uuid.UUID  `json:"id" gorm:"primaryKey"`
-	FullName            string     `json:"fullName" gorm:"not null;type:string"`
-	FirstName           string     `json:"firstName" gorm:"not null;type:string"`
-	LastName            string     `json:"lastName" gorm:"not null;type:string"`
-	NhlTeam             string     `json:"nhlTeamName" gorm:"not null;type:string"`
-	Birthdate           string     `json:"birthdate" gorm:"not null;type:string"`
-	Height              string     `json:"height" gorm:"not null;type:string"`
-	Weight              string     `json:"weight" gorm:"not null;type:string"`
-	NhlDraftYear        string     `json:"nhlYear" gorm:"not null;type:string"`
-	NhlDraftRound       string     `json:"nhlDraftRound" gorm:"not null;type:string"`
-	NhlDraftPickOverall string     `json:"nhlDraftPickOverall" gorm:"not null;type:string"`
-	NhlDraftPickInRound string     `json:"nhlDraftPickInRound" gorm:"not null;type:string"`
-	PositionCode        string     `json:"positionCode" gorm:"not null;type:string"`
-	Protected           bool       `json:"protected" gorm:"not null;type:bool;default:false"`
-	LeagueID            *uuid.UUID `json:"leagueID" gorm:"foreignKey:LeagueID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
-	FranchiseID         *uuid.UUID `json:"franchiseID" gorm:"foreignKey:FranchiseID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
-	Pick                *Pick      `json:"pick" gorm:"foreignKey:ProspectID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	ID                  uuid.UUID      `json:"id" gorm:"primaryKey"`
+	FullName            string         `json:"fullName" gorm:"not null;type:string"`
+	FirstName           string         `json:"firstName" gorm:"not null;type:string"`
+	LastName            string         `json:"lastName" gorm:"not null;type:string"`
+	NhlTeam             string         `json:"nhlTeamName" gorm:"not null;type:string"`
+	Birthdate           *time.Time     `json:"birthdate" gorm:"type:date"`
+	Height              int            `json:"height" gorm:"not null;type:int"` // inches
+	Weight              int            `json:"weight" gorm:"not null;type:int"` // pounds
+	NhlDraftYear        int            `json:"nhlYear" gorm:"not null;type:int"`
+	NhlDraftRound       int            `json:"nhlDraftRound" gorm:"not null;type:int"`
+	NhlDraftPickOverall int            `json:"nhlDraftPickOverall" gorm:"not null;type:int"`
+	NhlDraftPickInRound int            `json:"nhlDraftPickInRound" gorm:"not null;type:int"`
+	PositionCode        Position       `json:"positionCode" gorm:"not null;type:string"`
+	Protected           bool           `json:"protected" gorm:"not null;type:bool;default:false"`
+	Status              ProspectStatus `json:"status" gorm:"not null;type:string;default:UNSIGNED"`
+	StatusSince         *time.Time     `json:"statusSince" gorm:"type:date"` // effective date of the status, nil if never changed
+	LeagueID            *uuid.UUID     `json:"leagueID" gorm:"foreignKey:LeagueID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	FranchiseID         *uuid.UUID     `json:"franchiseID" gorm:"foreignKey:FranchiseID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	Pick                *Pick          `json:"pick" gorm:"foreignKey:ProspectID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 }
@@ -32,6 +34,9 @@ type Prospect struct {
 func (prospect *Prospect) BeforeCreate(db *gorm.DB) error {
 	prospect.ID = uuid.New()
 	prospect.CreatedAt = time.Now().Local()
+	if prospect.Status == "" {
+		prospect.Status = Unsigned
+	}
 	return nil
 }
 
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ProspectSeasonStats are the statistics of a prospect's season with a team.
+type ProspectSeasonStats struct {
+	ID         uuid.UUID `json:"id" gorm:"primaryKey"`
+	ProspectID uuid.UUID `json:"prospectId" gorm:"not null;type:uuid"`
+	// Season is the year the season starts, 2023 for 2023-24
+	Season int `json:"season" gorm:"not null;type:int"`
+	// Level is the league or level the season was played in, e.g. OHL or U20
+	Level          string `json:"level" gorm:"not null;type:string"`
+	Team           string `json:"team" gorm:"not null;type:string"`
+	GamesPlayed    int    `json:"gamesPlayed" gorm:"not null;type:int"`
+	Goals          int    `json:"goals" gorm:"not null;type:int"`
+	Assists        int    `json:"assists" gorm:"not null;type:int"`
+	Points         int    `json:"points" gorm:"not null;type:int"`
+	PenaltyMinutes int    `json:"penaltyMinutes" gorm:"not null;type:int"`
+	// SavePercentage and GoalsAgainstAverage are only set for goalies
+	SavePercentage      *float64 `json:"savePercentage"`
+	GoalsAgainstAverage *float64 `json:"goalsAgainstAverage"`
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+func (stats *ProspectSeasonStats) BeforeCreate(db *gorm.DB) error {
+	stats.ID = uuid.New()
+	stats.CreatedAt = time.Now().Local()
+	return nil
+}
+
+func (stats *ProspectSeasonStats) BeforeUpdate(db *gorm.DB) error {
+	stats.UpdatedAt = time.Now().Local()
+	return nil
+}
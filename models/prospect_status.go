@@ -0,0 +1,89 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ProspectStatus is the contract or career status of a prospect.
+type ProspectStatus string
+
+const (
+	Unsigned      ProspectStatus = "UNSIGNED"
+	SignedELC     ProspectStatus = "SIGNED_ELC"
+	AHL           ProspectStatus = "AHL"
+	NhlGraduated  ProspectStatus = "NHL_GRADUATED"
+	RightsExpired ProspectStatus = "RIGHTS_EXPIRED"
+	Retired       ProspectStatus = "RETIRED"
+)
+
+// Valid reports whether the status is one of the known statuses.
+func (s ProspectStatus) Valid() bool {
+	switch s {
+	case Unsigned, SignedELC, AHL, NhlGraduated, RightsExpired, Retired:
+		return true
+	}
+	return false
+}
+
+// ProspectStatusChange records the status a prospect has from the effective date on.
+type ProspectStatusChange struct {
+	ID            uuid.UUID      `json:"id" gorm:"primaryKey"`
+	ProspectID    uuid.UUID      `json:"prospectId" gorm:"not null;type:uuid"`
+	Status        ProspectStatus `json:"status" gorm:"not null;type:string"`
+	EffectiveDate time.Time      `json:"effectiveDate" gorm:"not null;type:date"`
+	CreatedAt     time.Time
+}
+
+func (change *ProspectStatusChange) BeforeCreate(db *gorm.DB) error {
+	change.ID = uuid.New()
+	change.CreatedAt = time.Now().Local()
+	return nil
+}
+
+// StatusAction is what happens to a rostered prospect that changes to a status.
+type StatusAction string
+
+const (
+	// Keep leaves the prospect on the roster
+	Keep StatusAction = ""
+	// Release returns the prospect to the pool, the pick keeps recording the draft
+	Release StatusAction = "release"
+	// Unprotect lifts the protection of the prospect
+	Unprotect StatusAction = "unprotect"
+)
+
+// StatusRules are the actions of a league by status, stored as a json object.
+type StatusRules map[ProspectStatus]StatusAction
+
+// DefaultStatusRules release retired prospects and prospects whose rights expired.
+func DefaultStatusRules() StatusRules {
+	return StatusRules{Retired: Release, RightsExpired: Release}
+}
+
+func (r StatusRules) Value() (driver.Value, error) {
+	if r == nil {
+		return "{}", nil
+	}
+	b, err := json.Marshal(map[ProspectStatus]StatusAction(r))
+	return string(b), err
+}
+
+func (r *StatusRules) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*r = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, r)
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	default:
+		return fmt.Errorf("cannot scan %T into status rules", value)
+	}
+}
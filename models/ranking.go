@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ProspectRanking is the rank of a prospect in a ranking for a draft. Rankings are imported
+// from a named source or submitted by the owners of a franchise.
+type ProspectRanking struct {
+	ID uuid.UUID `json:"id" gorm:"primaryKey"`
+	// Year is the year of the draft the ranking is for
+	Year int `json:"year" gorm:"not null;type:int"`
+	// Source is the name of an imported ranking, "" for rankings of a franchise
+	Source      string     `json:"source" gorm:"not null;type:string"`
+	FranchiseID *uuid.UUID `json:"franchiseId" gorm:"type:uuid"`
+	ProspectID  uuid.UUID  `json:"prospectId" gorm:"not null;type:uuid"`
+	Rank        int        `json:"rank" gorm:"not null;type:int"`
+	CreatedAt   time.Time
+}
+
+func (ranking *ProspectRanking) BeforeCreate(db *gorm.DB) error {
+	ranking.ID = uuid.New()
+	ranking.CreatedAt = time.Now().Local()
+	return nil
+}
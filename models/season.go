@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SeasonStatus string
+
+const (
+	SeasonActive SeasonStatus = "active"
+	SeasonClosed SeasonStatus = "closed"
+)
+
+// Season is a year of a league, it ends with the draft of its Year. A league has one active season.
+type Season struct {
+	ID        uuid.UUID    `json:"id" gorm:"primaryKey"`
+	LeagueID  uuid.UUID    `json:"leagueId" gorm:"not null;type:uuid"`
+	Year      int          `json:"year" gorm:"not null;type:int"`
+	StartDate time.Time    `json:"startDate" gorm:"not null;type:date"`
+	EndDate   time.Time    `json:"endDate" gorm:"not null;type:date"`
+	Status    SeasonStatus `json:"status" gorm:"not null;type:string"`
+	ClosedAt  *time.Time   `json:"closedAt"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (season *Season) BeforeCreate(db *gorm.DB) error {
+	season.ID = uuid.New()
+	season.CreatedAt = time.Now().Local()
+	return nil
+}
+
+func (season *Season) BeforeUpdate(db *gorm.DB) error {
+	season.UpdatedAt = time.Now().Local()
+	return nil
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Standing is the record of a franchise in the season of its league that ends with the draft of Year.
+// Rank orders the standings of a season from the first place on.
+type Standing struct {
+	ID          uuid.UUID `json:"id" gorm:"primaryKey"`
+	LeagueID    uuid.UUID `json:"leagueId" gorm:"not null;type:uuid"`
+	FranchiseID uuid.UUID `json:"franchiseId" gorm:"not null;type:uuid"`
+	Year        int       `json:"year" gorm:"not null;type:int"`
+	Wins        int       `json:"wins" gorm:"not null;type:int"`
+	Losses      int       `json:"losses" gorm:"not null;type:int"`
+	Points      int       `json:"points" gorm:"not null;type:int"`
+	Rank        int       `json:"rank" gorm:"not null;type:int"`
+	CreatedAt   time.Time
+}
+
+func (standing *Standing) BeforeCreate(db *gorm.DB) error {
+	standing.ID = uuid.New()
+	standing.CreatedAt = time.Now().Local()
+	return nil
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// TradeBlockEntry is a prospect or a pick its franchise is willing to trade.
+type TradeBlockEntry struct {
+	ID          uuid.UUID  `json:"id" gorm:"primaryKey"`
+	LeagueID    uuid.UUID  `json:"leagueId" gorm:"not null;type:uuid"`
+	FranchiseID uuid.UUID  `json:"franchiseId" gorm:"not null;type:uuid"`
+	ProspectID  *uuid.UUID `json:"prospectId" gorm:"type:uuid"`
+	PickID      *uuid.UUID `json:"pickId" gorm:"type:uuid"`
+	// Note is what the franchise asks for
+	Note      string `json:"note"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// AssetID is the id of the listed prospect or pick.
+func (entry *TradeBlockEntry) AssetID() uuid.UUID {
+	if entry.ProspectID != nil {
+		return *entry.ProspectID
+	}
+	if entry.PickID != nil {
+		return *entry.PickID
+	}
+	return uuid.Nil
+}
+
+func (entry *TradeBlockEntry) BeforeCreate(db *gorm.DB) error {
+	entry.ID = uuid.New()
+	entry.CreatedAt = time.Now().Local()
+	return nil
+}
+
+func (entry *TradeBlockEntry) BeforeUpdate(db *gorm.DB) error {
+	entry.UpdatedAt = time.Now().Local()
+	return nil
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// WatchlistEntry is a prospect on the private watchlist of a user.
+type WatchlistEntry struct {
+	ID         uuid.UUID `json:"id" gorm:"primaryKey"`
+	UserID     uuid.UUID `json:"userId" gorm:"not null;type:uuid"`
+	ProspectID uuid.UUID `json:"prospectId" gorm:"not null;type:uuid"`
+	Note       string    `json:"note"`
+	Tags       Tags      `json:"tags" gorm:"type:jsonb"`
+	// Rank is the user's ranking of the prospect, nil is unranked
+	Rank      *int `json:"rank"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (entry *WatchlistEntry) BeforeCreate(db *gorm.DB) error {
+	entry.ID = uuid.New()
+	entry.CreatedAt = time.Now().Local()
+	return nil
+}
+
+func (entry *WatchlistEntry) BeforeUpdate(db *gorm.DB) error {
+	entry.UpdatedAt = time.Now().Local()
+	return nil
+}
+
+// Tags are stored as a json array.
+type Tags []string
+
+// Has reports whether the tag is one of the tags.
+func (t Tags) Has(tag string) bool {
+	for _, s := range t {
+		if s == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (t Tags) Value() (driver.Value, error) {
+	if t == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal([]string(t))
+	return string(b), err
+}
+
+func (t *Tags) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*t = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, t)
+	case string:
+		return json.Unmarshal([]byte(v), t)
+	default:
+		return fmt.Errorf("cannot scan %T into tags", value)
+	}
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/importer"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Conversions between the typed models and the messages. Older clients only
+// know the string fields, so responses fill them next to the typed fields and
+// requests fall back to them if the typed field is unset.
+
+const dateLayout = "2006-01-02"
+
+// feetAndInches matches heights like 6' 1"
+var feetAndInches = regexp.MustCompile(`^(\d+)'\s*(\d+)"?$`)
+
+var positions = map[models.Position]pb.Position{
+	models.Center:     pb.Position_POSITION_CENTER,
+	models.LeftWing:   pb.Position_POSITION_LEFT_WING,
+	models.RightWing:  pb.Position_POSITION_RIGHT_WING,
+	models.Defenseman: pb.Position_POSITION_DEFENSEMAN,
+	models.Goalie:     pb.Position_POSITION_GOALIE,
+}
+
+// number returns the typed value or parses the legacy one, both unset is 0
+func number(typed int32, legacy string, name string) (int, error) {
+	if typed != 0 {
+		return int(typed), nil
+	}
+	legacy = strings.TrimSpace(legacy)
+	if legacy == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(legacy)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", name, legacy)
+	}
+	return n, nil
+}
+
+// height returns the height in inches, legacy heights are either inches or feet and inches
+func height(typed int32, legacy string) (int, error) {
+	if m := feetAndInches.FindStringSubmatch(strings.TrimSpace(legacy)); typed == 0 && m != nil {
+		feet, _ := strconv.Atoi(m[1])
+		inches, _ := strconv.Atoi(m[2])
+		return feet*12 + inches, nil
+	}
+	return number(typed, legacy, "height")
+}
+
+// birthdate returns the date of birth, nil if unset. Legacy dates are read like imported ones.
+func birthdate(typed *timestamppb.Timestamp, legacy string) (*time.Time, error) {
+	if typed != nil {
+		t := typed.AsTime().UTC().Truncate(24 * time.Hour)
+		return &t, nil
+	}
+	legacy = strings.TrimSpace(legacy)
+	t, err := importer.ParseDate(legacy)
+	if err != nil && len(legacy) > len(dateLayout) {
+		t, err = importer.ParseDate(legacy[:len(dateLayout)])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid birthdate %q", legacy)
+	}
+	return t, nil
+}
+
+// position returns the position of the enum or the legacy code, "" if unset
+func position(typed pb.Position, legacy string) (models.Position, error) {
+	for p, v := range positions {
+		if v == typed {
+			return p, nil
+		}
+	}
+	p, err := importer.ParsePosition(legacy)
+	if err != nil {
+		return "", fmt.Errorf("invalid position code %q", legacy)
+	}
+	return p, nil
+}
+
+func legacyNumber(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
+
+func legacyHeight(inches int) string {
+	if inches == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d' %d\"", inches/12, inches%12)
+}
+
+func legacyProtected(protected bool) string {
+	if protected {
+		return "yes"
+	}
+	return "no"
+}
+
+func dateToPb(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func dateString(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(dateLayout)
+}
+
+func idString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func idValue(id *uuid.UUID) uuid.UUID {
+	if id == nil {
+		return uuid.Nil
+	}
+	return *id
+}
+
+func optionalNumber(n *int) int {
+	if n == nil {
+		return 0
+	}
+	return *n
+}
+
+func pickToPb(p models.Pick) *pb.Pick {
+	pick := &pb.Pick{
+		ID:               p.ID.String(),
+		DraftYear:        legacyNumber(p.DraftYear),
+		DraftRound:       legacyNumber(p.DraftRound),
+		DraftPickInRound: legacyNumber(optionalNumber(p.DraftPickInRound)),
+		DraftPickOverall: legacyNumber(optionalNumber(p.DraftPickOverall)),
+		ProspectID:       idString(p.ProspectID),
+		OwnerID:          idString(p.OwnerID),
+		OwnerName:        p.OwnerName,
+		LastOwnerID:      idString(p.LastOwnerID),
+		LastOwnerName:    p.LastOwnerName,
+		OriginID:         idString(p.OriginID),
+		OriginName:       p.OriginName,
+		Year:             int32(p.DraftYear),
+		Round:            int32(p.DraftRound),
+		PickOverall:      int32(optionalNumber(p.DraftPickOverall)),
+		PickInRound:      int32(optionalNumber(p.DraftPickInRound)),
+		Slot:             int32(optionalNumber(p.Slot)),
+		Supplemental:     p.Supplemental,
+		Forfeited:        p.Forfeited(),
+		Reason:           p.Reason,
+	}
+	if p.ForfeitedAt != nil {
+		pick.ForfeitedAt = timestamppb.New(*p.ForfeitedAt)
+	}
+	return pick
+}
+
+func prospectToPb(p models.Prospect) *pb.Prospect {
+	pick := &pb.Pick{}
+	if p.Pick != nil {
+		pick = pickToPb(*p.Pick)
+	}
+	return &pb.Prospect{
+		ID:                  p.ID.String(),
+		FullName:            p.FullName,
+		FirstName:           p.FirstName,
+		LastName:            p.LastName,
+		NhlTeam:             p.NhlTeam,
+		Birthdate:           dateString(p.Birthdate),
+		Height:              legacyHeight(p.Height),
+		Weight:              legacyNumber(p.Weight),
+		PositionCode:        string(p.PositionCode),
+		NhlDraftYear:        legacyNumber(p.NhlDraftYear),
+		NhlDraftRound:       legacyNumber(p.NhlDraftRound),
+		NhlPickInRound:      legacyNumber(p.NhlDraftPickInRound),
+		NhlDraftPickOverall: legacyNumber(p.NhlDraftPickOverall),
+		LeagueID:            idString(p.LeagueID),
+		FranchiseID:         idString(p.FranchiseID),
+		Pick:                pick,
+		Protected:           legacyProtected(p.Protected),
+		Position:            positions[p.PositionCode],
+		DateOfBirth:         dateToPb(p.Birthdate),
+		HeightInches:        int32(p.Height),
+		WeightPounds:        int32(p.Weight),
+		NhlDraft: &pb.NhlDraft{
+			Year:        int32(p.NhlDraftYear),
+			Round:       int32(p.NhlDraftRound),
+			PickOverall: int32(p.NhlDraftPickOverall),
+			PickInRound: int32(p.NhlDraftPickInRound),
+		},
+		IsProtected: p.Protected,
+		Status:      statuses[p.Status],
+		StatusSince: dateToPb(p.StatusSince),
+	}
+}
+
+// prospectFromPb reads a prospect of a create request
+func prospectFromPb(req *pb.CreateProspect) (models.Prospect, error) {
+	var err error
+	prospect := models.Prospect{
+		FullName:  req.FullName,
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+		NhlTeam:   req.NhlTeam,
+	}
+	draft := req.NhlDraft
+	if draft == nil {
+		draft = &pb.NhlDraft{}
+	}
+
+	if prospect.Birthdate, err = birthdate(req.DateOfBirth, req.Birthdate); err != nil {
+		return prospect, err
+	}
+	if prospect.Height, err = height(req.HeightInches, req.Height); err != nil {
+		return prospect, err
+	}
+	if prospect.Weight, err = number(req.WeightPounds, req.Weight, "weight"); err != nil {
+		return prospect, err
+	}
+	if prospect.NhlDraftYear, err = number(draft.Year, req.DraftYear, "nhl draft year"); err != nil {
+		return prospect, err
+	}
+	if prospect.NhlDraftRound, err = number(draft.Round, req.NhlDraftRound, "nhl draft round"); err != nil {
+		return prospect, err
+	}
+	if prospect.NhlDraftPickOverall, err = number(draft.PickOverall, req.NhlDraftPickOverall, "nhl draft pick overall"); err != nil {
+		return prospect, err
+	}
+	if prospect.NhlDraftPickInRound, err = number(draft.PickInRound, req.NhlDraftPickInRound, "nhl draft pick in round"); err != nil {
+		return prospect, err
+	}
+	if prospect.PositionCode, err = position(req.Position, req.PositionCode); err != nil {
+		return prospect, err
+	}
+	return prospect, nil
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+)
+
+func TestHeight(t *testing.T) {
+	var tests = []struct {
+		typed    int32
+		legacy   string
+		expected int
+	}{
+		{0, `6' 1"`, 73},
+		{0, `5'11`, 71},
+		{0, "74", 74},
+		{72, `6' 1"`, 72},
+		{0, "", 0},
+	}
+	for _, test := range tests {
+		actual, err := height(test.typed, test.legacy)
+		if err != nil {
+			t.Errorf("Parsing height %q failed: %v", test.legacy, err)
+		}
+		if actual != test.expected {
+			t.Errorf("Height %d not equal to expected %d for %q", actual, test.expected, test.legacy)
+		}
+	}
+	if _, err := height(0, "tall"); err == nil {
+		t.Error("Expected an error for an invalid height")
+	}
+}
+
+func TestBirthdate(t *testing.T) {
+	date, err := birthdate(nil, "2004-05-12T00:00:00Z")
+	if err != nil {
+		t.Fatalf("Parsing birthdate failed: %v", err)
+	}
+	if dateString(date) != "2004-05-12" {
+		t.Errorf("Birthdate %q not equal to expected %q", dateString(date), "2004-05-12")
+	}
+	if date, _ := birthdate(dateToPb(date), ""); dateString(date) != "2004-05-12" {
+		t.Errorf("Typed birthdate %q not equal to expected %q", dateString(date), "2004-05-12")
+	}
+	if date, err := birthdate(nil, ""); date != nil || err != nil {
+		t.Errorf("Expected no birthdate, got %v, %v", date, err)
+	}
+	for _, legacy := range []string{"12.05.2004", "2004/05/12", "2004-05-12 00:00:00"} {
+		if date, err := birthdate(nil, legacy); err != nil || dateString(date) != "2004-05-12" {
+			t.Errorf("Legacy birthdate %q read as %q: %v", legacy, dateString(date), err)
+		}
+	}
+	if _, err := birthdate(nil, "May 2004"); err == nil {
+		t.Error("Expected an error for an invalid birthdate")
+	}
+}
+
+func TestPosition(t *testing.T) {
+	if p, _ := position(pb.Position_POSITION_GOALIE, "C"); p != models.Goalie {
+		t.Errorf("Position %q not equal to expected %q", p, models.Goalie)
+	}
+	if p, _ := position(pb.Position_POSITION_UNSPECIFIED, "d"); p != models.Defenseman {
+		t.Errorf("Position %q not equal to expected %q", p, models.Defenseman)
+	}
+	if p, err := position(pb.Position_POSITION_UNSPECIFIED, " "); err != nil || p != models.Unknown {
+		t.Errorf("Position %q of an unset code not unknown: %v", p, err)
+	}
+	if p, _ := position(pb.Position_POSITION_UNSPECIFIED, "lw"); p != models.LeftWing {
+		t.Errorf("Position %q not equal to expected %q", p, models.LeftWing)
+	}
+	if p, _ := position(pb.Position_POSITION_UNSPECIFIED, "RD"); p != models.Defenseman {
+		t.Errorf("Position %q not equal to expected %q", p, models.Defenseman)
+	}
+	if _, err := position(pb.Position_POSITION_UNSPECIFIED, "X"); err == nil {
+		t.Error("Expected an error for an invalid position code")
+	}
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) GetPickConditions(ctx context.Context, req *pb.GetLeagueRequest) (*pb.PickConditionsResponse, error) {
+	lId, err := uuid.Parse(req.LeagueId)
+	if err != nil {
+		return &pb.PickConditionsResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.LeagueId),
+		}, nil
+	}
+
+	conditions, err := s.R.ListPickConditions(ctx, storage.ConditionFilter{LeagueID: &lId})
+	if err != nil {
+		return &pb.PickConditionsResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Could not fetch pick conditions of league %q. Error: %v", lId, err),
+		}, nil
+	}
+
+	result := []*pb.PickCondition{}
+	for _, c := range conditions {
+		result = append(result, conditionToPb(c))
+	}
+	return &pb.PickConditionsResponse{
+		Status: http.StatusOK,
+		Result: result,
+	}, nil
+}
+
+// tradeConditionalPick records the protection of a traded pick, the pick and the substitute
+// stay with the trading franchise until the condition is resolved
+func tradeConditionalPick(ctx context.Context, tx storage.Repository, req *pb.ConditionalPick, from *models.Franchise, to *models.Franchise) error {
+	if req.ProtectedTop < 1 {
+		return fmt.Errorf("pick %v must be protected in at least the first position", req.PickID)
+	}
+	pick, err := ownPick(ctx, tx, req.PickID, from)
+	if err != nil {
+		return err
+	}
+	condition := models.PickCondition{
+		LeagueID:     from.LeagueID,
+		PickID:       pick.ID,
+		FromID:       from.ID,
+		FromName:     from.Name,
+		ToID:         to.ID,
+		ToName:       to.Name,
+		ProtectedTop: int(req.ProtectedTop),
+		Status:       models.ConditionPending,
+	}
+	if req.SubstitutePickID != "" {
+		substitute, err := ownPick(ctx, tx, req.SubstitutePickID, from)
+		if err != nil {
+			return err
+		}
+		if substitute.ID == pick.ID {
+			return fmt.Errorf("pick %v can't be its own substitute", pick.ID)
+		}
+		condition.SubstitutePickID = &substitute.ID
+	}
+	if err := tx.CreatePickCondition(ctx, &condition); err != nil {
+		return err
+	}
+	// the position may already be set
+	return resolveCondition(ctx, tx, &condition, pick, time.Now().Local())
+}
+
+// ownPick returns the undrafted, valid pick of the franchise that is not part of a pending condition or swap
+func ownPick(ctx context.Context, tx storage.Repository, pickID string, owner *models.Franchise) (*models.Pick, error) {
+	pId, err := uuid.Parse(pickID)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse PickID %v", pickID)
+	}
+	pick, err := tx.FindPick(ctx, pId)
+	if err != nil {
+		return nil, fmt.Errorf("error while querying pick with ID %v. Error %+v", pId, err)
+	}
+	if pick.OwnerID == nil || *pick.OwnerID != owner.ID {
+		return nil, fmt.Errorf("pick %v is not owned by franchise %v", pId, owner.ID)
+	}
+	if pick.ProspectID != nil {
+		return nil, fmt.Errorf("pick %v is already used", pId)
+	}
+	if pick.Forfeited() {
+		return nil, fmt.Errorf("pick %v is forfeited", pId)
+	}
+	if err := unencumbered(ctx, tx, pId); err != nil {
+		return nil, err
+	}
+	return pick, nil
+}
+
+// unencumbered fails if the pick is part of a pending condition or swap, it can't be traded until they are resolved
+func unencumbered(ctx context.Context, tx storage.Repository, pickID uuid.UUID) error {
+	conditions, err := tx.ListPickConditions(ctx, storage.ConditionFilter{PickIDs: []uuid.UUID{pickID}, Status: models.ConditionPending})
+	if err != nil {
+		return err
+	}
+	if len(conditions) > 0 {
+		return fmt.Errorf("pick %v is part of the pending condition %v", pickID, conditions[0].ID)
+	}
+	swaps, err := tx.ListPickSwaps(ctx, storage.SwapFilter{PickIDs: []uuid.UUID{pickID}, Status: models.SwapPending})
+	if err != nil {
+		return err
+	}
+	if len(swaps) > 0 {
+		return fmt.Errorf("pick %v is part of the pending swap %v", pickID, swaps[0].ID)
+	}
+	return nil
+}
+
+// resolvePickConditions resolves the league's pending conditions of picks with a position
+func resolvePickConditions(ctx context.Context, tx storage.Repository, leagueID uuid.UUID) error {
+	pending, err := tx.ListPickConditions(ctx, storage.ConditionFilter{LeagueID: &leagueID, Status: models.ConditionPending})
+	if err != nil {
+		return err
+	}
+	now := time.Now().Local()
+	for i := range pending {
+		pick, err := tx.FindPick(ctx, pending[i].PickID)
+		if err != nil {
+			return err
+		}
+		if err := resolveCondition(ctx, tx, &pending[i], pick, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveCondition conveys the pick or its substitute once the pick's position in the round is set
+func resolveCondition(ctx context.Context, tx storage.Repository, condition *models.PickCondition, pick *models.Pick, now time.Time) error {
+	if pick.DraftPickInRound == nil {
+		return nil
+	}
+	switch {
+	case !condition.Protected(*pick.DraftPickInRound):
+		if err := movePick(ctx, tx, pick, condition.ToID, condition.ToName); err != nil {
+			return err
+		}
+		condition.Status = models.ConditionConveyed
+	case condition.SubstitutePickID != nil:
+		substitute, err := tx.FindPick(ctx, *condition.SubstitutePickID)
+		if err != nil {
+			return err
+		}
+		if err := movePick(ctx, tx, substitute, condition.ToID, condition.ToName); err != nil {
+			return err
+		}
+		condition.Status = models.ConditionConverted
+	default:
+		condition.Status = models.ConditionExtinguished
+	}
+	condition.ResolvedAt = &now
+	return tx.SavePickCondition(ctx, condition)
+}
+
+// picksToPb converts the picks with their pending conditions and swaps
+func picksToPb(ctx context.Context, r storage.Repository, picks []models.Pick) ([]*pb.Pick, error) {
+	ids := make([]uuid.UUID, 0, len(picks))
+	for _, p := range picks {
+		ids = append(ids, p.ID)
+	}
+	pending, err := r.ListPickConditions(ctx, storage.ConditionFilter{PickIDs: ids, Status: models.ConditionPending})
+	if err != nil {
+		return nil, err
+	}
+	pendingSwaps, err := r.ListPickSwaps(ctx, storage.SwapFilter{PickIDs: ids, Status: models.SwapPending})
+	if err != nil {
+		return nil, err
+	}
+	swaps := map[uuid.UUID][]*pb.PickSwap{}
+	for _, s := range pendingSwaps {
+		swaps[s.HolderPickID] = append(swaps[s.HolderPickID], swapToPb(s))
+		swaps[s.GrantorPickID] = append(swaps[s.GrantorPickID], swapToPb(s))
+	}
+	conditions := map[uuid.UUID][]*pb.PickCondition{}
+	for _, c := range pending {
+		conditions[c.PickID] = append(conditions[c.PickID], conditionToPb(c))
+		if c.SubstitutePickID != nil {
+			conditions[*c.SubstitutePickID] = append(conditions[*c.SubstitutePickID], conditionToPb(c))
+		}
+	}
+	result := []*pb.Pick{}
+	for _, p := range picks {
+		pick := pickToPb(p)
+		pick.Conditions = conditions[p.ID]
+		pick.Swaps = swaps[p.ID]
+		result = append(result, pick)
+	}
+	return result, nil
+}
+
+func conditionToPb(c models.PickCondition) *pb.PickCondition {
+	condition := &pb.PickCondition{
+		ID:                c.ID.String(),
+		PickID:            c.PickID.String(),
+		FromFranchiseID:   c.FromID.String(),
+		FromFranchiseName: c.FromName,
+		ToFranchiseID:     c.ToID.String(),
+		ToFranchiseName:   c.ToName,
+		ProtectedTop:      int32(c.ProtectedTop),
+		SubstitutePickID:  idString(c.SubstitutePickID),
+		Status:            string(c.Status),
+	}
+	if c.ResolvedAt != nil {
+		condition.ResolvedAt = timestamppb.New(*c.ResolvedAt)
+	}
+	return condition
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+func (s *Server) ListEligibleProspects(ctx context.Context, req *pb.EligibleProspectsRequest) (*pb.ProspectsResponse, error) {
+	lId, err := uuid.Parse(req.LeagueID)
+	if err != nil {
+		return &pb.ProspectsResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.LeagueID),
+		}, nil
+	}
+	league, err := s.R.FindLeague(ctx, lId)
+	if err != nil {
+		return &pb.ProspectsResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("League (%s) not found: %v", req.LeagueID, err),
+		}, nil
+	}
+	year := draftYear(league, req.Year)
+	if year <= 0 {
+		return &pb.ProspectsResponse{
+			Status: http.StatusBadRequest,
+			Error:  "A draft year must be given for leagues without a season",
+		}, nil
+	}
+
+	filter := eligibleFilter(league.Eligibility, year)
+	page := storage.Page{Size: int(req.PageSize), Token: req.PageToken, OrderBy: req.OrderBy}
+	prospects, nextPageToken, err := s.R.ListProspects(ctx, filter, page)
+	if err != nil {
+		return &pb.ProspectsResponse{
+			Status: listStatus(err, http.StatusConflict),
+			Error:  fmt.Sprintf("Could not fetch the eligible prospects of %d. Error: %v", year, err),
+		}, nil
+	}
+
+	result := []*pb.Prospect{}
+	for _, p := range prospects {
+		result = append(result, prospectToPb(p))
+	}
+	return &pb.ProspectsResponse{
+		Status:        http.StatusOK,
+		Prospects:     result,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// eligibleFilter matches the undrafted prospects that meet the rules in the draft of the year like Eligibility.Check
+func eligibleFilter(rules models.Eligibility, year int) storage.ProspectFilter {
+	drafted := false
+	filter := storage.ProspectFilter{Drafted: &drafted, Positions: rules.Positions, BornAfter: rules.BornAfter(year)}
+	if from, to, ok := rules.NhlDraftYears(year); ok {
+		filter.NhlDraftYears = &storage.YearRange{From: from, To: to, Undrafted: rules.AllowUndrafted}
+	}
+	return filter
+}
+
+// eligibilityFromPb returns the rules of the message, nil allows every prospect
+func eligibilityFromPb(req *pb.EligibilityRules) (models.Eligibility, error) {
+	rules := models.Eligibility{}
+	if req == nil {
+		return rules, nil
+	}
+	if d := req.DraftYears; d != nil {
+		if d.Min > d.Max {
+			return rules, fmt.Errorf("the draft year offsets %d to %d are reversed", d.Min, d.Max)
+		}
+		rules.DraftYears = &models.DraftYearOffsets{Min: int(d.Min), Max: int(d.Max)}
+	}
+	if req.MaxAge < 0 {
+		return rules, fmt.Errorf("invalid max age %d", req.MaxAge)
+	}
+	rules.AllowUndrafted = req.AllowUndrafted
+	rules.MaxAge = int(req.MaxAge)
+	for _, p := range req.Positions {
+		pos, err := position(p, "")
+		if err != nil || pos == "" {
+			return rules, fmt.Errorf("invalid position %v", p)
+		}
+		rules.Positions = append(rules.Positions, pos)
+	}
+	return rules, nil
+}
+
+func eligibilityToPb(rules models.Eligibility) *pb.EligibilityRules {
+	result := &pb.EligibilityRules{AllowUndrafted: rules.AllowUndrafted, MaxAge: int32(rules.MaxAge)}
+	if d := rules.DraftYears; d != nil {
+		result.DraftYears = &pb.DraftYearOffsets{Min: int32(d.Min), Max: int32(d.Max)}
+	}
+	for _, p := range rules.Positions {
+		result.Positions = append(result.Positions, positions[p])
+	}
+	return result
+}
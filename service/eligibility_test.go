@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+func TestEligibility(t *testing.T) {
+	date := func(s string) *time.Time {
+		d, _ := time.Parse(dateLayout, s)
+		return &d
+	}
+	rules := models.Eligibility{
+		DraftYears: &models.DraftYearOffsets{Min: -1, Max: 0},
+		MaxAge:     19,
+		Positions:  []models.Position{models.Center, models.Defenseman},
+	}
+	var tests = []struct {
+		prospect models.Prospect
+		rules    models.Eligibility
+		eligible bool
+	}{
+		{models.Prospect{FullName: "a", NhlDraftYear: 2024, PositionCode: models.Center, Birthdate: date("2005-09-16")}, rules, true},
+		{models.Prospect{FullName: "b", NhlDraftYear: 2023, PositionCode: models.Defenseman}, rules, true},
+		// the draft years and positions are bounded
+		{models.Prospect{FullName: "c", NhlDraftYear: 2022, PositionCode: models.Center}, rules, false},
+		{models.Prospect{FullName: "d", NhlDraftYear: 2024, PositionCode: models.Goalie}, rules, false},
+		// turns 20 on the cut-off date
+		{models.Prospect{FullName: "e", NhlDraftYear: 2024, PositionCode: models.Center, Birthdate: date("2004-09-15")}, rules, false},
+		{models.Prospect{FullName: "f", PositionCode: models.Center}, rules, false},
+		{models.Prospect{FullName: "g", PositionCode: models.Center}, models.Eligibility{DraftYears: rules.DraftYears, AllowUndrafted: true}, true},
+		{models.Prospect{FullName: "h", NhlDraftYear: 2010, PositionCode: models.Goalie, Birthdate: date("1990-01-01")}, models.Eligibility{}, true},
+	}
+
+	ctx := context.Background()
+	for _, test := range tests {
+		if err := test.rules.Check(test.prospect, 2024); (err == nil) != test.eligible {
+			t.Errorf("Check of %s returned %v, expected eligible %v", test.prospect.FullName, err, test.eligible)
+		}
+		// the filter of ListEligibleProspects agrees with the check
+		r := storage.NewMemoryRepository()
+		if err := r.CreateProspects(ctx, []models.Prospect{test.prospect}); err != nil {
+			t.Fatalf("Creating prospect failed: %v", err)
+		}
+		prospects, _, err := r.ListProspects(ctx, eligibleFilter(test.rules, 2024), storage.Page{})
+		if err != nil || (len(prospects) == 1) != test.eligible {
+			t.Errorf("Filter matched %d prospects %s, expected eligible %v: %v", len(prospects), test.prospect.FullName, test.eligible, err)
+		}
+	}
+}
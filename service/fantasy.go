@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -9,135 +10,117 @@ import (
 	"github.com/hiltpold/lakelandcup-fantasy-service/models"
 	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
 	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
-	"github.com/sirupsen/logrus"
-	"gorm.io/gorm"
+	"github.com/hiltpold/lakelandcup-fantasy-service/utils"
 )
 
 type Server struct {
 	R storage.Repository
+	// Jwt signs the invitation tokens
+	Jwt *utils.JwtWrapper
+	// Mail sends the invitations, they are only returned to the commissioner if nil
+	Mail Mailer
+	// Values evaluates trades, the default chart is used if nil
+	Values *ValueChart
 	// https://github.com/grpc/grpc-go/issues/3794:
 	pb.UnimplementedFantasyServiceServer
 }
 
 func (s *Server) Trade(ctx context.Context, req *pb.TradeRequest) (*pb.DefaultResponse, error) {
-	//logrus.Info(fmt.Sprintf("%+v", req))
-	var transaction = s.R.DB.Transaction(func(tx *gorm.DB) error {
-		//
-		var firstFranchise models.Franchise
-		var secondFranchise models.Franchise
-
-		firstFranchiseID, err := uuid.Parse(req.First.FranchiseID)
+	if req.First == nil || req.Second == nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  "A trade needs two sides",
+		}, nil
+	}
+	franchiseIDs := []uuid.UUID{}
+	for _, side := range []*pb.TradePayload{req.First, req.Second} {
+		fId, err := uuid.Parse(side.FranchiseID)
 		if err != nil {
-			logrus.Error(fmt.Sprintf("could not parse first FranchiseID %v", req.First.FranchiseID))
+			return &pb.DefaultResponse{
+				Status: http.StatusBadRequest,
+				Error:  fmt.Sprintf("Could not parse uuid for franchise id %q.", side.FranchiseID),
+			}, nil
+		}
+		franchiseIDs = append(franchiseIDs, fId)
+		for _, id := range append(append([]string{}, side.Picks...), side.Prospects...) {
+			if _, err := uuid.Parse(id); err != nil {
+				return &pb.DefaultResponse{
+					Status: http.StatusBadRequest,
+					Error:  fmt.Sprintf("Could not parse uuid %q of a traded pick or prospect.", id),
+				}, nil
+			}
 		}
+	}
 
-		secondFranchiseID, err := uuid.Parse(req.Second.FranchiseID)
+	var transaction = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		firstFranchise, err := tx.FindFranchise(ctx, franchiseIDs[0])
 		if err != nil {
-			logrus.Error(fmt.Sprintf("could not parse second FranchiseID %v", req.Second.FranchiseID))
+			return fmt.Errorf("error while querying franchise with ID %v. Error: %+v", franchiseIDs[0], err)
 		}
-
-		firstFranchise = models.Franchise{ID: firstFranchiseID}
-		if findFirstFranchise := tx.First(&firstFranchise); findFirstFranchise.Error != nil {
-			return fmt.Errorf("error while querying franchise with ID %v. Error: %+v", firstFranchiseID, findFirstFranchise.Error)
+		secondFranchise, err := tx.FindFranchise(ctx, franchiseIDs[1])
+		if err != nil {
+			return fmt.Errorf("error while querying franchise with ID %v. Error: %+v", franchiseIDs[1], err)
 		}
-
-		secondFranchise = models.Franchise{ID: secondFranchiseID}
-		if findSecondFranchise := tx.First(&secondFranchise); findSecondFranchise.Error != nil {
-			return fmt.Errorf("error while querying franchise with ID %v. Error: %+v", secondFranchiseID, findSecondFranchise.Error)
+		if firstFranchise.LeagueID != secondFranchise.LeagueID {
+			return errors.New("the franchises play in different leagues")
+		}
+		// both sides give assets, the acting user needs the trade permission on both
+		for _, f := range []*models.Franchise{firstFranchise, secondFranchise} {
+			if err := authorize(ctx, tx, f, req.UserId, models.Trade); err != nil {
+				return err
+			}
 		}
 
 		// update first picks
 		for _, firstPId := range req.First.Picks {
-			pId, err := uuid.Parse(firstPId)
-			if err != nil {
-				logrus.Error(fmt.Sprintf("could not parse PickID %v", pId))
-			}
-			//
-			var pick = models.Pick{ID: pId}
-			findPick := tx.First(&pick)
-
-			if findPick.Error != nil {
-				return fmt.Errorf("error while querying pick with ID %v. Error %+v", pId, findPick.Error)
-			}
-
-			if findPick.RowsAffected == 1 {
-				// update ownership
-				pick.LastOwnerID = &firstFranchiseID
-				pick.LastOwnerName = firstFranchise.Name
-
-				pick.OwnerID = &secondFranchiseID
-				pick.OwnerName = secondFranchise.Name
-
-				tx.Save(&pick)
+			if err := tradePick(ctx, tx, firstPId, firstFranchise, secondFranchise); err != nil {
+				return err
 			}
 		}
 
 		// update second picks
 		for _, secondPId := range req.Second.Picks {
-			pId, err := uuid.Parse(secondPId)
-			if err != nil {
-				logrus.Error(fmt.Sprintf("could not parse PickID %v", pId))
+			if err := tradePick(ctx, tx, secondPId, secondFranchise, firstFranchise); err != nil {
+				return err
 			}
-			//
-			var pick = models.Pick{ID: pId}
-			findPick := tx.First(&pick)
+		}
 
-			if findPick.Error != nil {
-				return fmt.Errorf("error while querying pick with ID %v. Error %+v", pId, findPick.Error)
+		// record the protected picks
+		for _, c := range req.First.ConditionalPicks {
+			if err := tradeConditionalPick(ctx, tx, c, firstFranchise, secondFranchise); err != nil {
+				return err
 			}
-
-			if findPick.RowsAffected == 1 {
-				// update ownership
-				pick.LastOwnerID = &secondFranchiseID
-				pick.LastOwnerName = secondFranchise.Name
-
-				pick.OwnerID = &firstFranchiseID
-				pick.OwnerName = firstFranchise.Name
-
-				tx.Save(&pick)
+		}
+		for _, c := range req.Second.ConditionalPicks {
+			if err := tradeConditionalPick(ctx, tx, c, secondFranchise, firstFranchise); err != nil {
+				return err
 			}
 		}
 
-		// update first prospects
-		for _, firstPId := range req.First.Prospects {
-			pId, err := uuid.Parse(firstPId)
-			if err != nil {
-				logrus.Error(fmt.Sprintf("could not parse PickID %v", pId))
+		// record the swap rights
+		for _, r := range req.First.SwapRights {
+			if err := tradeSwapRight(ctx, tx, r, firstFranchise, secondFranchise); err != nil {
+				return err
 			}
-			//
-			var prospect = models.Prospect{ID: pId}
-			findProspect := tx.First(&prospect)
-
-			if findProspect.Error != nil {
-				return fmt.Errorf("error while querying pick with ID %v. Error %+v", pId, findProspect.Error)
+		}
+		for _, r := range req.Second.SwapRights {
+			if err := tradeSwapRight(ctx, tx, r, secondFranchise, firstFranchise); err != nil {
+				return err
 			}
+		}
 
-			if findProspect.RowsAffected == 1 {
-				prospect.FranchiseID = &secondFranchiseID
-				tx.Save(&prospect)
+		// update first prospects
+		for _, firstPId := range req.First.Prospects {
+			if err := tradeProspect(ctx, tx, firstPId, firstFranchise, secondFranchise); err != nil {
+				return err
 			}
-
 		}
 
 		// update second prospects
 		for _, secondPId := range req.Second.Prospects {
-			pId, err := uuid.Parse(secondPId)
-			if err != nil {
-				logrus.Error(fmt.Sprintf("could not parse PickID %v", pId))
+			if err := tradeProspect(ctx, tx, secondPId, secondFranchise, firstFranchise); err != nil {
+				return err
 			}
-			//
-			var prospect = models.Prospect{ID: pId}
-			findProspect := tx.First(&prospect)
-
-			if findProspect.Error != nil {
-				return fmt.Errorf("error while querying pick with ID %v. Error %+v", pId, findProspect.Error)
-			}
-
-			if findProspect.RowsAffected == 1 {
-				prospect.FranchiseID = &firstFranchiseID
-				tx.Save(&prospect)
-			}
-
 		}
 
 		//
@@ -146,7 +129,7 @@ func (s *Server) Trade(ctx context.Context, req *pb.TradeRequest) (*pb.DefaultRe
 
 	if transaction != nil {
 		return &pb.DefaultResponse{
-			Status: http.StatusConflict,
+			Status: authStatus(transaction, http.StatusConflict),
 			Error:  transaction.Error(),
 		}, nil
 
@@ -156,3 +139,45 @@ func (s *Server) Trade(ctx context.Context, req *pb.TradeRequest) (*pb.DefaultRe
 		Status: http.StatusOK,
 	}, nil
 }
+
+// tradePick moves the pick from the franchise owning it to the other
+func tradePick(ctx context.Context, tx storage.Repository, pickID string, from *models.Franchise, to *models.Franchise) error {
+	pick, err := ownPick(ctx, tx, pickID, from)
+	if err != nil {
+		return err
+	}
+
+	// update ownership
+	pick.LastOwnerID = &from.ID
+	pick.LastOwnerName = from.Name
+
+	pick.OwnerID = &to.ID
+	pick.OwnerName = to.Name
+
+	if err := delist(ctx, tx, pick.ID); err != nil {
+		return err
+	}
+	return tx.SavePick(ctx, pick)
+}
+
+// tradeProspect moves the prospect from the franchise rostering it to the other
+func tradeProspect(ctx context.Context, tx storage.Repository, prospectID string, from *models.Franchise, to *models.Franchise) error {
+	pId, err := uuid.Parse(prospectID)
+	if err != nil {
+		return fmt.Errorf("could not parse ProspectID %v", prospectID)
+	}
+
+	prospect, err := tx.FindProspect(ctx, pId)
+	if err != nil {
+		return fmt.Errorf("error while querying prospect with ID %v. Error %+v", pId, err)
+	}
+	if prospect.FranchiseID == nil || *prospect.FranchiseID != from.ID {
+		return fmt.Errorf("prospect %v is not rostered by franchise %v", pId, from.ID)
+	}
+
+	prospect.FranchiseID = &to.ID
+	if err := delist(ctx, tx, prospect.ID); err != nil {
+		return err
+	}
+	return tx.SaveProspect(ctx, prospect)
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+// boolFilter converts the tri-state filter of a request, nil matches everything
+func boolFilter(f pb.BoolFilter) *bool {
+	switch f {
+	case pb.BoolFilter_BOOL_FILTER_YES:
+		yes := true
+		return &yes
+	case pb.BoolFilter_BOOL_FILTER_NO:
+		no := false
+		return &no
+	}
+	return nil
+}
+
+func prospectFilter(f *pb.ProspectFilter) (storage.ProspectFilter, error) {
+	if f == nil {
+		return storage.ProspectFilter{}, nil
+	}
+	filter := storage.ProspectFilter{
+		NhlTeam:   f.NhlTeam,
+		Drafted:   boolFilter(f.Drafted),
+		Protected: boolFilter(f.Protected),
+	}
+	var err error
+	if filter.PositionCode, err = position(f.Position, f.PositionCode); err != nil {
+		return filter, err
+	}
+	if filter.NhlDraftYear, err = number(0, f.NhlDraftYear, "nhl draft year"); err != nil {
+		return filter, err
+	}
+	if filter.DraftYear, err = number(0, f.DraftYear, "draft year"); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
+
+// listStatus is the status of a failed list request, bad page requests are the client's fault
+func listStatus(err error, status int64) int64 {
+	if errors.Is(err, storage.ErrInvalidPage) {
+		return http.StatusBadRequest
+	}
+	return status
+}
@@ -2,51 +2,110 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hiltpold/lakelandcup-fantasy-service/models"
 	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *Server) CreateFranchise(ctx context.Context, req *pb.FranchiseRequest) (*pb.FranchiseResponse, error) {
-	var league models.League
 	var franchise models.Franchise
+	lId, err := uuid.Parse(req.LeagueId)
+	if err != nil {
+		return &pb.FranchiseResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.LeagueId),
+		}, nil
+	}
+	ownerId, err := uuid.Parse(req.OwnerID)
+	if err != nil {
+		return &pb.FranchiseResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for owner id %q.", req.OwnerID),
+		}, nil
+	}
+
 	// check if franchise exists in this league
-	if findLeague := s.R.DB.Preload("Franchises").First(&league, "id = ?", uuid.MustParse(req.LeagueId)); findLeague.RowsAffected == 0 {
+	league, err := s.R.FindLeague(ctx, lId)
+	if err != nil {
 		return &pb.FranchiseResponse{
 			Status: http.StatusConflict,
 			Error:  fmt.Sprintf("Franchise cannot be created, provided leagueId (%s) does not exist", req.LeagueId),
 		}, nil
 	}
 
+	if err := writable(league); err != nil {
+		return &pb.FranchiseResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Franchise cannot be created: %v", err),
+		}, nil
+	}
+	if err := authorizeLeague(league, req.UserId); err != nil {
+		return &pb.FranchiseResponse{
+			Status: http.StatusForbidden,
+			Error:  fmt.Sprintf("Franchise cannot be created: %v", err),
+		}, nil
+	}
+
 	// check if franchise name already taken in this league
-	if findFranchise := s.R.DB.Where(&models.Franchise{LeagueID: uuid.MustParse(req.LeagueId), Name: req.Name}).First(&franchise); findFranchise.Error == nil {
+	if _, err := s.R.FindFranchiseByName(ctx, league.ID, req.Name); err == nil {
 		return &pb.FranchiseResponse{
 			Status: http.StatusConflict,
 			Error:  fmt.Sprintf("Franchise cannot be created, franchise with name (%s) already exisits in this league", req.Name),
 		}, nil
 	}
 
-	// check if maximum franchises already satisfied
-	if len(league.Franchises) >= league.MaxFranchises {
+	// check if maximum franchises already satisfied, slots reserved by invitations count as well
+	nFranchises, err := reservedSlots(ctx, s.R, league)
+	if err != nil {
+		return &pb.FranchiseResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Franchise cannot be created: %v", err),
+		}, nil
+	}
+	if nFranchises >= int64(league.MaxFranchises) {
 		return &pb.FranchiseResponse{
 			Status: http.StatusConflict,
 			Error:  fmt.Sprintf("Franchise cannot be created, maximum number of franchises already created (%d)", league.MaxFranchises),
 		}, nil
 	}
 
+	foundationYear, err := number(req.Founded, req.FoundationYear, "foundation year")
+	if err != nil {
+		return &pb.FranchiseResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Invalid franchise: %v", err),
+		}, nil
+	}
+
 	franchise.Name = req.Name
-	franchise.UserID = uuid.MustParse(req.OwnerID)
+	franchise.UserID = ownerId
 	franchise.UserName = req.OwnerName
-	franchise.FoundationYear = req.FoundationYear
-	franchise.LeagueID = uuid.MustParse(req.LeagueId)
+	franchise.FoundationYear = foundationYear
+	franchise.LeagueID = league.ID
 
-	if createFranchise := s.R.DB.Create(&franchise); createFranchise.Error != nil {
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		if err := tx.CreateFranchise(ctx, &franchise); err != nil {
+			return err
+		}
+		if err := tx.SaveFranchiseMember(ctx, ownerMember(&franchise)); err != nil {
+			return err
+		}
+		if err := reconcileFuturePicks(ctx, tx, league, []models.Franchise{franchise}); err != nil {
+			return err
+		}
+		return tx.AddFranchiseHistory(ctx, franchiseHistory(&franchise, models.FranchiseCreated))
+	})
+	if err != nil {
 		return &pb.FranchiseResponse{
 			Status: http.StatusForbidden,
-			Error:  fmt.Sprintf("Creating new franchise for league (%s) failed: %v", req.LeagueId, createFranchise.Error),
+			Error:  fmt.Sprintf("Creating new franchise for league (%s) failed: %v", req.LeagueId, err),
 		}, nil
 	}
 
@@ -57,20 +116,17 @@ func (s *Server) CreateFranchise(ctx context.Context, req *pb.FranchiseRequest)
 }
 
 func (s *Server) GetFranchise(ctx context.Context, req *pb.GetFranchiseRequest) (*pb.GetFranchiseResponse, error) {
-	var franchise models.Franchise
-	var franchiseRes *pb.Franchise
-	var prospectRes []*pb.Prospect
-
-	findFranchise := s.R.DB.Preload("Prospects").First(&franchise, "id = ?", req.FranchiseID)
-
-	if findFranchise.Error != nil {
+	fId, err := uuid.Parse(req.FranchiseID)
+	if err != nil {
 		return &pb.GetFranchiseResponse{
 			Status: http.StatusConflict,
-			Error:  fmt.Sprintf("Getting franchiseId (%s) failed: %v", req.FranchiseID, findFranchise.Error),
+			Error:  fmt.Sprintf("Getting franchiseId (%s) failed: %v", req.FranchiseID, err),
 		}, nil
 	}
 
-	if findFranchise.RowsAffected == 0 {
+	franchise, err := s.R.FindFranchise(ctx, fId)
+
+	if errors.Is(err, storage.ErrNotFound) {
 		return &pb.GetFranchiseResponse{
 			Status: http.StatusConflict,
 			Error:  fmt.Sprintf("franchiseId (%s) does not exist", req.FranchiseID),
@@ -78,76 +134,307 @@ func (s *Server) GetFranchise(ctx context.Context, req *pb.GetFranchiseRequest)
 
 	}
 
-	tmpProspect := pb.Prospect{}
-	if len(franchise.Prospects) > 0 {
-		for _, p := range franchise.Prospects {
-			tmpProspect.ID = p.ID.String()
-			tmpProspect.FullName = p.FullName
-			tmpProspect.FirstName = p.FirstName
-			tmpProspect.LastName = p.LastName
-			tmpProspect.FranchiseID = p.FranchiseID.String()
-		}
-	} else {
-		prospectRes = append(prospectRes, &pb.Prospect{})
-	}
-	franchiseRes = &pb.Franchise{
-		ID:             franchise.ID.String(),
-		OwnerID:        franchise.UserID.String(),
-		OwnerName:      franchise.UserName,
-		Name:           franchise.Name,
-		FoundationYear: franchise.FoundationYear,
-		Prospects:      prospectRes,
+	if err != nil {
+		return &pb.GetFranchiseResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Getting franchiseId (%s) failed: %v", req.FranchiseID, err),
+		}, nil
 	}
 
 	return &pb.GetFranchiseResponse{
 		Status: http.StatusAccepted,
-		Result: franchiseRes,
+		Result: franchiseToPb(*franchise),
 	}, nil
 
 }
 
 func (s *Server) GetLeagueFranchises(ctx context.Context, req *pb.GetLeagueRequest) (*pb.GetLeagueFranchisesResponse, error) {
-	var franchises []models.Franchise
 	var franchiseRes []*pb.Franchise
-	var prospectRes []*pb.Prospect
-
-	findFranchises := s.R.DB.Preload("Prospects").Find(&franchises, "league_id = ?", req.LeagueId).Limit(1000)
 
-	if findFranchises.Error != nil {
+	lId, err := uuid.Parse(req.LeagueId)
+	if err != nil {
 		return &pb.GetLeagueFranchisesResponse{
 			Status: http.StatusConflict,
-			Error:  fmt.Sprintf("Getting franchises for league (%s) failed: %v", req.LeagueId, findFranchises.Error),
+			Error:  fmt.Sprintf("Getting franchises for league (%s) failed: %v", req.LeagueId, err),
+		}, nil
+	}
+
+	page := storage.Page{Size: int(req.PageSize), Token: req.PageToken, OrderBy: req.OrderBy}
+	franchises, nextPageToken, err := s.R.ListFranchises(ctx, lId, page)
+
+	if err != nil {
+		return &pb.GetLeagueFranchisesResponse{
+			Status: listStatus(err, http.StatusConflict),
+			Error:  fmt.Sprintf("Getting franchises for league (%s) failed: %v", req.LeagueId, err),
 		}, nil
 	}
 
 	for _, f := range franchises {
-		//tmpFranchise := &pb.Franchise{}
-		if len(f.Prospects) > 0 {
-			tmpProspect := &pb.Prospect{}
-			for _, p := range f.Prospects {
-				tmpProspect.ID = p.ID.String()
-				tmpProspect.FullName = p.FullName
-				tmpProspect.FirstName = p.FirstName
-				tmpProspect.LastName = p.LastName
-				tmpProspect.FranchiseID = p.FranchiseID.String()
-				// append
-				prospectRes = append(prospectRes, tmpProspect)
+		franchiseRes = append(franchiseRes, franchiseToPb(f))
+	}
+	return &pb.GetLeagueFranchisesResponse{
+		Status:        http.StatusAccepted,
+		Result:        franchiseRes,
+		NextPageToken: nextPageToken}, nil
+
+}
+
+func (s *Server) UpdateFranchise(ctx context.Context, req *pb.FranchiseUpdateRequest) (*pb.FranchiseResponse, error) {
+	fId, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.FranchiseResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for franchise id %q.", req.Id),
+		}, nil
+	}
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		franchise, err := tx.FindFranchise(ctx, fId)
+		if err != nil {
+			return err
+		}
+		if err := authorize(ctx, tx, franchise, req.UserId, models.ManageFranchise); err != nil {
+			return err
+		}
+
+		if req.Name != "" && req.Name != franchise.Name {
+			if _, err := tx.FindFranchiseByName(ctx, franchise.LeagueID, req.Name); err == nil {
+				return fmt.Errorf("franchise with name (%s) already exisits in this league", req.Name)
 			}
-		} else {
-			prospectRes = append(prospectRes, &pb.Prospect{})
+			franchise.Name = req.Name
+		}
+		if req.Founded != 0 {
+			franchise.FoundationYear = int(req.Founded)
 		}
-		tmpFranchise := &pb.Franchise{
-			ID:             f.ID.String(),
-			OwnerID:        f.UserID.String(),
-			OwnerName:      f.UserName,
-			Name:           f.Name,
-			FoundationYear: f.FoundationYear,
-			Prospects:      prospectRes,
+
+		if err := tx.SaveFranchise(ctx, franchise); err != nil {
+			return err
 		}
-		franchiseRes = append(franchiseRes, tmpFranchise)
+		return tx.AddFranchiseHistory(ctx, franchiseHistory(franchise, models.FranchiseUpdated))
+	})
+
+	if err != nil {
+		return &pb.FranchiseResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Updating franchise (%s) failed: %v", req.Id, err),
+		}, nil
 	}
-	return &pb.GetLeagueFranchisesResponse{
-		Status: http.StatusAccepted,
-		Result: franchiseRes}, nil
 
+	return &pb.FranchiseResponse{
+		Status:      http.StatusOK,
+		FranchiseId: fId.String(),
+	}, nil
+}
+
+func (s *Server) TransferFranchiseOwnership(ctx context.Context, req *pb.TransferFranchiseRequest) (*pb.FranchiseResponse, error) {
+	fId, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.FranchiseResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for franchise id %q.", req.Id),
+		}, nil
+	}
+
+	ownerId, err := uuid.Parse(req.OwnerID)
+	if err != nil {
+		return &pb.FranchiseResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for owner id %q.", req.OwnerID),
+		}, nil
+	}
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		franchise, err := tx.FindFranchise(ctx, fId)
+		if err != nil {
+			return err
+		}
+		if err := authorize(ctx, tx, franchise, req.UserId, models.ManageMembers); err != nil {
+			return err
+		}
+
+		// the previous owner leaves, the new owner may have been a member before
+		if franchise.UserID != ownerId {
+			if err := tx.RemoveFranchiseMember(ctx, franchise.ID, franchise.UserID); err != nil {
+				return err
+			}
+		}
+		franchise.UserID = ownerId
+		franchise.UserName = req.OwnerName
+
+		if err := tx.SaveFranchise(ctx, franchise); err != nil {
+			return err
+		}
+		if err := tx.SaveFranchiseMember(ctx, ownerMember(franchise)); err != nil {
+			return err
+		}
+		return tx.AddFranchiseHistory(ctx, franchiseHistory(franchise, models.FranchiseTransferred))
+	})
+
+	if err != nil {
+		return &pb.FranchiseResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Transferring franchise (%s) failed: %v", req.Id, err),
+		}, nil
+	}
+
+	return &pb.FranchiseResponse{
+		Status:      http.StatusOK,
+		FranchiseId: fId.String(),
+	}, nil
+}
+
+func (s *Server) DeleteFranchise(ctx context.Context, req *pb.DeleteFranchiseRequest) (*pb.DefaultResponse, error) {
+	fId, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for franchise id %q.", req.Id),
+		}, nil
+	}
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		franchise, err := tx.FindFranchise(ctx, fId)
+		if err != nil {
+			return err
+		}
+		if err := authorize(ctx, tx, franchise, req.UserId, models.ManageMembers); err != nil {
+			return err
+		}
+		if franchise.Orphan {
+			return fmt.Errorf("the orphan franchise of a league cannot be deleted")
+		}
+
+		orphan, err := orphanFranchise(ctx, tx, franchise.LeagueID)
+		if err != nil {
+			return err
+		}
+
+		// prospects return to the pool, picks are held by the commissioner
+		if err := tx.RemoveTradeBlockEntries(ctx, storage.TradeBlockFilter{FranchiseID: &franchise.ID}); err != nil {
+			return err
+		}
+		if err := tx.ReleaseProspects(ctx, franchise.ID); err != nil {
+			return err
+		}
+		if err := tx.TransferPicks(ctx, franchise, orphan); err != nil {
+			return err
+		}
+
+		if err := tx.AddFranchiseHistory(ctx, franchiseHistory(franchise, models.FranchiseDeleted)); err != nil {
+			return err
+		}
+		return tx.DeleteFranchise(ctx, franchise.ID)
+	})
+
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Deleting franchise (%s) failed: %v", req.Id, err),
+		}, nil
+	}
+
+	return &pb.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "franchise was successfully deleted",
+	}, nil
+}
+
+func (s *Server) GetFranchiseHistory(ctx context.Context, req *pb.GetFranchiseRequest) (*pb.FranchiseHistoryResponse, error) {
+	fId, err := uuid.Parse(req.FranchiseID)
+	if err != nil {
+		return &pb.FranchiseHistoryResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for franchise id %q.", req.FranchiseID),
+		}, nil
+	}
+
+	history, err := s.R.ListFranchiseHistory(ctx, fId)
+	if err != nil {
+		return &pb.FranchiseHistoryResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Getting history of franchise (%s) failed: %v", req.FranchiseID, err),
+		}, nil
+	}
+
+	historyRes := []*pb.FranchiseChange{}
+	for _, h := range history {
+		historyRes = append(historyRes, &pb.FranchiseChange{
+			Change:    h.Change,
+			Name:      h.Name,
+			OwnerID:   h.UserID.String(),
+			OwnerName: h.UserName,
+			ChangedAt: timestamppb.New(h.CreatedAt),
+		})
+	}
+
+	return &pb.FranchiseHistoryResponse{
+		Status: http.StatusOK,
+		Result: historyRes,
+	}, nil
+}
+
+// orphanFranchise returns the league's orphan franchise, it is created for the commissioner on first use
+func orphanFranchise(ctx context.Context, tx storage.Repository, leagueID uuid.UUID) (*models.Franchise, error) {
+	const orphanName = "Orphaned Picks"
+
+	orphan, err := tx.FindOrphanFranchise(ctx, leagueID)
+	if !errors.Is(err, storage.ErrNotFound) {
+		return orphan, err
+	}
+
+	league, err := tx.FindLeague(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	orphan = &models.Franchise{
+		Name:           orphanName,
+		UserID:         league.CommissionerID,
+		UserName:       league.Commissioner,
+		FoundationYear: time.Now().Year(),
+		LeagueID:       leagueID,
+		Orphan:         true,
+	}
+	if err := tx.CreateFranchise(ctx, orphan); err != nil {
+		return nil, err
+	}
+	if err := tx.SaveFranchiseMember(ctx, ownerMember(orphan)); err != nil {
+		return nil, err
+	}
+	return orphan, tx.AddFranchiseHistory(ctx, franchiseHistory(orphan, models.FranchiseCreated))
+}
+
+// franchiseToPb converts the franchise with its prospects
+func franchiseToPb(f models.Franchise) *pb.Franchise {
+	prospects := []*pb.Prospect{}
+	for _, p := range f.Prospects {
+		prospects = append(prospects, prospectToPb(p))
+	}
+	return &pb.Franchise{
+		ID:             f.ID.String(),
+		OwnerID:        f.UserID.String(),
+		OwnerName:      f.UserName,
+		Name:           f.Name,
+		FoundationYear: legacyNumber(f.FoundationYear),
+		Founded:        int32(f.FoundationYear),
+		LeagueID:       f.LeagueID.String(),
+		Prospects:      prospects,
+	}
+}
+
+func franchiseHistory(f *models.Franchise, change string) *models.FranchiseHistory {
+	return &models.FranchiseHistory{
+		FranchiseID: f.ID,
+		Change:      change,
+		Name:        f.Name,
+		UserID:      f.UserID,
+		UserName:    f.UserName,
+	}
+}
+
+// franchiseStatus is the status of a failed franchise change
+func franchiseStatus(err error) int64 {
+	if errors.Is(err, storage.ErrNotFound) {
+		return http.StatusNotFound
+	}
+	return authStatus(err, http.StatusConflict)
 }
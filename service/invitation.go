@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+	"github.com/hiltpold/lakelandcup-fantasy-service/utils"
+)
+
+const (
+	// invitationToken is the token type of invitations, they are signed with the TokenKey
+	invitationToken    = "INVITATION_TOKEN"
+	invitationTemplate = "invitation"
+	invitationSubject  = "You are invited to the Lakelandcup"
+)
+
+// Mailer sends a mail rendered from one of the templates/.
+type Mailer interface {
+	Send(name string, email string, subject string, template string, token string) error
+}
+
+// SendGridMailer sends mails with utils.SendGridMail.
+type SendGridMailer struct {
+	Key string
+}
+
+func (m SendGridMailer) Send(name string, email string, subject string, template string, token string) error {
+	resp, err := utils.SendGridMail(name, email, subject, template, token, m.Key)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("sendgrid responded with status %d: %s", resp.StatusCode, resp.Body)
+	}
+	return nil
+}
+
+func (s *Server) InviteFranchise(ctx context.Context, req *pb.InvitationRequest) (*pb.InvitationResponse, error) {
+	if s.Jwt == nil {
+		return &pb.InvitationResponse{
+			Status: http.StatusInternalServerError,
+			Error:  "Invitations are not configured",
+		}, nil
+	}
+
+	lId, err := uuid.Parse(req.LeagueID)
+	if err != nil {
+		return &pb.InvitationResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.LeagueID),
+		}, nil
+	}
+
+	if _, err := mail.ParseAddress(req.Email); err != nil {
+		return &pb.InvitationResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Invalid email %q.", req.Email),
+		}, nil
+	}
+
+	invitation := models.Invitation{
+		LeagueID:      lId,
+		Email:         req.Email,
+		Name:          req.Name,
+		FranchiseName: req.FranchiseName,
+		ExpiresAt:     time.Now().Local().Add(time.Hour * time.Duration(s.Jwt.ExpirationHours)),
+	}
+	var token string
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		league, err := tx.FindLeague(ctx, lId)
+		if err != nil {
+			return err
+		}
+		if err := writable(league); err != nil {
+			return err
+		}
+		if err := authorizeLeague(league, req.UserId); err != nil {
+			return err
+		}
+		invitation.InvitedByID = uuid.MustParse(req.UserId)
+
+		slots, err := reservedSlots(ctx, tx, league)
+		if err != nil {
+			return err
+		}
+		if slots >= int64(league.MaxFranchises) {
+			return fmt.Errorf("maximum number of franchises already created or invited (%d)", league.MaxFranchises)
+		}
+
+		if err := tx.CreateInvitation(ctx, &invitation); err != nil {
+			return err
+		}
+		token, err = s.Jwt.GenerateToken(utils.JwtData{Id: invitation.ID, Email: invitation.Email}, invitationToken)
+		return err
+	})
+
+	if err != nil {
+		return &pb.InvitationResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Inviting to league (%s) failed: %v", req.LeagueID, err),
+		}, nil
+	}
+
+	// the slot stays reserved if mailing fails, the commissioner can pass the token on
+	if s.Mail != nil {
+		if err := s.Mail.Send(req.Name, req.Email, invitationSubject, invitationTemplate, token); err != nil {
+			return &pb.InvitationResponse{
+				Status:       http.StatusBadGateway,
+				Error:        fmt.Sprintf("Sending invitation to %s failed: %v", req.Email, err),
+				InvitationID: invitation.ID.String(),
+				Token:        token,
+			}, nil
+		}
+	}
+
+	return &pb.InvitationResponse{
+		Status:       http.StatusCreated,
+		InvitationID: invitation.ID.String(),
+		Token:        token,
+	}, nil
+}
+
+func (s *Server) RevokeInvitation(ctx context.Context, req *pb.RevokeInvitationRequest) (*pb.DefaultResponse, error) {
+	iId, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for invitation id %q.", req.Id),
+		}, nil
+	}
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		invitation, err := tx.FindInvitation(ctx, iId)
+		if err != nil {
+			return err
+		}
+		league, err := tx.FindLeague(ctx, invitation.LeagueID)
+		if err != nil {
+			return err
+		}
+		if err := authorizeLeague(league, req.UserId); err != nil {
+			return err
+		}
+		if invitation.ClaimedAt != nil {
+			return fmt.Errorf("invitation was already claimed")
+		}
+		now := time.Now().Local()
+		invitation.RevokedAt = &now
+		return tx.SaveInvitation(ctx, invitation)
+	})
+
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Revoking invitation (%s) failed: %v", req.Id, err),
+		}, nil
+	}
+
+	return &pb.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "invitation was successfully revoked",
+	}, nil
+}
+
+func (s *Server) ClaimFranchise(ctx context.Context, req *pb.ClaimFranchiseRequest) (*pb.FranchiseResponse, error) {
+	if s.Jwt == nil {
+		return &pb.FranchiseResponse{
+			Status: http.StatusInternalServerError,
+			Error:  "Invitations are not configured",
+		}, nil
+	}
+
+	uId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.FranchiseResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for user id %q.", req.UserId),
+		}, nil
+	}
+
+	claims, err := s.Jwt.ValidateToken(req.Token, invitationToken)
+	if err != nil {
+		return &pb.FranchiseResponse{
+			Status: http.StatusForbidden,
+			Error:  fmt.Sprintf("Invalid invitation token: %v", err),
+		}, nil
+	}
+
+	var franchise models.Franchise
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		invitation, err := tx.FindInvitation(ctx, claims.Id)
+		if err != nil {
+			return err
+		}
+		now := time.Now().Local()
+		if invitation.Email != claims.Email || !invitation.Open(now) {
+			return fmt.Errorf("%w: invitation was already claimed, revoked or expired", errForbidden)
+		}
+
+		league, err := tx.FindLeague(ctx, invitation.LeagueID)
+		if err != nil {
+			return err
+		}
+		if err := writable(league); err != nil {
+			return err
+		}
+		// the invitation reserved one of the slots, it is released by the claim
+		slots, err := reservedSlots(ctx, tx, league)
+		if err != nil {
+			return err
+		}
+		if slots > int64(league.MaxFranchises) {
+			return fmt.Errorf("maximum number of franchises already created (%d)", league.MaxFranchises)
+		}
+
+		franchise = models.Franchise{
+			Name:           invitation.FranchiseName,
+			UserID:         uId,
+			UserName:       req.UserName,
+			FoundationYear: now.Year(),
+			LeagueID:       league.ID,
+		}
+		if req.Name != "" {
+			franchise.Name = req.Name
+		}
+		if req.Founded != 0 {
+			franchise.FoundationYear = int(req.Founded)
+		}
+		if franchise.Name == "" {
+			return fmt.Errorf("the franchise needs a name")
+		}
+		if _, err := tx.FindFranchiseByName(ctx, league.ID, franchise.Name); err == nil {
+			return fmt.Errorf("franchise with name (%s) already exisits in this league", franchise.Name)
+		}
+
+		if err := tx.CreateFranchise(ctx, &franchise); err != nil {
+			return err
+		}
+		if err := tx.SaveFranchiseMember(ctx, ownerMember(&franchise)); err != nil {
+			return err
+		}
+		if err := reconcileFuturePicks(ctx, tx, league, []models.Franchise{franchise}); err != nil {
+			return err
+		}
+		if err := tx.AddFranchiseHistory(ctx, franchiseHistory(&franchise, models.FranchiseCreated)); err != nil {
+			return err
+		}
+
+		invitation.FranchiseID = &franchise.ID
+		invitation.ClaimedByID = &uId
+		invitation.ClaimedAt = &now
+		return tx.SaveInvitation(ctx, invitation)
+	})
+
+	if errors.Is(err, storage.ErrNotFound) {
+		return &pb.FranchiseResponse{
+			Status: http.StatusNotFound,
+			Error:  "Invitation does not exist",
+		}, nil
+	}
+	if err != nil {
+		return &pb.FranchiseResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Claiming franchise failed: %v", err),
+		}, nil
+	}
+
+	return &pb.FranchiseResponse{
+		Status:      http.StatusCreated,
+		FranchiseId: franchise.ID.String(),
+	}, nil
+}
+
+// reservedSlots counts the league's franchises, without the orphan franchise, and its open invitations
+func reservedSlots(ctx context.Context, r storage.Repository, league *models.League) (int64, error) {
+	franchises, err := r.CountFranchises(ctx, league.ID)
+	if err != nil {
+		return 0, err
+	}
+	invitations, err := r.CountOpenInvitations(ctx, league.ID, time.Now().Local())
+	if err != nil {
+		return 0, err
+	}
+	return franchises + invitations, nil
+}
@@ -2,12 +2,15 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hiltpold/lakelandcup-fantasy-service/models"
 	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
 	"github.com/sirupsen/logrus"
 )
 
@@ -23,29 +26,74 @@ func (s *Server) CreateLeague(ctx context.Context, req *pb.LeagueRequest) (*pb.L
 	}
 
 	// check if league already exists
-	if findLeague := s.R.DB.Where(&models.League{Name: req.Name}).First(&league); findLeague.Error == nil {
+	if _, err := s.R.FindLeagueByName(ctx, req.Name); err == nil {
 		return &pb.LeagueResponse{
 			Status: http.StatusConflict,
 			Error:  "League already exists",
 		}, nil
 	}
 
-	// TODO: Error Handling MustParse
+	foundationYear, err := number(req.Founded, req.FoundationYear, "foundation year")
+	if err != nil {
+		return &pb.LeagueResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Invalid league: %v", err),
+		}, nil
+	}
+	rules := models.DefaultStatusRules()
+	if len(req.StatusRules) > 0 {
+		rules = models.StatusRules{}
+	}
+	if league.StatusRules, err = statusRulesFromPb(rules, req.StatusRules); err != nil {
+		return &pb.LeagueResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Invalid league: %v", err),
+		}, nil
+	}
+	if league.Eligibility, err = eligibilityFromPb(req.Eligibility); err != nil {
+		return &pb.LeagueResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Invalid league: %v", err),
+		}, nil
+	}
+
+	if league.AdminID, err = uuid.Parse(req.AdminID); err != nil {
+		return &pb.LeagueResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid %q.", req.AdminID),
+		}, nil
+	}
+	if league.CommissionerID, err = uuid.Parse(req.CommissionerID); err != nil {
+		return &pb.LeagueResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid %q.", req.CommissionerID),
+		}, nil
+	}
 
 	league.Name = req.Name
 	league.Admin = req.Admin
-	league.AdminID = uuid.MustParse(req.AdminID)
 	league.Commissioner = req.Commissioner
-	league.CommissionerID = uuid.MustParse(req.CommissionerID)
-	league.FoundationYear = req.FoundationYear
+	league.FoundationYear = foundationYear
 	league.MaxFranchises = int(req.MaxFranchises)
 	league.MaxProspects = int(req.MaxProspects)
 	league.DraftRightsGoalie = int(req.DraftRightsGoalie)
 	league.DraftRightsSkater = int(req.DraftRightsSkater)
 	league.DraftRounds = int(req.DraftRounds)
+	league.Season = int(req.Season)
+	league.FuturePickYears = int(req.FuturePickYears)
 	league.Franchises = []models.Franchise{}
 
-	if createLeague := s.R.DB.Create(&league); createLeague.Error != nil {
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		if err := tx.CreateLeague(ctx, &league); err != nil {
+			return err
+		}
+		if league.Season == 0 {
+			return nil
+		}
+		_, err := startSeason(ctx, tx, &league, league.Season, nil, nil)
+		return err
+	})
+	if err != nil {
 		return &pb.LeagueResponse{
 			Status: http.StatusForbidden,
 			Error:  "Creating new league failed",
@@ -59,35 +107,105 @@ func (s *Server) CreateLeague(ctx context.Context, req *pb.LeagueRequest) (*pb.L
 }
 
 func (s *Server) UpdateLeague(ctx context.Context, req *pb.LeagueUpdateRequest) (*pb.LeagueResponse, error) {
-	var league models.League
 	const leagueName = "Lakelandcup"
 
+	if req.League == nil {
+		return &pb.LeagueResponse{
+			Status: http.StatusBadRequest,
+			Error:  "Invalid league: the league is missing",
+		}, nil
+	}
+	ids := []uuid.UUID{}
+	for _, id := range []string{req.Id, req.League.AdminID, req.League.CommissionerID} {
+		uId, err := uuid.Parse(id)
+		if err != nil {
+			return &pb.LeagueResponse{
+				Status: http.StatusBadRequest,
+				Error:  fmt.Sprintf("Could not parse uuid %q.", id),
+			}, nil
+		}
+		ids = append(ids, uId)
+	}
+
 	// check if league already exists
-	if findLeague := s.R.DB.Where(&models.League{ID: uuid.MustParse(req.Id), Name: leagueName}).First(&league); findLeague.Error != nil {
+	league, err := managedLeague(ctx, s.R, ids[0], req.UserId)
+	if errors.Is(err, errForbidden) {
+		return &pb.LeagueResponse{
+			Status: http.StatusForbidden,
+			Error:  fmt.Sprintf("Updating league failed: %v", err),
+		}, nil
+	}
+	if err != nil || league.Name != leagueName {
 		return &pb.LeagueResponse{
 			Status: http.StatusConflict,
 			Error:  fmt.Sprintf("League does't exists or is not called %q", leagueName),
 		}, nil
 	}
+	if err := writable(league); err != nil {
+		return &pb.LeagueResponse{
+			Status: http.StatusConflict,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	foundationYear, err := number(req.League.Founded, req.League.FoundationYear, "foundation year")
+	if err != nil {
+		return &pb.LeagueResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Invalid league: %v", err),
+		}, nil
+	}
+	rules, err := statusRulesFromPb(league.StatusRules, req.League.StatusRules)
+	if err != nil {
+		return &pb.LeagueResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Invalid league: %v", err),
+		}, nil
+	}
+	eligibility := league.Eligibility
+	if req.League.Eligibility != nil {
+		if eligibility, err = eligibilityFromPb(req.League.Eligibility); err != nil {
+			return &pb.LeagueResponse{
+				Status: http.StatusBadRequest,
+				Error:  fmt.Sprintf("Invalid league: %v", err),
+			}, nil
+		}
+	}
 
-	league.ID = uuid.MustParse(req.Id)
 	league.Name = req.League.Name
 	league.Admin = req.League.Admin
-	league.AdminID = uuid.MustParse(req.League.AdminID)
+	league.AdminID = ids[1]
 	league.Commissioner = req.League.Commissioner
-	league.CommissionerID = uuid.MustParse(req.League.CommissionerID)
-	league.FoundationYear = req.League.FoundationYear
+	league.CommissionerID = ids[2]
+	league.FoundationYear = foundationYear
 	league.MaxFranchises = int(req.League.MaxFranchises)
 	league.MaxProspects = int(req.League.MaxProspects)
 	league.DraftRightsGoalie = int(req.League.DraftRightsGoalie)
 	league.DraftRightsSkater = int(req.League.DraftRightsSkater)
-	league.DraftRounds = int(req.League.DraftRounds)
+	if req.League.DraftRounds != 0 {
+		league.DraftRounds = int(req.League.DraftRounds)
+	}
+	if req.League.Season != 0 {
+		league.Season = int(req.League.Season)
+	}
+	if req.League.FuturePickYears != 0 {
+		league.FuturePickYears = int(req.League.FuturePickYears)
+	}
+	league.StatusRules = rules
+	league.Eligibility = eligibility
 	league.Franchises = []models.Franchise{}
 
-	if updateLeague := s.R.DB.Save(&league); updateLeague.Error != nil {
+	// the future picks follow changes of the draft rounds and the horizon
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		if err := tx.SaveLeague(ctx, league); err != nil {
+			return err
+		}
+		return reconcileLeaguePicks(ctx, tx, league)
+	})
+	if err != nil {
 		return &pb.LeagueResponse{
-			Status: http.StatusForbidden,
-			Error:  "Updating league failed",
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Updating league failed: %v", err),
 		}, nil
 	}
 
@@ -98,20 +216,20 @@ func (s *Server) UpdateLeague(ctx context.Context, req *pb.LeagueUpdateRequest)
 }
 
 func (s *Server) GetLeague(ctx context.Context, req *pb.GetLeagueRequest) (*pb.GetLeagueResponse, error) {
-	var league models.League
 	var leagueRes *pb.League
 	var franchisesRes []*pb.Franchise
 
-	findLeague := s.R.DB.Preload("Franchises").First(&league, "id = ?", req.LeagueId)
-
-	if findLeague.Error != nil {
+	lId, err := uuid.Parse(req.LeagueId)
+	if err != nil {
 		return &pb.GetLeagueResponse{
 			Status: http.StatusConflict,
 			Error:  fmt.Sprintf("Getting LeagueID (%s) failed", req.LeagueId),
 		}, nil
 	}
 
-	if findLeague.RowsAffected == 0 {
+	league, err := s.R.FindLeague(ctx, lId)
+
+	if errors.Is(err, storage.ErrNotFound) {
 		return &pb.GetLeagueResponse{
 			Status: http.StatusConflict,
 			Error:  fmt.Sprintf("LeagueID (%s) does not exist", req.LeagueId),
@@ -119,14 +237,22 @@ func (s *Server) GetLeague(ctx context.Context, req *pb.GetLeagueRequest) (*pb.G
 
 	}
 
-	tmpFranchise := pb.Franchise{}
+	if err != nil {
+		return &pb.GetLeagueResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Getting LeagueID (%s) failed", req.LeagueId),
+		}, nil
+	}
+
 	if len(league.Franchises) > 0 {
 		for _, f := range league.Franchises {
+			tmpFranchise := pb.Franchise{}
 			tmpFranchise.ID = f.ID.String()
 			tmpFranchise.OwnerID = f.UserID.String()
 			tmpFranchise.OwnerName = f.UserName
 			tmpFranchise.Name = f.Name
-			tmpFranchise.FoundationYear = f.FoundationYear
+			tmpFranchise.FoundationYear = legacyNumber(f.FoundationYear)
+			tmpFranchise.Founded = int32(f.FoundationYear)
 			franchisesRes = append(franchisesRes, &tmpFranchise)
 
 		}
@@ -141,12 +267,19 @@ func (s *Server) GetLeague(ctx context.Context, req *pb.GetLeagueRequest) (*pb.G
 		AdminID:           league.AdminID.String(),
 		Commissioner:      league.Commissioner,
 		CommissionerID:    league.CommissionerID.String(),
-		FoundationYear:    league.FoundationYear,
+		FoundationYear:    legacyNumber(league.FoundationYear),
+		Founded:           int32(league.FoundationYear),
 		MaxFranchises:     int32(league.MaxFranchises),
 		MaxProspects:      int32(league.MaxProspects),
 		DraftRightsGoalie: int32(league.DraftRightsGoalie),
 		DraftRightsSkater: int32(league.DraftRightsSkater),
 		Franchises:        franchisesRes,
+		Season:            int32(league.Season),
+		Archived:          league.ArchivedAt != nil,
+		ClonedFromID:      idString(league.ClonedFromID),
+		FuturePickYears:   int32(league.FuturePickYears),
+		StatusRules:       statusRulesToPb(league.StatusRules),
+		Eligibility:       eligibilityToPb(league.Eligibility),
 	}
 
 	return &pb.GetLeagueResponse{
@@ -157,13 +290,19 @@ func (s *Server) GetLeague(ctx context.Context, req *pb.GetLeagueRequest) (*pb.G
 }
 
 func (s *Server) GetLeagues(ctx context.Context, req *pb.GetLeaguesRequest) (*pb.GetLeaguesResponse, error) {
-	var leagues []models.League
 	var leagueRes []*pb.League
-	var franchisesRes []*pb.Franchise
 
-	s.R.DB.Preload("Franchises").Find(&leagues).Limit(1000)
+	page := storage.Page{Size: int(req.PageSize), Token: req.PageToken, OrderBy: req.OrderBy}
+	leagues, nextPageToken, err := s.R.ListLeagues(ctx, page)
+	if err != nil {
+		return &pb.GetLeaguesResponse{
+			Status: listStatus(err, http.StatusConflict),
+			Error:  fmt.Sprintf("Getting leagues failed: %v", err),
+		}, nil
+	}
 
 	for _, l := range leagues {
+		var franchisesRes []*pb.Franchise
 		if len(l.Franchises) > 0 {
 			for _, f := range l.Franchises {
 				tmpFranchise := pb.Franchise{}
@@ -171,7 +310,8 @@ func (s *Server) GetLeagues(ctx context.Context, req *pb.GetLeaguesRequest) (*pb
 				tmpFranchise.OwnerID = f.UserID.String()
 				tmpFranchise.OwnerName = f.UserName
 				tmpFranchise.Name = f.Name
-				tmpFranchise.FoundationYear = f.FoundationYear
+				tmpFranchise.FoundationYear = legacyNumber(f.FoundationYear)
+				tmpFranchise.Founded = int32(f.FoundationYear)
 				franchisesRes = append(franchisesRes, &tmpFranchise)
 
 			}
@@ -185,20 +325,297 @@ func (s *Server) GetLeagues(ctx context.Context, req *pb.GetLeaguesRequest) (*pb
 			AdminID:           l.AdminID.String(),
 			Commissioner:      l.Commissioner,
 			CommissionerID:    l.CommissionerID.String(),
-			FoundationYear:    l.FoundationYear,
+			FoundationYear:    legacyNumber(l.FoundationYear),
+			Founded:           int32(l.FoundationYear),
 			MaxFranchises:     int32(l.MaxFranchises),
 			MaxProspects:      int32(l.MaxProspects),
 			DraftRightsGoalie: int32(l.DraftRightsGoalie),
 			DraftRightsSkater: int32(l.DraftRightsSkater),
 			Franchises:        franchisesRes,
+			Season:            int32(l.Season),
+			Archived:          l.ArchivedAt != nil,
+			ClonedFromID:      idString(l.ClonedFromID),
+			FuturePickYears:   int32(l.FuturePickYears),
+			StatusRules:       statusRulesToPb(l.StatusRules),
+			Eligibility:       eligibilityToPb(l.Eligibility),
 		}
 		leagueRes = append(leagueRes, &tmpLeague)
 
 	}
 	logrus.Info(leagueRes)
 	return &pb.GetLeaguesResponse{
-		Status: http.StatusAccepted,
-		Result: leagueRes,
+		Status:        http.StatusAccepted,
+		Result:        leagueRes,
+		NextPageToken: nextPageToken,
+	}, nil
+
+}
+
+func (s *Server) ArchiveLeague(ctx context.Context, req *pb.LeagueActionRequest) (*pb.LeagueResponse, error) {
+	lId, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.LeagueResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.Id),
+		}, nil
+	}
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		league, err := managedLeague(ctx, tx, lId, req.UserId)
+		if err != nil {
+			return err
+		}
+		if err := writable(league); err != nil {
+			return err
+		}
+		now := time.Now().Local()
+		league.ArchivedAt = &now
+		return tx.SaveLeague(ctx, league)
+	})
+
+	if err != nil {
+		return &pb.LeagueResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Archiving league (%s) failed: %v", req.Id, err),
+		}, nil
+	}
+
+	return &pb.LeagueResponse{
+		Status:   http.StatusOK,
+		LeagueId: lId.String(),
+	}, nil
+}
+
+func (s *Server) DeleteLeague(ctx context.Context, req *pb.LeagueActionRequest) (*pb.DefaultResponse, error) {
+	lId, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.Id),
+		}, nil
+	}
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		if _, err := managedLeague(ctx, tx, lId, req.UserId); err != nil {
+			return err
+		}
+		return tx.DeleteLeague(ctx, lId)
+	})
+
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Deleting league (%s) failed: %v", req.Id, err),
+		}, nil
+	}
+
+	return &pb.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "league was successfully deleted",
 	}, nil
+}
+
+func (s *Server) CloneLeagueForSeason(ctx context.Context, req *pb.CloneLeagueRequest) (*pb.LeagueResponse, error) {
+	lId, err := uuid.Parse(req.Id)
+	if err != nil {
+		return &pb.LeagueResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.Id),
+		}, nil
+	}
+
+	var clone *models.League
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		league, err := managedLeague(ctx, tx, lId, req.UserId)
+		if err != nil {
+			return err
+		}
+		if err := writable(league); err != nil {
+			return err
+		}
+		if int(req.Season) <= league.Season {
+			return fmt.Errorf("season %d does not follow the league's season %d", req.Season, league.Season)
+		}
+		clone, err = cloneLeague(ctx, tx, league, int(req.Season))
+		return err
+	})
+
+	if err != nil {
+		return &pb.LeagueResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Cloning league (%s) failed: %v", req.Id, err),
+		}, nil
+	}
+
+	return &pb.LeagueResponse{
+		Status:   http.StatusCreated,
+		LeagueId: clone.ID.String(),
+	}, nil
+}
+
+// cloneLeague archives the league and continues it in the season with a copy of its settings,
+// franchises and members. The rosters and the unused picks of the season's and later drafts
+// move to the copied franchises, protections are reset.
+func cloneLeague(ctx context.Context, tx storage.Repository, league *models.League, season int) (*models.League, error) {
+	now := time.Now().Local()
+	league.ArchivedAt = &now
+	franchises := league.Franchises
+	if err := tx.SaveLeague(ctx, league); err != nil {
+		return nil, err
+	}
+	// the assets move to the copied franchises, the archived trade block is cleared
+	if err := tx.RemoveTradeBlockEntries(ctx, storage.TradeBlockFilter{LeagueID: &league.ID}); err != nil {
+		return nil, err
+	}
+
+	clone := *league
+	clone.ID = uuid.Nil
+	clone.Season = season
+	clone.ArchivedAt = nil
+	clone.ClonedFromID = &league.ID
+	clone.Franchises = nil
+	clone.Prospects = nil
+	if err := tx.CreateLeague(ctx, &clone); err != nil {
+		return nil, err
+	}
+	current, err := tx.FindActiveSeason(ctx, league.ID)
+	if err == nil {
+		current.Status = models.SeasonClosed
+		current.ClosedAt = &now
+		err = tx.SaveSeason(ctx, current)
+	}
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+	if _, err := startSeason(ctx, tx, &clone, season, nil, nil); err != nil {
+		return nil, err
+	}
 
+	// old franchise id to its copy
+	copies := map[uuid.UUID]*uuid.UUID{}
+	for _, f := range franchises {
+		franchise := models.Franchise{
+			Name:           f.Name,
+			UserID:         f.UserID,
+			UserName:       f.UserName,
+			FoundationYear: f.FoundationYear,
+			LeagueID:       clone.ID,
+			Orphan:         f.Orphan,
+		}
+		if err := tx.CreateFranchise(ctx, &franchise); err != nil {
+			return nil, err
+		}
+		if err := tx.AddFranchiseHistory(ctx, franchiseHistory(&franchise, models.FranchiseCreated)); err != nil {
+			return nil, err
+		}
+		copies[f.ID] = &franchise.ID
+
+		members, err := tx.ListFranchiseMembers(ctx, f.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range members {
+			member := models.FranchiseMember{FranchiseID: franchise.ID, UserID: m.UserID, UserName: m.UserName, Role: m.Role}
+			if err := tx.SaveFranchiseMember(ctx, &member); err != nil {
+				return nil, err
+			}
+		}
+
+		roster, err := tx.FindFranchise(ctx, f.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range roster.Prospects {
+			p.LeagueID = &clone.ID
+			p.FranchiseID = &franchise.ID
+			p.Protected = false
+			if err := tx.SaveProspect(ctx, &p); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, f := range franchises {
+		picks, err := allPicks(ctx, tx, storage.PickFilter{OwnerID: &f.ID, Drafted: new(bool)})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range picks {
+			if p.DraftYear < season {
+				continue
+			}
+			p.OwnerID = copies[f.ID]
+			if c, ok := copies[idValue(p.LastOwnerID)]; ok {
+				p.LastOwnerID = c
+			}
+			if c, ok := copies[idValue(p.OriginID)]; ok {
+				p.OriginID = c
+			}
+			if err := tx.SavePick(ctx, &p); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// pending conditions and swaps continue between the copies
+	pending, err := tx.ListPickConditions(ctx, storage.ConditionFilter{LeagueID: &league.ID, Status: models.ConditionPending})
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range pending {
+		pick, err := tx.FindPick(ctx, c.PickID)
+		if err != nil {
+			return nil, err
+		}
+		from, to := copies[c.FromID], copies[c.ToID]
+		if from == nil || to == nil || pick.DraftYear < season {
+			continue
+		}
+		c.LeagueID = clone.ID
+		c.FromID = *from
+		c.ToID = *to
+		if err := tx.SavePickCondition(ctx, &c); err != nil {
+			return nil, err
+		}
+	}
+	swaps, err := tx.ListPickSwaps(ctx, storage.SwapFilter{LeagueID: &league.ID, Status: models.SwapPending})
+	if err != nil {
+		return nil, err
+	}
+	for _, sw := range swaps {
+		pick, err := tx.FindPick(ctx, sw.HolderPickID)
+		if err != nil {
+			return nil, err
+		}
+		holder, grantor := copies[sw.HolderID], copies[sw.GrantorID]
+		if holder == nil || grantor == nil || pick.DraftYear < season {
+			continue
+		}
+		sw.LeagueID = clone.ID
+		sw.HolderID = *holder
+		sw.GrantorID = *grantor
+		if err := tx.SavePickSwap(ctx, &sw); err != nil {
+			return nil, err
+		}
+	}
+	return &clone, reconcileLeaguePicks(ctx, tx, &clone)
+}
+
+// managedLeague returns the league if the acting user is its commissioner or admin
+func managedLeague(ctx context.Context, tx storage.Repository, leagueID uuid.UUID, userID string) (*models.League, error) {
+	league, err := tx.FindLeague(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	return league, authorizeLeague(league, userID)
+}
+
+// errArchived is returned for changes of archived leagues
+var errArchived = errors.New("league is archived and read-only")
+
+func writable(league *models.League) error {
+	if league.ArchivedAt != nil {
+		return errArchived
+	}
+	return nil
 }
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+// errForbidden is returned if the acting user may not change a franchise
+var errForbidden = errors.New("forbidden")
+
+func (s *Server) AddFranchiseMember(ctx context.Context, req *pb.FranchiseMemberRequest) (*pb.DefaultResponse, error) {
+	fId, err := uuid.Parse(req.FranchiseID)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for franchise id %q.", req.FranchiseID),
+		}, nil
+	}
+
+	memberId, err := uuid.Parse(req.MemberID)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for member id %q.", req.MemberID),
+		}, nil
+	}
+
+	role := models.Role(req.Role)
+	if !role.Valid() || role == models.Owner {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Invalid role %q, members are either %q or %q.", req.Role, models.CoOwner, models.AssistantGM),
+		}, nil
+	}
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		franchise, err := tx.FindFranchise(ctx, fId)
+		if err != nil {
+			return err
+		}
+		if err := authorize(ctx, tx, franchise, req.UserId, models.ManageMembers); err != nil {
+			return err
+		}
+		if franchise.UserID == memberId {
+			return fmt.Errorf("the owner's role only changes by transferring the franchise")
+		}
+		return tx.SaveFranchiseMember(ctx, &models.FranchiseMember{
+			FranchiseID: franchise.ID,
+			UserID:      memberId,
+			UserName:    req.MemberName,
+			Role:        role,
+		})
+	})
+
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Adding member to franchise (%s) failed: %v", req.FranchiseID, err),
+		}, nil
+	}
+
+	return &pb.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "member was successfully added",
+	}, nil
+}
+
+// RemoveFranchiseMember removes a member, members may also leave a franchise themselves
+func (s *Server) RemoveFranchiseMember(ctx context.Context, req *pb.FranchiseMemberRequest) (*pb.DefaultResponse, error) {
+	fId, err := uuid.Parse(req.FranchiseID)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for franchise id %q.", req.FranchiseID),
+		}, nil
+	}
+
+	memberId, err := uuid.Parse(req.MemberID)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for member id %q.", req.MemberID),
+		}, nil
+	}
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		franchise, err := tx.FindFranchise(ctx, fId)
+		if err != nil {
+			return err
+		}
+		// leaving needs no permission
+		if uId, err := uuid.Parse(req.UserId); err == nil && uId == memberId {
+			if _, err := franchiseLeague(ctx, tx, franchise); err != nil {
+				return err
+			}
+		} else if err := authorize(ctx, tx, franchise, req.UserId, models.ManageMembers); err != nil {
+			return err
+		}
+		if franchise.UserID == memberId {
+			return fmt.Errorf("the owner cannot be removed, transfer the franchise instead")
+		}
+		if _, err := tx.FindFranchiseMember(ctx, franchise.ID, memberId); err != nil {
+			return err
+		}
+		return tx.RemoveFranchiseMember(ctx, franchise.ID, memberId)
+	})
+
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Removing member from franchise (%s) failed: %v", req.FranchiseID, err),
+		}, nil
+	}
+
+	return &pb.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "member was successfully removed",
+	}, nil
+}
+
+func (s *Server) GetFranchiseMembers(ctx context.Context, req *pb.GetFranchiseRequest) (*pb.FranchiseMembersResponse, error) {
+	fId, err := uuid.Parse(req.FranchiseID)
+	if err != nil {
+		return &pb.FranchiseMembersResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for franchise id %q.", req.FranchiseID),
+		}, nil
+	}
+
+	members, err := s.R.ListFranchiseMembers(ctx, fId)
+	if err != nil {
+		return &pb.FranchiseMembersResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Getting members of franchise (%s) failed: %v", req.FranchiseID, err),
+		}, nil
+	}
+
+	membersRes := []*pb.FranchiseMember{}
+	for _, m := range members {
+		permissions := []string{}
+		for _, p := range m.Role.Permissions() {
+			permissions = append(permissions, string(p))
+		}
+		membersRes = append(membersRes, &pb.FranchiseMember{
+			UserID:      m.UserID.String(),
+			UserName:    m.UserName,
+			Role:        string(m.Role),
+			Permissions: permissions,
+		})
+	}
+
+	return &pb.FranchiseMembersResponse{
+		Status: http.StatusOK,
+		Result: membersRes,
+	}, nil
+}
+
+func (s *Server) GetLeagueFranchisePairs(ctx context.Context, req *pb.GetLeagueFranchisePairsRequest) (*pb.GetLeagueFranchisePairsResponse, error) {
+	uId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.GetLeagueFranchisePairsResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for user id %q.", req.UserId),
+		}, nil
+	}
+
+	franchises, err := s.R.ListMemberFranchises(ctx, uId)
+	if err != nil {
+		return &pb.GetLeagueFranchisePairsResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Getting franchises of user (%s) failed: %v", req.UserId, err),
+		}, nil
+	}
+
+	pairsRes := []*pb.LeagueFranchisePair{}
+	page := storage.Page{Size: storage.MaxPageSize}
+	for {
+		leagues, next, err := s.R.ListLeagues(ctx, page)
+		if err != nil {
+			return &pb.GetLeagueFranchisePairsResponse{
+				Status: http.StatusConflict,
+				Error:  fmt.Sprintf("Getting leagues failed: %v", err),
+			}, nil
+		}
+		for _, l := range leagues {
+			pairsRes = append(pairsRes, leagueFranchisePairs(l.ID, franchises)...)
+		}
+		if next == "" {
+			break
+		}
+		page.Token = next
+	}
+
+	return &pb.GetLeagueFranchisePairsResponse{
+		Status: http.StatusAccepted,
+		Result: pairsRes,
+	}, nil
+}
+
+// leagueFranchisePairs pairs the league with each of the franchises in it, or with no franchise
+func leagueFranchisePairs(leagueID uuid.UUID, franchises []models.Franchise) []*pb.LeagueFranchisePair {
+	pairs := []*pb.LeagueFranchisePair{}
+	for _, f := range franchises {
+		if f.LeagueID == leagueID {
+			pairs = append(pairs, &pb.LeagueFranchisePair{LeagueID: leagueID.String(), FranchiseID: f.ID.String()})
+		}
+	}
+	if len(pairs) == 0 {
+		pairs = append(pairs, &pb.LeagueFranchisePair{LeagueID: leagueID.String()})
+	}
+	return pairs
+}
+
+// authorize checks that the acting user has the permission on the franchise and that its league
+// is not archived. The league's commissioner and admin may do anything, requests without an
+// acting user are forbidden.
+func authorize(ctx context.Context, tx storage.Repository, franchise *models.Franchise, userID string, permission models.Permission) error {
+	league, err := franchiseLeague(ctx, tx, franchise)
+	if err != nil {
+		return err
+	}
+	uId, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("%w: could not parse user id %q", errForbidden, userID)
+	}
+	if leagueManager(league, uId) {
+		return nil
+	}
+
+	member, err := tx.FindFranchiseMember(ctx, franchise.ID, uId)
+	if errors.Is(err, storage.ErrNotFound) || (err == nil && !member.Role.Can(permission)) {
+		return fmt.Errorf("%w: user %s lacks the %s permission on franchise %s", errForbidden, uId, permission, franchise.ID)
+	}
+	return err
+}
+
+// franchiseLeague returns the league of the franchise if it is not archived
+func franchiseLeague(ctx context.Context, tx storage.Repository, franchise *models.Franchise) (*models.League, error) {
+	league, err := tx.FindLeague(ctx, franchise.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+	return league, writable(league)
+}
+
+// leagueManager reports whether the user is the league's commissioner or admin
+func leagueManager(league *models.League, userID uuid.UUID) bool {
+	return userID == league.CommissionerID || userID == league.AdminID
+}
+
+// authorizeLeague checks that the acting user is the league's commissioner or admin
+func authorizeLeague(league *models.League, userID string) error {
+	uId, err := uuid.Parse(userID)
+	if err != nil || !leagueManager(league, uId) {
+		return fmt.Errorf("%w: only the commissioner or admin of league %s may do this", errForbidden, league.ID)
+	}
+	return nil
+}
+
+// ownerMember is the member entry of the franchise's owner
+func ownerMember(f *models.Franchise) *models.FranchiseMember {
+	return &models.FranchiseMember{
+		FranchiseID: f.ID,
+		UserID:      f.UserID,
+		UserName:    f.UserName,
+		Role:        models.Owner,
+	}
+}
+
+// authStatus is the status of a request the acting user may not make, status otherwise
+func authStatus(err error, status int64) int64 {
+	if errors.Is(err, errForbidden) {
+		return http.StatusForbidden
+	}
+	return status
+}
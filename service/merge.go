@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/importer"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+func (s *Server) UpdateProspect(ctx context.Context, req *pb.UpdateProspectRequest) (*pb.ProspectResponse, error) {
+	pId, err := uuid.Parse(req.ProspectID)
+	if err != nil {
+		return &pb.ProspectResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for prospect id %q.", req.ProspectID),
+		}, nil
+	}
+	if req.Prospect == nil {
+		return &pb.ProspectResponse{
+			Status: http.StatusBadRequest,
+			Error:  "No prospect values given",
+		}, nil
+	}
+	row, err := prospectFromPb(req.Prospect)
+	if err != nil {
+		return &pb.ProspectResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Invalid prospect: %v", err),
+		}, nil
+	}
+
+	var prospect *models.Prospect
+	var changes []string
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		if prospect, err = tx.FindProspect(ctx, pId); err != nil {
+			return err
+		}
+		if changes = importer.MergeProspect(prospect, row); len(changes) == 0 {
+			return nil
+		}
+		pick := prospect.Pick
+		prospect.Pick = nil
+		if err := tx.SaveProspect(ctx, prospect); err != nil {
+			return err
+		}
+		prospect.Pick = pick
+		return nil
+	})
+
+	if err != nil {
+		return &pb.ProspectResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Updating prospect (%s) failed: %v", req.ProspectID, err),
+		}, nil
+	}
+
+	return &pb.ProspectResponse{
+		Status:   http.StatusOK,
+		Prospect: prospectToPb(*prospect),
+		Changes:  changes,
+	}, nil
+}
+
+func (s *Server) MergeProspects(ctx context.Context, req *pb.MergeProspectsRequest) (*pb.ProspectResponse, error) {
+	keepID, err := uuid.Parse(req.KeepID)
+	if err != nil {
+		return &pb.ProspectResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for prospect id %q.", req.KeepID),
+		}, nil
+	}
+	dropID, err := uuid.Parse(req.DropID)
+	if err != nil {
+		return &pb.ProspectResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for prospect id %q.", req.DropID),
+		}, nil
+	}
+	if keepID == dropID {
+		return &pb.ProspectResponse{
+			Status: http.StatusBadRequest,
+			Error:  "A prospect can't be merged into itself",
+		}, nil
+	}
+
+	var keep *models.Prospect
+	var changes []string
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		if keep, err = tx.FindProspect(ctx, keepID); err != nil {
+			return err
+		}
+		drop, err := tx.FindProspect(ctx, dropID)
+		if err != nil {
+			return err
+		}
+		if keep.FranchiseID != nil && drop.FranchiseID != nil && *keep.FranchiseID != *drop.FranchiseID {
+			return fmt.Errorf("the prospects are on the rosters of different franchises")
+		}
+		if keep.Pick != nil && drop.Pick != nil {
+			return fmt.Errorf("both prospects were drafted")
+		}
+
+		changes = importer.FillProspect(keep, *drop)
+		if keep.FranchiseID == nil && drop.FranchiseID != nil {
+			changes = append(changes, fmt.Sprintf("franchise: - -> %v", drop.FranchiseID))
+			keep.LeagueID, keep.FranchiseID, keep.Protected = drop.LeagueID, drop.FranchiseID, drop.Protected
+		}
+		keep.Pick = nil
+		if err := tx.SaveProspect(ctx, keep); err != nil {
+			return err
+		}
+		if err := tx.ReassignProspect(ctx, drop.ID, keep.ID); err != nil {
+			return err
+		}
+		if err := tx.DeleteProspect(ctx, drop.ID); err != nil {
+			return err
+		}
+		keep, err = tx.FindProspect(ctx, keepID)
+		return err
+	})
+
+	if err != nil {
+		return &pb.ProspectResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Merging prospect (%s) into (%s) failed: %v", req.DropID, req.KeepID, err),
+		}, nil
+	}
+
+	return &pb.ProspectResponse{
+		Status:   http.StatusOK,
+		Prospect: prospectToPb(*keep),
+		Changes:  changes,
+	}, nil
+}
+
+func (s *Server) GetDuplicateProspects(ctx context.Context, req *pb.DuplicateProspectsRequest) (*pb.DuplicateProspectsResponse, error) {
+	minSimilarity := req.MinSimilarity
+	if minSimilarity == 0 {
+		minSimilarity = importer.DefaultDuplicateSimilarity
+	}
+	if minSimilarity < 0 || minSimilarity > 1 {
+		return &pb.DuplicateProspectsResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("The similarity %v must be between 0 and 1", req.MinSimilarity),
+		}, nil
+	}
+
+	duplicates, err := importer.FindDuplicates(ctx, s.R, int(req.NhlDraftYear), minSimilarity)
+	if err != nil {
+		return &pb.DuplicateProspectsResponse{
+			Status: listStatus(err, http.StatusConflict),
+			Error:  fmt.Sprintf("Could not search duplicate prospects. Error: %v", err),
+		}, nil
+	}
+
+	result := []*pb.DuplicateProspects{}
+	for _, d := range duplicates {
+		result = append(result, &pb.DuplicateProspects{
+			First:      prospectToPb(d.First),
+			Second:     prospectToPb(d.Second),
+			Similarity: d.Similarity,
+			Reason:     d.Reason,
+		})
+	}
+	return &pb.DuplicateProspectsResponse{
+		Status: http.StatusOK,
+		Result: result,
+	}, nil
+}
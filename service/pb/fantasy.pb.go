@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,24 +21,296 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Position int32
+
+const (
+	Position_POSITION_UNSPECIFIED Position = 0
+	Position_POSITION_CENTER      Position = 1
+	Position_POSITION_LEFT_WING   Position = 2
+	Position_POSITION_RIGHT_WING  Position = 3
+	Position_POSITION_DEFENSEMAN  Position = 4
+	Position_POSITION_GOALIE      Position = 5
+)
+
+// Enum value maps for Position.
+var (
+	Position_name = map[int32]string{
+		0: "POSITION_UNSPECIFIED",
+		1: "POSITION_CENTER",
+		2: "POSITION_LEFT_WING",
+		3: "POSITION_RIGHT_WING",
+		4: "POSITION_DEFENSEMAN",
+		5: "POSITION_GOALIE",
+	}
+	Position_value = map[string]int32{
+		"POSITION_UNSPECIFIED": 0,
+		"POSITION_CENTER":      1,
+		"POSITION_LEFT_WING":   2,
+		"POSITION_RIGHT_WING":  3,
+		"POSITION_DEFENSEMAN":  4,
+		"POSITION_GOALIE":      5,
+	}
+)
+
+func (x Position) Enum() *Position {
+	p := new(Position)
+	*p = x
+	return p
+}
+
+func (x Position) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Position) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_pb_fantasy_proto_enumTypes[0].Descriptor()
+}
+
+func (Position) Type() protoreflect.EnumType {
+	return &file_service_pb_fantasy_proto_enumTypes[0]
+}
+
+func (x Position) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Position.Descriptor instead.
+func (Position) EnumDescriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{0}
+}
+
+type ProspectStatus int32
+
+const (
+	ProspectStatus_PROSPECT_STATUS_UNSPECIFIED    ProspectStatus = 0
+	ProspectStatus_PROSPECT_STATUS_UNSIGNED       ProspectStatus = 1
+	ProspectStatus_PROSPECT_STATUS_SIGNED_ELC     ProspectStatus = 2
+	ProspectStatus_PROSPECT_STATUS_AHL            ProspectStatus = 3
+	ProspectStatus_PROSPECT_STATUS_NHL_GRADUATED  ProspectStatus = 4
+	ProspectStatus_PROSPECT_STATUS_RIGHTS_EXPIRED ProspectStatus = 5
+	ProspectStatus_PROSPECT_STATUS_RETIRED        ProspectStatus = 6
+)
+
+// Enum value maps for ProspectStatus.
+var (
+	ProspectStatus_name = map[int32]string{
+		0: "PROSPECT_STATUS_UNSPECIFIED",
+		1: "PROSPECT_STATUS_UNSIGNED",
+		2: "PROSPECT_STATUS_SIGNED_ELC",
+		3: "PROSPECT_STATUS_AHL",
+		4: "PROSPECT_STATUS_NHL_GRADUATED",
+		5: "PROSPECT_STATUS_RIGHTS_EXPIRED",
+		6: "PROSPECT_STATUS_RETIRED",
+	}
+	ProspectStatus_value = map[string]int32{
+		"PROSPECT_STATUS_UNSPECIFIED":    0,
+		"PROSPECT_STATUS_UNSIGNED":       1,
+		"PROSPECT_STATUS_SIGNED_ELC":     2,
+		"PROSPECT_STATUS_AHL":            3,
+		"PROSPECT_STATUS_NHL_GRADUATED":  4,
+		"PROSPECT_STATUS_RIGHTS_EXPIRED": 5,
+		"PROSPECT_STATUS_RETIRED":        6,
+	}
+)
+
+func (x ProspectStatus) Enum() *ProspectStatus {
+	p := new(ProspectStatus)
+	*p = x
+	return p
+}
+
+func (x ProspectStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProspectStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_pb_fantasy_proto_enumTypes[1].Descriptor()
+}
+
+func (ProspectStatus) Type() protoreflect.EnumType {
+	return &file_service_pb_fantasy_proto_enumTypes[1]
+}
+
+func (x ProspectStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProspectStatus.Descriptor instead.
+func (ProspectStatus) EnumDescriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{1}
+}
+
+// what happens to a rostered prospect that changes to the status
+type StatusAction int32
+
+const (
+	StatusAction_STATUS_ACTION_KEEP      StatusAction = 0
+	StatusAction_STATUS_ACTION_RELEASE   StatusAction = 1
+	StatusAction_STATUS_ACTION_UNPROTECT StatusAction = 2
+)
+
+// Enum value maps for StatusAction.
+var (
+	StatusAction_name = map[int32]string{
+		0: "STATUS_ACTION_KEEP",
+		1: "STATUS_ACTION_RELEASE",
+		2: "STATUS_ACTION_UNPROTECT",
+	}
+	StatusAction_value = map[string]int32{
+		"STATUS_ACTION_KEEP":      0,
+		"STATUS_ACTION_RELEASE":   1,
+		"STATUS_ACTION_UNPROTECT": 2,
+	}
+)
+
+func (x StatusAction) Enum() *StatusAction {
+	p := new(StatusAction)
+	*p = x
+	return p
+}
+
+func (x StatusAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatusAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_pb_fantasy_proto_enumTypes[2].Descriptor()
+}
+
+func (StatusAction) Type() protoreflect.EnumType {
+	return &file_service_pb_fantasy_proto_enumTypes[2]
+}
+
+func (x StatusAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatusAction.Descriptor instead.
+func (StatusAction) EnumDescriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{2}
+}
+
+// tri-state flag, the zero value does not filter at all
+type BoolFilter int32
+
+const (
+	BoolFilter_BOOL_FILTER_ANY BoolFilter = 0
+	BoolFilter_BOOL_FILTER_YES BoolFilter = 1
+	BoolFilter_BOOL_FILTER_NO  BoolFilter = 2
+)
+
+// Enum value maps for BoolFilter.
+var (
+	BoolFilter_name = map[int32]string{
+		0: "BOOL_FILTER_ANY",
+		1: "BOOL_FILTER_YES",
+		2: "BOOL_FILTER_NO",
+	}
+	BoolFilter_value = map[string]int32{
+		"BOOL_FILTER_ANY": 0,
+		"BOOL_FILTER_YES": 1,
+		"BOOL_FILTER_NO":  2,
+	}
+)
+
+func (x BoolFilter) Enum() *BoolFilter {
+	p := new(BoolFilter)
+	*p = x
+	return p
+}
+
+func (x BoolFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BoolFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_pb_fantasy_proto_enumTypes[3].Descriptor()
+}
+
+func (BoolFilter) Type() protoreflect.EnumType {
+	return &file_service_pb_fantasy_proto_enumTypes[3]
+}
+
+func (x BoolFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BoolFilter.Descriptor instead.
+func (BoolFilter) EnumDescriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{3}
+}
+
+type ConsensusMethod int32
+
+const (
+	ConsensusMethod_CONSENSUS_METHOD_AVERAGE ConsensusMethod = 0
+	ConsensusMethod_CONSENSUS_METHOD_MEDIAN  ConsensusMethod = 1
+)
+
+// Enum value maps for ConsensusMethod.
+var (
+	ConsensusMethod_name = map[int32]string{
+		0: "CONSENSUS_METHOD_AVERAGE",
+		1: "CONSENSUS_METHOD_MEDIAN",
+	}
+	ConsensusMethod_value = map[string]int32{
+		"CONSENSUS_METHOD_AVERAGE": 0,
+		"CONSENSUS_METHOD_MEDIAN":  1,
+	}
+)
+
+func (x ConsensusMethod) Enum() *ConsensusMethod {
+	p := new(ConsensusMethod)
+	*p = x
+	return p
+}
+
+func (x ConsensusMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConsensusMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_pb_fantasy_proto_enumTypes[4].Descriptor()
+}
+
+func (ConsensusMethod) Type() protoreflect.EnumType {
+	return &file_service_pb_fantasy_proto_enumTypes[4]
+}
+
+func (x ConsensusMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConsensusMethod.Descriptor instead.
+func (ConsensusMethod) EnumDescriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{4}
+}
+
 type League struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                string       `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Admin             string       `protobuf:"bytes,2,opt,name=Admin,proto3" json:"Admin,omitempty"`
-	AdminID           string       `protobuf:"bytes,3,opt,name=AdminID,proto3" json:"AdminID,omitempty"`
-	Commissioner      string       `protobuf:"bytes,4,opt,name=Commissioner,proto3" json:"Commissioner,omitempty"`
-	CommissionerID    string       `protobuf:"bytes,5,opt,name=CommissionerID,proto3" json:"CommissionerID,omitempty"`
-	Name              string       `protobuf:"bytes,6,opt,name=Name,proto3" json:"Name,omitempty"`
-	FoundationYear    string       `protobuf:"bytes,7,opt,name=FoundationYear,proto3" json:"FoundationYear,omitempty"`
-	MaxFranchises     int32        `protobuf:"varint,8,opt,name=MaxFranchises,proto3" json:"MaxFranchises,omitempty"`
-	MaxProspects      int32        `protobuf:"varint,9,opt,name=MaxProspects,proto3" json:"MaxProspects,omitempty"`
-	DraftRightsGoalie int32        `protobuf:"varint,10,opt,name=DraftRightsGoalie,proto3" json:"DraftRightsGoalie,omitempty"`
-	DraftRightsSkater int32        `protobuf:"varint,11,opt,name=DraftRightsSkater,proto3" json:"DraftRightsSkater,omitempty"`
-	DraftRounds       int32        `protobuf:"varint,12,opt,name=DraftRounds,proto3" json:"DraftRounds,omitempty"`
-	Franchises        []*Franchise `protobuf:"bytes,13,rep,name=Franchises,proto3" json:"Franchises,omitempty"`
+	ID                string            `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Admin             string            `protobuf:"bytes,2,opt,name=Admin,proto3" json:"Admin,omitempty"`
+	AdminID           string            `protobuf:"bytes,3,opt,name=AdminID,proto3" json:"AdminID,omitempty"`
+	Commissioner      string            `protobuf:"bytes,4,opt,name=Commissioner,proto3" json:"Commissioner,omitempty"`
+	CommissionerID    string            `protobuf:"bytes,5,opt,name=CommissionerID,proto3" json:"CommissionerID,omitempty"`
+	Name              string            `protobuf:"bytes,6,opt,name=Name,proto3" json:"Name,omitempty"`
+	FoundationYear    string            `protobuf:"bytes,7,opt,name=FoundationYear,proto3" json:"FoundationYear,omitempty"`
+	MaxFranchises     int32             `protobuf:"varint,8,opt,name=MaxFranchises,proto3" json:"MaxFranchises,omitempty"`
+	MaxProspects      int32             `protobuf:"varint,9,opt,name=MaxProspects,proto3" json:"MaxProspects,omitempty"`
+	DraftRightsGoalie int32             `protobuf:"varint,10,opt,name=DraftRightsGoalie,proto3" json:"DraftRightsGoalie,omitempty"`
+	DraftRightsSkater int32             `protobuf:"varint,11,opt,name=DraftRightsSkater,proto3" json:"DraftRightsSkater,omitempty"`
+	DraftRounds       int32             `protobuf:"varint,12,opt,name=DraftRounds,proto3" json:"DraftRounds,omitempty"`
+	Franchises        []*Franchise      `protobuf:"bytes,13,rep,name=Franchises,proto3" json:"Franchises,omitempty"`
+	Founded           int32             `protobuf:"varint,14,opt,name=founded,proto3" json:"founded,omitempty"`
+	Season            int32             `protobuf:"varint,15,opt,name=season,proto3" json:"season,omitempty"`
+	Archived          bool              `protobuf:"varint,16,opt,name=archived,proto3" json:"archived,omitempty"`
+	ClonedFromID      string            `protobuf:"bytes,17,opt,name=clonedFromID,proto3" json:"clonedFromID,omitempty"`
+	FuturePickYears   int32             `protobuf:"varint,18,opt,name=futurePickYears,proto3" json:"futurePickYears,omitempty"`
+	StatusRules       []*StatusRule     `protobuf:"bytes,19,rep,name=statusRules,proto3" json:"statusRules,omitempty"`
+	Eligibility       *EligibilityRules `protobuf:"bytes,20,opt,name=eligibility,proto3" json:"eligibility,omitempty"`
 }
 
 func (x *League) Reset() {
@@ -163,6 +436,55 @@ func (x *League) GetFranchises() []*Franchise {
 	return nil
 }
 
+func (x *League) GetFounded() int32 {
+	if x != nil {
+		return x.Founded
+	}
+	return 0
+}
+
+func (x *League) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *League) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *League) GetClonedFromID() string {
+	if x != nil {
+		return x.ClonedFromID
+	}
+	return ""
+}
+
+func (x *League) GetFuturePickYears() int32 {
+	if x != nil {
+		return x.FuturePickYears
+	}
+	return 0
+}
+
+func (x *League) GetStatusRules() []*StatusRule {
+	if x != nil {
+		return x.StatusRules
+	}
+	return nil
+}
+
+func (x *League) GetEligibility() *EligibilityRules {
+	if x != nil {
+		return x.Eligibility
+	}
+	return nil
+}
+
 type Franchise struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -175,6 +497,7 @@ type Franchise struct {
 	FoundationYear string      `protobuf:"bytes,5,opt,name=FoundationYear,proto3" json:"FoundationYear,omitempty"`
 	LeagueID       string      `protobuf:"bytes,6,opt,name=LeagueID,proto3" json:"LeagueID,omitempty"`
 	Prospects      []*Prospect `protobuf:"bytes,7,rep,name=Prospects,proto3" json:"Prospects,omitempty"`
+	Founded        int32       `protobuf:"varint,8,opt,name=founded,proto3" json:"founded,omitempty"`
 }
 
 func (x *Franchise) Reset() {
@@ -258,28 +581,46 @@ func (x *Franchise) GetProspects() []*Prospect {
 	return nil
 }
 
+func (x *Franchise) GetFounded() int32 {
+	if x != nil {
+		return x.Founded
+	}
+	return 0
+}
+
 type Prospect struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                  string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	FullName            string `protobuf:"bytes,2,opt,name=FullName,proto3" json:"FullName,omitempty"`
-	FirstName           string `protobuf:"bytes,3,opt,name=FirstName,proto3" json:"FirstName,omitempty"`
-	LastName            string `protobuf:"bytes,4,opt,name=LastName,proto3" json:"LastName,omitempty"`
-	NhlTeam             string `protobuf:"bytes,5,opt,name=NhlTeam,proto3" json:"NhlTeam,omitempty"`
-	NhlDraftYear        string `protobuf:"bytes,6,opt,name=NhlDraftYear,proto3" json:"NhlDraftYear,omitempty"`
-	Birthdate           string `protobuf:"bytes,7,opt,name=Birthdate,proto3" json:"Birthdate,omitempty"`
-	Height              string `protobuf:"bytes,8,opt,name=Height,proto3" json:"Height,omitempty"`
-	Weight              string `protobuf:"bytes,9,opt,name=Weight,proto3" json:"Weight,omitempty"`
-	NhlDraftRound       string `protobuf:"bytes,10,opt,name=NhlDraftRound,proto3" json:"NhlDraftRound,omitempty"`
-	NhlDraftPickOverall string `protobuf:"bytes,11,opt,name=NhlDraftPickOverall,proto3" json:"NhlDraftPickOverall,omitempty"`
-	NhlPickInRound      string `protobuf:"bytes,12,opt,name=NhlPickInRound,proto3" json:"NhlPickInRound,omitempty"`
-	PositionCode        string `protobuf:"bytes,13,opt,name=PositionCode,proto3" json:"PositionCode,omitempty"`
-	LeagueID            string `protobuf:"bytes,14,opt,name=LeagueID,proto3" json:"LeagueID,omitempty"`
-	FranchiseID         string `protobuf:"bytes,15,opt,name=FranchiseID,proto3" json:"FranchiseID,omitempty"`
-	Pick                *Pick  `protobuf:"bytes,16,opt,name=Pick,proto3" json:"Pick,omitempty"`
-	Protected           string `protobuf:"bytes,17,opt,name=Protected,proto3" json:"Protected,omitempty"`
+	ID                  string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	FullName            string                 `protobuf:"bytes,2,opt,name=FullName,proto3" json:"FullName,omitempty"`
+	FirstName           string                 `protobuf:"bytes,3,opt,name=FirstName,proto3" json:"FirstName,omitempty"`
+	LastName            string                 `protobuf:"bytes,4,opt,name=LastName,proto3" json:"LastName,omitempty"`
+	NhlTeam             string                 `protobuf:"bytes,5,opt,name=NhlTeam,proto3" json:"NhlTeam,omitempty"`
+	NhlDraftYear        string                 `protobuf:"bytes,6,opt,name=NhlDraftYear,proto3" json:"NhlDraftYear,omitempty"`
+	Birthdate           string                 `protobuf:"bytes,7,opt,name=Birthdate,proto3" json:"Birthdate,omitempty"`
+	Height              string                 `protobuf:"bytes,8,opt,name=Height,proto3" json:"Height,omitempty"`
+	Weight              string                 `protobuf:"bytes,9,opt,name=Weight,proto3" json:"Weight,omitempty"`
+	NhlDraftRound       string                 `protobuf:"bytes,10,opt,name=NhlDraftRound,proto3" json:"NhlDraftRound,omitempty"`
+	NhlDraftPickOverall string                 `protobuf:"bytes,11,opt,name=NhlDraftPickOverall,proto3" json:"NhlDraftPickOverall,omitempty"`
+	NhlPickInRound      string                 `protobuf:"bytes,12,opt,name=NhlPickInRound,proto3" json:"NhlPickInRound,omitempty"`
+	PositionCode        string                 `protobuf:"bytes,13,opt,name=PositionCode,proto3" json:"PositionCode,omitempty"`
+	LeagueID            string                 `protobuf:"bytes,14,opt,name=LeagueID,proto3" json:"LeagueID,omitempty"`
+	FranchiseID         string                 `protobuf:"bytes,15,opt,name=FranchiseID,proto3" json:"FranchiseID,omitempty"`
+	Pick                *Pick                  `protobuf:"bytes,16,opt,name=Pick,proto3" json:"Pick,omitempty"`
+	Protected           string                 `protobuf:"bytes,17,opt,name=Protected,proto3" json:"Protected,omitempty"`
+	Position            Position               `protobuf:"varint,18,opt,name=position,proto3,enum=fantasy.Position" json:"position,omitempty"`
+	DateOfBirth         *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	HeightInches        int32                  `protobuf:"varint,20,opt,name=heightInches,proto3" json:"heightInches,omitempty"`
+	WeightPounds        int32                  `protobuf:"varint,21,opt,name=weightPounds,proto3" json:"weightPounds,omitempty"`
+	NhlDraft            *NhlDraft              `protobuf:"bytes,22,opt,name=nhlDraft,proto3" json:"nhlDraft,omitempty"`
+	IsProtected         bool                   `protobuf:"varint,23,opt,name=isProtected,proto3" json:"isProtected,omitempty"`
+	// the caller's watchlist entry, only set if requested
+	Watchlist *WatchlistEntry `protobuf:"bytes,24,opt,name=watchlist,proto3" json:"watchlist,omitempty"`
+	Status    ProspectStatus  `protobuf:"varint,25,opt,name=status,proto3,enum=fantasy.ProspectStatus" json:"status,omitempty"`
+	// effective date of the status, unset if it never changed
+	StatusSince *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=statusSince,proto3" json:"statusSince,omitempty"`
 }
 
 func (x *Prospect) Reset() {
@@ -433,6 +774,69 @@ func (x *Prospect) GetProtected() string {
 	return ""
 }
 
+func (x *Prospect) GetPosition() Position {
+	if x != nil {
+		return x.Position
+	}
+	return Position_POSITION_UNSPECIFIED
+}
+
+func (x *Prospect) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *Prospect) GetHeightInches() int32 {
+	if x != nil {
+		return x.HeightInches
+	}
+	return 0
+}
+
+func (x *Prospect) GetWeightPounds() int32 {
+	if x != nil {
+		return x.WeightPounds
+	}
+	return 0
+}
+
+func (x *Prospect) GetNhlDraft() *NhlDraft {
+	if x != nil {
+		return x.NhlDraft
+	}
+	return nil
+}
+
+func (x *Prospect) GetIsProtected() bool {
+	if x != nil {
+		return x.IsProtected
+	}
+	return false
+}
+
+func (x *Prospect) GetWatchlist() *WatchlistEntry {
+	if x != nil {
+		return x.Watchlist
+	}
+	return nil
+}
+
+func (x *Prospect) GetStatus() ProspectStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProspectStatus_PROSPECT_STATUS_UNSPECIFIED
+}
+
+func (x *Prospect) GetStatusSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusSince
+	}
+	return nil
+}
+
 type Pick struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -450,6 +854,21 @@ type Pick struct {
 	LastOwnerName    string `protobuf:"bytes,10,opt,name=LastOwnerName,proto3" json:"LastOwnerName,omitempty"`
 	OriginID         string `protobuf:"bytes,11,opt,name=OriginID,proto3" json:"OriginID,omitempty"`
 	OriginName       string `protobuf:"bytes,12,opt,name=OriginName,proto3" json:"OriginName,omitempty"`
+	Year             int32  `protobuf:"varint,13,opt,name=year,proto3" json:"year,omitempty"`
+	Round            int32  `protobuf:"varint,14,opt,name=round,proto3" json:"round,omitempty"`
+	PickOverall      int32  `protobuf:"varint,15,opt,name=pickOverall,proto3" json:"pickOverall,omitempty"`
+	PickInRound      int32  `protobuf:"varint,16,opt,name=pickInRound,proto3" json:"pickInRound,omitempty"`
+	// the pending conditions of trades the pick is part of
+	Conditions []*PickCondition `protobuf:"bytes,17,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	// the pending swaps the pick is part of
+	Swaps []*PickSwap `protobuf:"bytes,18,rep,name=swaps,proto3" json:"swaps,omitempty"`
+	// the slot in the round before supplemental and forfeited picks, zero for supplemental picks at the end of the round
+	Slot         int32 `protobuf:"varint,19,opt,name=slot,proto3" json:"slot,omitempty"`
+	Supplemental bool  `protobuf:"varint,20,opt,name=supplemental,proto3" json:"supplemental,omitempty"`
+	// forfeited picks are void and not part of the draft order
+	Forfeited   bool                   `protobuf:"varint,21,opt,name=forfeited,proto3" json:"forfeited,omitempty"`
+	ForfeitedAt *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=forfeitedAt,proto3" json:"forfeitedAt,omitempty"`
+	Reason      string                 `protobuf:"bytes,23,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (x *Pick) Reset() {
@@ -568,104 +987,119 @@ func (x *Pick) GetOriginName() string {
 	return ""
 }
 
-type DraftPick struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Pick) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
 
-	DraftYear        string `protobuf:"bytes,2,opt,name=DraftYear,proto3" json:"DraftYear,omitempty"`
-	DraftRound       string `protobuf:"bytes,3,opt,name=DraftRound,proto3" json:"DraftRound,omitempty"`
-	DraftPickOverall string `protobuf:"bytes,4,opt,name=DraftPickOverall,proto3" json:"DraftPickOverall,omitempty"`
-	DraftPickInRound string `protobuf:"bytes,5,opt,name=DraftPickInRound,proto3" json:"DraftPickInRound,omitempty"`
+func (x *Pick) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
 }
 
-func (x *DraftPick) Reset() {
-	*x = DraftPick{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Pick) GetPickOverall() int32 {
+	if x != nil {
+		return x.PickOverall
 	}
+	return 0
 }
 
-func (x *DraftPick) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Pick) GetPickInRound() int32 {
+	if x != nil {
+		return x.PickInRound
+	}
+	return 0
 }
 
-func (*DraftPick) ProtoMessage() {}
+func (x *Pick) GetConditions() []*PickCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
 
-func (x *DraftPick) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Pick) GetSwaps() []*PickSwap {
+	if x != nil {
+		return x.Swaps
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DraftPick.ProtoReflect.Descriptor instead.
-func (*DraftPick) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{4}
+func (x *Pick) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
 }
 
-func (x *DraftPick) GetDraftYear() string {
+func (x *Pick) GetSupplemental() bool {
 	if x != nil {
-		return x.DraftYear
+		return x.Supplemental
 	}
-	return ""
+	return false
 }
 
-func (x *DraftPick) GetDraftRound() string {
+func (x *Pick) GetForfeited() bool {
 	if x != nil {
-		return x.DraftRound
+		return x.Forfeited
 	}
-	return ""
+	return false
 }
 
-func (x *DraftPick) GetDraftPickOverall() string {
+func (x *Pick) GetForfeitedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DraftPickOverall
+		return x.ForfeitedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *DraftPick) GetDraftPickInRound() string {
+func (x *Pick) GetReason() string {
 	if x != nil {
-		return x.DraftPickInRound
+		return x.Reason
 	}
 	return ""
 }
 
-type DefaultResponse struct {
+// a pick traded with a protection, it stays with the trading franchise until its position
+// in the round is set. The substitute pick is conveyed instead if the protection applies.
+type PickCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status  int64  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	ID                string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	PickID            string                 `protobuf:"bytes,2,opt,name=pickID,proto3" json:"pickID,omitempty"`
+	FromFranchiseID   string                 `protobuf:"bytes,3,opt,name=fromFranchiseID,proto3" json:"fromFranchiseID,omitempty"`
+	FromFranchiseName string                 `protobuf:"bytes,4,opt,name=fromFranchiseName,proto3" json:"fromFranchiseName,omitempty"`
+	ToFranchiseID     string                 `protobuf:"bytes,5,opt,name=toFranchiseID,proto3" json:"toFranchiseID,omitempty"`
+	ToFranchiseName   string                 `protobuf:"bytes,6,opt,name=toFranchiseName,proto3" json:"toFranchiseName,omitempty"`
+	ProtectedTop      int32                  `protobuf:"varint,7,opt,name=protectedTop,proto3" json:"protectedTop,omitempty"`
+	SubstitutePickID  string                 `protobuf:"bytes,8,opt,name=substitutePickID,proto3" json:"substitutePickID,omitempty"`
+	Status            string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	ResolvedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=resolvedAt,proto3" json:"resolvedAt,omitempty"`
 }
 
-func (x *DefaultResponse) Reset() {
-	*x = DefaultResponse{}
+func (x *PickCondition) Reset() {
+	*x = PickCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[5]
+		mi := &file_service_pb_fantasy_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DefaultResponse) String() string {
+func (x *PickCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefaultResponse) ProtoMessage() {}
+func (*PickCondition) ProtoMessage() {}
 
-func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[5]
+func (x *PickCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,68 +1110,108 @@ func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefaultResponse.ProtoReflect.Descriptor instead.
-func (*DefaultResponse) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PickCondition.ProtoReflect.Descriptor instead.
+func (*PickCondition) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DefaultResponse) GetStatus() int64 {
+func (x *PickCondition) GetID() string {
 	if x != nil {
-		return x.Status
+		return x.ID
+	}
+	return ""
+}
+
+func (x *PickCondition) GetPickID() string {
+	if x != nil {
+		return x.PickID
+	}
+	return ""
+}
+
+func (x *PickCondition) GetFromFranchiseID() string {
+	if x != nil {
+		return x.FromFranchiseID
+	}
+	return ""
+}
+
+func (x *PickCondition) GetFromFranchiseName() string {
+	if x != nil {
+		return x.FromFranchiseName
+	}
+	return ""
+}
+
+func (x *PickCondition) GetToFranchiseID() string {
+	if x != nil {
+		return x.ToFranchiseID
+	}
+	return ""
+}
+
+func (x *PickCondition) GetToFranchiseName() string {
+	if x != nil {
+		return x.ToFranchiseName
+	}
+	return ""
+}
+
+func (x *PickCondition) GetProtectedTop() int32 {
+	if x != nil {
+		return x.ProtectedTop
 	}
 	return 0
 }
 
-func (x *DefaultResponse) GetError() string {
+func (x *PickCondition) GetSubstitutePickID() string {
 	if x != nil {
-		return x.Error
+		return x.SubstitutePickID
 	}
 	return ""
 }
 
-func (x *DefaultResponse) GetMessage() string {
+func (x *PickCondition) GetStatus() string {
 	if x != nil {
-		return x.Message
+		return x.Status
 	}
 	return ""
 }
 
-// create
-type LeagueRequest struct {
+func (x *PickCondition) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type PickConditionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Admin             string `protobuf:"bytes,1,opt,name=Admin,proto3" json:"Admin,omitempty"`
-	AdminID           string `protobuf:"bytes,2,opt,name=AdminID,proto3" json:"AdminID,omitempty"`
-	Commissioner      string `protobuf:"bytes,3,opt,name=Commissioner,proto3" json:"Commissioner,omitempty"`
-	CommissionerID    string `protobuf:"bytes,4,opt,name=CommissionerID,proto3" json:"CommissionerID,omitempty"`
-	Name              string `protobuf:"bytes,5,opt,name=Name,proto3" json:"Name,omitempty"`
-	FoundationYear    string `protobuf:"bytes,6,opt,name=FoundationYear,proto3" json:"FoundationYear,omitempty"`
-	MaxFranchises     int32  `protobuf:"varint,7,opt,name=MaxFranchises,proto3" json:"MaxFranchises,omitempty"`
-	MaxProspects      int32  `protobuf:"varint,8,opt,name=MaxProspects,proto3" json:"MaxProspects,omitempty"`
-	DraftRightsGoalie int32  `protobuf:"varint,9,opt,name=DraftRightsGoalie,proto3" json:"DraftRightsGoalie,omitempty"`
-	DraftRightsSkater int32  `protobuf:"varint,10,opt,name=DraftRightsSkater,proto3" json:"DraftRightsSkater,omitempty"`
-	DraftRounds       int32  `protobuf:"varint,11,opt,name=DraftRounds,proto3" json:"DraftRounds,omitempty"`
+	Status int64            `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result []*PickCondition `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *LeagueRequest) Reset() {
-	*x = LeagueRequest{}
+func (x *PickConditionsResponse) Reset() {
+	*x = PickConditionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[6]
+		mi := &file_service_pb_fantasy_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LeagueRequest) String() string {
+func (x *PickConditionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeagueRequest) ProtoMessage() {}
+func (*PickConditionsResponse) ProtoMessage() {}
 
-func (x *LeagueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[6]
+func (x *PickConditionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,100 +1222,157 @@ func (x *LeagueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeagueRequest.ProtoReflect.Descriptor instead.
-func (*LeagueRequest) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use PickConditionsResponse.ProtoReflect.Descriptor instead.
+func (*PickConditionsResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *LeagueRequest) GetAdmin() string {
+func (x *PickConditionsResponse) GetStatus() int64 {
 	if x != nil {
-		return x.Admin
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *LeagueRequest) GetAdminID() string {
+func (x *PickConditionsResponse) GetError() string {
 	if x != nil {
-		return x.AdminID
+		return x.Error
 	}
 	return ""
 }
 
-func (x *LeagueRequest) GetCommissioner() string {
+func (x *PickConditionsResponse) GetResult() []*PickCondition {
 	if x != nil {
-		return x.Commissioner
+		return x.Result
+	}
+	return nil
+}
+
+// the right of the holding franchise to swap its pick with the granting franchise's pick,
+// it is resolved once both picks have their overall position
+type PickSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                   string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	HolderFranchiseID    string                 `protobuf:"bytes,2,opt,name=holderFranchiseID,proto3" json:"holderFranchiseID,omitempty"`
+	HolderFranchiseName  string                 `protobuf:"bytes,3,opt,name=holderFranchiseName,proto3" json:"holderFranchiseName,omitempty"`
+	HolderPickID         string                 `protobuf:"bytes,4,opt,name=holderPickID,proto3" json:"holderPickID,omitempty"`
+	GrantorFranchiseID   string                 `protobuf:"bytes,5,opt,name=grantorFranchiseID,proto3" json:"grantorFranchiseID,omitempty"`
+	GrantorFranchiseName string                 `protobuf:"bytes,6,opt,name=grantorFranchiseName,proto3" json:"grantorFranchiseName,omitempty"`
+	GrantorPickID        string                 `protobuf:"bytes,7,opt,name=grantorPickID,proto3" json:"grantorPickID,omitempty"`
+	Status               string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	ResolvedAt           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=resolvedAt,proto3" json:"resolvedAt,omitempty"`
+}
+
+func (x *PickSwap) Reset() {
+	*x = PickSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PickSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickSwap) ProtoMessage() {}
+
+func (x *PickSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickSwap.ProtoReflect.Descriptor instead.
+func (*PickSwap) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PickSwap) GetID() string {
+	if x != nil {
+		return x.ID
 	}
 	return ""
 }
 
-func (x *LeagueRequest) GetCommissionerID() string {
+func (x *PickSwap) GetHolderFranchiseID() string {
 	if x != nil {
-		return x.CommissionerID
+		return x.HolderFranchiseID
 	}
 	return ""
 }
 
-func (x *LeagueRequest) GetName() string {
+func (x *PickSwap) GetHolderFranchiseName() string {
 	if x != nil {
-		return x.Name
+		return x.HolderFranchiseName
 	}
 	return ""
 }
 
-func (x *LeagueRequest) GetFoundationYear() string {
+func (x *PickSwap) GetHolderPickID() string {
 	if x != nil {
-		return x.FoundationYear
+		return x.HolderPickID
 	}
 	return ""
 }
 
-func (x *LeagueRequest) GetMaxFranchises() int32 {
+func (x *PickSwap) GetGrantorFranchiseID() string {
 	if x != nil {
-		return x.MaxFranchises
+		return x.GrantorFranchiseID
 	}
-	return 0
+	return ""
 }
 
-func (x *LeagueRequest) GetMaxProspects() int32 {
+func (x *PickSwap) GetGrantorFranchiseName() string {
 	if x != nil {
-		return x.MaxProspects
+		return x.GrantorFranchiseName
 	}
-	return 0
+	return ""
 }
 
-func (x *LeagueRequest) GetDraftRightsGoalie() int32 {
+func (x *PickSwap) GetGrantorPickID() string {
 	if x != nil {
-		return x.DraftRightsGoalie
+		return x.GrantorPickID
 	}
-	return 0
+	return ""
 }
 
-func (x *LeagueRequest) GetDraftRightsSkater() int32 {
+func (x *PickSwap) GetStatus() string {
 	if x != nil {
-		return x.DraftRightsSkater
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *LeagueRequest) GetDraftRounds() int32 {
+func (x *PickSwap) GetResolvedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DraftRounds
+		return x.ResolvedAt
 	}
-	return 0
+	return nil
 }
 
-// update
-type LeagueUpdateRequest struct {
+type PickSwapsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	League *LeagueRequest `protobuf:"bytes,2,opt,name=league,proto3" json:"league,omitempty"`
+	Status int64       `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string      `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result []*PickSwap `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *LeagueUpdateRequest) Reset() {
-	*x = LeagueUpdateRequest{}
+func (x *PickSwapsResponse) Reset() {
+	*x = PickSwapsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_pb_fantasy_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -849,13 +1380,13 @@ func (x *LeagueUpdateRequest) Reset() {
 	}
 }
 
-func (x *LeagueUpdateRequest) String() string {
+func (x *PickSwapsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeagueUpdateRequest) ProtoMessage() {}
+func (*PickSwapsResponse) ProtoMessage() {}
 
-func (x *LeagueUpdateRequest) ProtoReflect() protoreflect.Message {
+func (x *PickSwapsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_service_pb_fantasy_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -867,37 +1398,46 @@ func (x *LeagueUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeagueUpdateRequest.ProtoReflect.Descriptor instead.
-func (*LeagueUpdateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PickSwapsResponse.ProtoReflect.Descriptor instead.
+func (*PickSwapsResponse) Descriptor() ([]byte, []int) {
 	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *LeagueUpdateRequest) GetId() string {
+func (x *PickSwapsResponse) GetStatus() int64 {
 	if x != nil {
-		return x.Id
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PickSwapsResponse) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-func (x *LeagueUpdateRequest) GetLeague() *LeagueRequest {
+func (x *PickSwapsResponse) GetResult() []*PickSwap {
 	if x != nil {
-		return x.League
+		return x.Result
 	}
 	return nil
 }
 
-type LeagueResponse struct {
+// the draft a prospect was picked in by an nhl team, zero if undrafted
+type NhlDraft struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status   int64  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error    string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	LeagueId string `protobuf:"bytes,3,opt,name=leagueId,proto3" json:"leagueId,omitempty"`
+	Year        int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Round       int32 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	PickOverall int32 `protobuf:"varint,3,opt,name=pickOverall,proto3" json:"pickOverall,omitempty"`
+	PickInRound int32 `protobuf:"varint,4,opt,name=pickInRound,proto3" json:"pickInRound,omitempty"`
 }
 
-func (x *LeagueResponse) Reset() {
-	*x = LeagueResponse{}
+func (x *NhlDraft) Reset() {
+	*x = NhlDraft{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_pb_fantasy_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -905,13 +1445,13 @@ func (x *LeagueResponse) Reset() {
 	}
 }
 
-func (x *LeagueResponse) String() string {
+func (x *NhlDraft) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeagueResponse) ProtoMessage() {}
+func (*NhlDraft) ProtoMessage() {}
 
-func (x *LeagueResponse) ProtoReflect() protoreflect.Message {
+func (x *NhlDraft) ProtoReflect() protoreflect.Message {
 	mi := &file_service_pb_fantasy_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -923,41 +1463,50 @@ func (x *LeagueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeagueResponse.ProtoReflect.Descriptor instead.
-func (*LeagueResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use NhlDraft.ProtoReflect.Descriptor instead.
+func (*NhlDraft) Descriptor() ([]byte, []int) {
 	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *LeagueResponse) GetStatus() int64 {
+func (x *NhlDraft) GetYear() int32 {
 	if x != nil {
-		return x.Status
+		return x.Year
 	}
 	return 0
 }
 
-func (x *LeagueResponse) GetError() string {
+func (x *NhlDraft) GetRound() int32 {
 	if x != nil {
-		return x.Error
+		return x.Round
 	}
-	return ""
+	return 0
 }
 
-func (x *LeagueResponse) GetLeagueId() string {
+func (x *NhlDraft) GetPickOverall() int32 {
 	if x != nil {
-		return x.LeagueId
+		return x.PickOverall
 	}
-	return ""
+	return 0
 }
 
-// get all leagues
-type GetLeaguesRequest struct {
+func (x *NhlDraft) GetPickInRound() int32 {
+	if x != nil {
+		return x.PickInRound
+	}
+	return 0
+}
+
+type StatusRule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Status ProspectStatus `protobuf:"varint,1,opt,name=status,proto3,enum=fantasy.ProspectStatus" json:"status,omitempty"`
+	Action StatusAction   `protobuf:"varint,2,opt,name=action,proto3,enum=fantasy.StatusAction" json:"action,omitempty"`
 }
 
-func (x *GetLeaguesRequest) Reset() {
-	*x = GetLeaguesRequest{}
+func (x *StatusRule) Reset() {
+	*x = StatusRule{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_pb_fantasy_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -965,13 +1514,13 @@ func (x *GetLeaguesRequest) Reset() {
 	}
 }
 
-func (x *GetLeaguesRequest) String() string {
+func (x *StatusRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeaguesRequest) ProtoMessage() {}
+func (*StatusRule) ProtoMessage() {}
 
-func (x *GetLeaguesRequest) ProtoReflect() protoreflect.Message {
+func (x *StatusRule) ProtoReflect() protoreflect.Message {
 	mi := &file_service_pb_fantasy_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -983,23 +1532,37 @@ func (x *GetLeaguesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeaguesRequest.ProtoReflect.Descriptor instead.
-func (*GetLeaguesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StatusRule.ProtoReflect.Descriptor instead.
+func (*StatusRule) Descriptor() ([]byte, []int) {
 	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{9}
 }
 
-type GetLeaguesResponse struct {
+func (x *StatusRule) GetStatus() ProspectStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProspectStatus_PROSPECT_STATUS_UNSPECIFIED
+}
+
+func (x *StatusRule) GetAction() StatusAction {
+	if x != nil {
+		return x.Action
+	}
+	return StatusAction_STATUS_ACTION_KEEP
+}
+
+// the nhl draft years eligible in a fantasy draft relative to its year, e.g. -1 to 0
+type DraftYearOffsets struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int64     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error  string    `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	Result []*League `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
+	Min int32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max int32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
 }
 
-func (x *GetLeaguesResponse) Reset() {
-	*x = GetLeaguesResponse{}
+func (x *DraftYearOffsets) Reset() {
+	*x = DraftYearOffsets{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_pb_fantasy_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1007,13 +1570,13 @@ func (x *GetLeaguesResponse) Reset() {
 	}
 }
 
-func (x *GetLeaguesResponse) String() string {
+func (x *DraftYearOffsets) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeaguesResponse) ProtoMessage() {}
+func (*DraftYearOffsets) ProtoMessage() {}
 
-func (x *GetLeaguesResponse) ProtoReflect() protoreflect.Message {
+func (x *DraftYearOffsets) ProtoReflect() protoreflect.Message {
 	mi := &file_service_pb_fantasy_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1025,43 +1588,41 @@ func (x *GetLeaguesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeaguesResponse.ProtoReflect.Descriptor instead.
-func (*GetLeaguesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DraftYearOffsets.ProtoReflect.Descriptor instead.
+func (*DraftYearOffsets) Descriptor() ([]byte, []int) {
 	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetLeaguesResponse) GetStatus() int64 {
+func (x *DraftYearOffsets) GetMin() int32 {
 	if x != nil {
-		return x.Status
+		return x.Min
 	}
 	return 0
 }
 
-func (x *GetLeaguesResponse) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
-func (x *GetLeaguesResponse) GetResult() []*League {
+func (x *DraftYearOffsets) GetMax() int32 {
 	if x != nil {
-		return x.Result
+		return x.Max
 	}
-	return nil
+	return 0
 }
 
-// get single league
-type GetLeagueRequest struct {
+// the prospects franchises may draft, unset rules allow every prospect. maxAge is the age on
+// September 15 of the draft year, prospects with unknown birthdate are not limited by it
+type EligibilityRules struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LeagueId string `protobuf:"bytes,1,opt,name=leagueId,proto3" json:"leagueId,omitempty"`
+	DraftYears *DraftYearOffsets `protobuf:"bytes,1,opt,name=draftYears,proto3" json:"draftYears,omitempty"`
+	// prospects without nhl draft year stay eligible despite the draft years
+	AllowUndrafted bool       `protobuf:"varint,2,opt,name=allowUndrafted,proto3" json:"allowUndrafted,omitempty"`
+	MaxAge         int32      `protobuf:"varint,3,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
+	Positions      []Position `protobuf:"varint,4,rep,packed,name=positions,proto3,enum=fantasy.Position" json:"positions,omitempty"`
 }
 
-func (x *GetLeagueRequest) Reset() {
-	*x = GetLeagueRequest{}
+func (x *EligibilityRules) Reset() {
+	*x = EligibilityRules{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_pb_fantasy_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1069,13 +1630,13 @@ func (x *GetLeagueRequest) Reset() {
 	}
 }
 
-func (x *GetLeagueRequest) String() string {
+func (x *EligibilityRules) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeagueRequest) ProtoMessage() {}
+func (*EligibilityRules) ProtoMessage() {}
 
-func (x *GetLeagueRequest) ProtoReflect() protoreflect.Message {
+func (x *EligibilityRules) ProtoReflect() protoreflect.Message {
 	mi := &file_service_pb_fantasy_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1087,30 +1648,52 @@ func (x *GetLeagueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeagueRequest.ProtoReflect.Descriptor instead.
-func (*GetLeagueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EligibilityRules.ProtoReflect.Descriptor instead.
+func (*EligibilityRules) Descriptor() ([]byte, []int) {
 	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetLeagueRequest) GetLeagueId() string {
+func (x *EligibilityRules) GetDraftYears() *DraftYearOffsets {
 	if x != nil {
-		return x.LeagueId
+		return x.DraftYears
 	}
-	return ""
+	return nil
 }
 
-type GetLeagueResponse struct {
+func (x *EligibilityRules) GetAllowUndrafted() bool {
+	if x != nil {
+		return x.AllowUndrafted
+	}
+	return false
+}
+
+func (x *EligibilityRules) GetMaxAge() int32 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+func (x *EligibilityRules) GetPositions() []Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type DraftPick struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int64   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error  string  `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	Result *League `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	DraftYear        string `protobuf:"bytes,2,opt,name=DraftYear,proto3" json:"DraftYear,omitempty"`
+	DraftRound       string `protobuf:"bytes,3,opt,name=DraftRound,proto3" json:"DraftRound,omitempty"`
+	DraftPickOverall string `protobuf:"bytes,4,opt,name=DraftPickOverall,proto3" json:"DraftPickOverall,omitempty"`
+	DraftPickInRound string `protobuf:"bytes,5,opt,name=DraftPickInRound,proto3" json:"DraftPickInRound,omitempty"`
 }
 
-func (x *GetLeagueResponse) Reset() {
-	*x = GetLeagueResponse{}
+func (x *DraftPick) Reset() {
+	*x = DraftPick{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_pb_fantasy_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1118,13 +1701,13 @@ func (x *GetLeagueResponse) Reset() {
 	}
 }
 
-func (x *GetLeagueResponse) String() string {
+func (x *DraftPick) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeagueResponse) ProtoMessage() {}
+func (*DraftPick) ProtoMessage() {}
 
-func (x *GetLeagueResponse) ProtoReflect() protoreflect.Message {
+func (x *DraftPick) ProtoReflect() protoreflect.Message {
 	mi := &file_service_pb_fantasy_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1136,44 +1719,51 @@ func (x *GetLeagueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeagueResponse.ProtoReflect.Descriptor instead.
-func (*GetLeagueResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DraftPick.ProtoReflect.Descriptor instead.
+func (*DraftPick) Descriptor() ([]byte, []int) {
 	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetLeagueResponse) GetStatus() int64 {
+func (x *DraftPick) GetDraftYear() string {
 	if x != nil {
-		return x.Status
+		return x.DraftYear
 	}
-	return 0
+	return ""
 }
 
-func (x *GetLeagueResponse) GetError() string {
+func (x *DraftPick) GetDraftRound() string {
 	if x != nil {
-		return x.Error
+		return x.DraftRound
 	}
 	return ""
 }
 
-func (x *GetLeagueResponse) GetResult() *League {
+func (x *DraftPick) GetDraftPickOverall() string {
 	if x != nil {
-		return x.Result
+		return x.DraftPickOverall
 	}
-	return nil
+	return ""
 }
 
-type GetLeagueFranchisesResponse struct {
+func (x *DraftPick) GetDraftPickInRound() string {
+	if x != nil {
+		return x.DraftPickInRound
+	}
+	return ""
+}
+
+type DefaultResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int64        `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error  string       `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	Result []*Franchise `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
+	Status  int64  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetLeagueFranchisesResponse) Reset() {
-	*x = GetLeagueFranchisesResponse{}
+func (x *DefaultResponse) Reset() {
+	*x = DefaultResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_pb_fantasy_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1181,13 +1771,13 @@ func (x *GetLeagueFranchisesResponse) Reset() {
 	}
 }
 
-func (x *GetLeagueFranchisesResponse) String() string {
+func (x *DefaultResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeagueFranchisesResponse) ProtoMessage() {}
+func (*DefaultResponse) ProtoMessage() {}
 
-func (x *GetLeagueFranchisesResponse) ProtoReflect() protoreflect.Message {
+func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_service_pb_fantasy_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1199,43 +1789,48 @@ func (x *GetLeagueFranchisesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeagueFranchisesResponse.ProtoReflect.Descriptor instead.
-func (*GetLeagueFranchisesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DefaultResponse.ProtoReflect.Descriptor instead.
+func (*DefaultResponse) Descriptor() ([]byte, []int) {
 	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetLeagueFranchisesResponse) GetStatus() int64 {
+func (x *DefaultResponse) GetStatus() int64 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *GetLeagueFranchisesResponse) GetError() string {
+func (x *DefaultResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-func (x *GetLeagueFranchisesResponse) GetResult() []*Franchise {
+func (x *DefaultResponse) GetMessage() string {
 	if x != nil {
-		return x.Result
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-// get all leagues an associated franchises
-type GetLeagueFranchisePairsRequest struct {
+type ProspectFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	PositionCode string     `protobuf:"bytes,1,opt,name=positionCode,proto3" json:"positionCode,omitempty"`
+	NhlTeam      string     `protobuf:"bytes,2,opt,name=nhlTeam,proto3" json:"nhlTeam,omitempty"`
+	NhlDraftYear string     `protobuf:"bytes,3,opt,name=nhlDraftYear,proto3" json:"nhlDraftYear,omitempty"`
+	DraftYear    string     `protobuf:"bytes,4,opt,name=draftYear,proto3" json:"draftYear,omitempty"`
+	Drafted      BoolFilter `protobuf:"varint,5,opt,name=drafted,proto3,enum=fantasy.BoolFilter" json:"drafted,omitempty"`
+	Protected    BoolFilter `protobuf:"varint,6,opt,name=protected,proto3,enum=fantasy.BoolFilter" json:"protected,omitempty"`
+	Position     Position   `protobuf:"varint,7,opt,name=position,proto3,enum=fantasy.Position" json:"position,omitempty"`
 }
 
-func (x *GetLeagueFranchisePairsRequest) Reset() {
-	*x = GetLeagueFranchisePairsRequest{}
+func (x *ProspectFilter) Reset() {
+	*x = ProspectFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_pb_fantasy_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1243,13 +1838,13 @@ func (x *GetLeagueFranchisePairsRequest) Reset() {
 	}
 }
 
-func (x *GetLeagueFranchisePairsRequest) String() string {
+func (x *ProspectFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeagueFranchisePairsRequest) ProtoMessage() {}
+func (*ProspectFilter) ProtoMessage() {}
 
-func (x *GetLeagueFranchisePairsRequest) ProtoReflect() protoreflect.Message {
+func (x *ProspectFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_service_pb_fantasy_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1261,100 +1856,105 @@ func (x *GetLeagueFranchisePairsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeagueFranchisePairsRequest.ProtoReflect.Descriptor instead.
-func (*GetLeagueFranchisePairsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProspectFilter.ProtoReflect.Descriptor instead.
+func (*ProspectFilter) Descriptor() ([]byte, []int) {
 	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetLeagueFranchisePairsRequest) GetUserId() string {
+func (x *ProspectFilter) GetPositionCode() string {
 	if x != nil {
-		return x.UserId
+		return x.PositionCode
 	}
 	return ""
 }
 
-type LeagueFranchisePair struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	LeagueID    string `protobuf:"bytes,1,opt,name=LeagueID,proto3" json:"LeagueID,omitempty"`
-	FranchiseID string `protobuf:"bytes,2,opt,name=FranchiseID,proto3" json:"FranchiseID,omitempty"`
-}
-
-func (x *LeagueFranchisePair) Reset() {
-	*x = LeagueFranchisePair{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ProspectFilter) GetNhlTeam() string {
+	if x != nil {
+		return x.NhlTeam
 	}
+	return ""
 }
 
-func (x *LeagueFranchisePair) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ProspectFilter) GetNhlDraftYear() string {
+	if x != nil {
+		return x.NhlDraftYear
+	}
+	return ""
 }
 
-func (*LeagueFranchisePair) ProtoMessage() {}
-
-func (x *LeagueFranchisePair) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ProspectFilter) GetDraftYear() string {
+	if x != nil {
+		return x.DraftYear
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use LeagueFranchisePair.ProtoReflect.Descriptor instead.
-func (*LeagueFranchisePair) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{15}
+func (x *ProspectFilter) GetDrafted() BoolFilter {
+	if x != nil {
+		return x.Drafted
+	}
+	return BoolFilter_BOOL_FILTER_ANY
 }
 
-func (x *LeagueFranchisePair) GetLeagueID() string {
+func (x *ProspectFilter) GetProtected() BoolFilter {
 	if x != nil {
-		return x.LeagueID
+		return x.Protected
 	}
-	return ""
+	return BoolFilter_BOOL_FILTER_ANY
 }
 
-func (x *LeagueFranchisePair) GetFranchiseID() string {
+func (x *ProspectFilter) GetPosition() Position {
 	if x != nil {
-		return x.FranchiseID
+		return x.Position
 	}
-	return ""
+	return Position_POSITION_UNSPECIFIED
 }
 
-type GetLeagueFranchisePairsResponse struct {
+// create
+type LeagueRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int64                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error  string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	Result []*LeagueFranchisePair `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
+	Admin             string `protobuf:"bytes,1,opt,name=Admin,proto3" json:"Admin,omitempty"`
+	AdminID           string `protobuf:"bytes,2,opt,name=AdminID,proto3" json:"AdminID,omitempty"`
+	Commissioner      string `protobuf:"bytes,3,opt,name=Commissioner,proto3" json:"Commissioner,omitempty"`
+	CommissionerID    string `protobuf:"bytes,4,opt,name=CommissionerID,proto3" json:"CommissionerID,omitempty"`
+	Name              string `protobuf:"bytes,5,opt,name=Name,proto3" json:"Name,omitempty"`
+	FoundationYear    string `protobuf:"bytes,6,opt,name=FoundationYear,proto3" json:"FoundationYear,omitempty"`
+	MaxFranchises     int32  `protobuf:"varint,7,opt,name=MaxFranchises,proto3" json:"MaxFranchises,omitempty"`
+	MaxProspects      int32  `protobuf:"varint,8,opt,name=MaxProspects,proto3" json:"MaxProspects,omitempty"`
+	DraftRightsGoalie int32  `protobuf:"varint,9,opt,name=DraftRightsGoalie,proto3" json:"DraftRightsGoalie,omitempty"`
+	DraftRightsSkater int32  `protobuf:"varint,10,opt,name=DraftRightsSkater,proto3" json:"DraftRightsSkater,omitempty"`
+	DraftRounds       int32  `protobuf:"varint,11,opt,name=DraftRounds,proto3" json:"DraftRounds,omitempty"`
+	Founded           int32  `protobuf:"varint,12,opt,name=founded,proto3" json:"founded,omitempty"`
+	Season            int32  `protobuf:"varint,13,opt,name=season,proto3" json:"season,omitempty"`
+	// picks are generated this many drafts after the season's, 3 if unset
+	FuturePickYears int32 `protobuf:"varint,14,opt,name=futurePickYears,proto3" json:"futurePickYears,omitempty"`
+	// new leagues release retired prospects and prospects whose rights expired if unset,
+	// on updates the given rules replace the ones of their statuses
+	StatusRules []*StatusRule `protobuf:"bytes,15,rep,name=statusRules,proto3" json:"statusRules,omitempty"`
+	// new leagues allow every prospect if unset, on updates set rules replace the league's
+	Eligibility *EligibilityRules `protobuf:"bytes,16,opt,name=eligibility,proto3" json:"eligibility,omitempty"`
 }
 
-func (x *GetLeagueFranchisePairsResponse) Reset() {
-	*x = GetLeagueFranchisePairsResponse{}
+func (x *LeagueRequest) Reset() {
+	*x = LeagueRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[16]
+		mi := &file_service_pb_fantasy_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLeagueFranchisePairsResponse) String() string {
+func (x *LeagueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeagueFranchisePairsResponse) ProtoMessage() {}
+func (*LeagueRequest) ProtoMessage() {}
 
-func (x *GetLeagueFranchisePairsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[16]
+func (x *LeagueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1365,139 +1965,151 @@ func (x *GetLeagueFranchisePairsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeagueFranchisePairsResponse.ProtoReflect.Descriptor instead.
-func (*GetLeagueFranchisePairsResponse) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use LeagueRequest.ProtoReflect.Descriptor instead.
+func (*LeagueRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetLeagueFranchisePairsResponse) GetStatus() int64 {
+func (x *LeagueRequest) GetAdmin() string {
 	if x != nil {
-		return x.Status
+		return x.Admin
 	}
-	return 0
+	return ""
 }
 
-func (x *GetLeagueFranchisePairsResponse) GetError() string {
+func (x *LeagueRequest) GetAdminID() string {
 	if x != nil {
-		return x.Error
+		return x.AdminID
 	}
 	return ""
 }
 
-func (x *GetLeagueFranchisePairsResponse) GetResult() []*LeagueFranchisePair {
+func (x *LeagueRequest) GetCommissioner() string {
 	if x != nil {
-		return x.Result
+		return x.Commissioner
 	}
-	return nil
+	return ""
 }
 
-// create franchise
-type FranchiseRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *LeagueRequest) GetCommissionerID() string {
+	if x != nil {
+		return x.CommissionerID
+	}
+	return ""
+}
 
-	Name           string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	OwnerID        string `protobuf:"bytes,2,opt,name=OwnerID,proto3" json:"OwnerID,omitempty"`
-	OwnerName      string `protobuf:"bytes,3,opt,name=OwnerName,proto3" json:"OwnerName,omitempty"`
-	FoundationYear string `protobuf:"bytes,4,opt,name=FoundationYear,proto3" json:"FoundationYear,omitempty"`
-	LeagueId       string `protobuf:"bytes,5,opt,name=LeagueId,proto3" json:"LeagueId,omitempty"`
+func (x *LeagueRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *FranchiseRequest) Reset() {
-	*x = FranchiseRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *LeagueRequest) GetFoundationYear() string {
+	if x != nil {
+		return x.FoundationYear
 	}
+	return ""
 }
 
-func (x *FranchiseRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LeagueRequest) GetMaxFranchises() int32 {
+	if x != nil {
+		return x.MaxFranchises
+	}
+	return 0
 }
 
-func (*FranchiseRequest) ProtoMessage() {}
+func (x *LeagueRequest) GetMaxProspects() int32 {
+	if x != nil {
+		return x.MaxProspects
+	}
+	return 0
+}
 
-func (x *FranchiseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *LeagueRequest) GetDraftRightsGoalie() int32 {
+	if x != nil {
+		return x.DraftRightsGoalie
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use FranchiseRequest.ProtoReflect.Descriptor instead.
-func (*FranchiseRequest) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{17}
+func (x *LeagueRequest) GetDraftRightsSkater() int32 {
+	if x != nil {
+		return x.DraftRightsSkater
+	}
+	return 0
 }
 
-func (x *FranchiseRequest) GetName() string {
+func (x *LeagueRequest) GetDraftRounds() int32 {
 	if x != nil {
-		return x.Name
+		return x.DraftRounds
 	}
-	return ""
+	return 0
 }
 
-func (x *FranchiseRequest) GetOwnerID() string {
+func (x *LeagueRequest) GetFounded() int32 {
 	if x != nil {
-		return x.OwnerID
+		return x.Founded
 	}
-	return ""
+	return 0
 }
 
-func (x *FranchiseRequest) GetOwnerName() string {
+func (x *LeagueRequest) GetSeason() int32 {
 	if x != nil {
-		return x.OwnerName
+		return x.Season
 	}
-	return ""
+	return 0
 }
 
-func (x *FranchiseRequest) GetFoundationYear() string {
+func (x *LeagueRequest) GetFuturePickYears() int32 {
 	if x != nil {
-		return x.FoundationYear
+		return x.FuturePickYears
 	}
-	return ""
+	return 0
 }
 
-func (x *FranchiseRequest) GetLeagueId() string {
+func (x *LeagueRequest) GetStatusRules() []*StatusRule {
 	if x != nil {
-		return x.LeagueId
+		return x.StatusRules
 	}
-	return ""
+	return nil
 }
 
-type FranchiseResponse struct {
+func (x *LeagueRequest) GetEligibility() *EligibilityRules {
+	if x != nil {
+		return x.Eligibility
+	}
+	return nil
+}
+
+// update, userId is the acting commissioner or admin
+type LeagueUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status      int64  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error       string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	FranchiseId string `protobuf:"bytes,3,opt,name=FranchiseId,proto3" json:"FranchiseId,omitempty"`
+	Id     string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	League *LeagueRequest `protobuf:"bytes,2,opt,name=league,proto3" json:"league,omitempty"`
+	UserId string         `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
 }
 
-func (x *FranchiseResponse) Reset() {
-	*x = FranchiseResponse{}
+func (x *LeagueUpdateRequest) Reset() {
+	*x = LeagueUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[18]
+		mi := &file_service_pb_fantasy_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FranchiseResponse) String() string {
+func (x *LeagueUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FranchiseResponse) ProtoMessage() {}
+func (*LeagueUpdateRequest) ProtoMessage() {}
 
-func (x *FranchiseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[18]
+func (x *LeagueUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1508,59 +2120,59 @@ func (x *FranchiseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FranchiseResponse.ProtoReflect.Descriptor instead.
-func (*FranchiseResponse) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use LeagueUpdateRequest.ProtoReflect.Descriptor instead.
+func (*LeagueUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *FranchiseResponse) GetStatus() int64 {
+func (x *LeagueUpdateRequest) GetId() string {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *FranchiseResponse) GetError() string {
+func (x *LeagueUpdateRequest) GetLeague() *LeagueRequest {
 	if x != nil {
-		return x.Error
+		return x.League
 	}
-	return ""
+	return nil
 }
 
-func (x *FranchiseResponse) GetFranchiseId() string {
+func (x *LeagueUpdateRequest) GetUserId() string {
 	if x != nil {
-		return x.FranchiseId
+		return x.UserId
 	}
 	return ""
 }
 
-// get single league
-type GetFranchiseRequest struct {
+// archive or delete a league, userId is the acting commissioner or admin
+type LeagueActionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LeagueID    string `protobuf:"bytes,1,opt,name=LeagueID,proto3" json:"LeagueID,omitempty"`
-	FranchiseID string `protobuf:"bytes,2,opt,name=FranchiseID,proto3" json:"FranchiseID,omitempty"`
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
 }
 
-func (x *GetFranchiseRequest) Reset() {
-	*x = GetFranchiseRequest{}
+func (x *LeagueActionRequest) Reset() {
+	*x = LeagueActionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[19]
+		mi := &file_service_pb_fantasy_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetFranchiseRequest) String() string {
+func (x *LeagueActionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFranchiseRequest) ProtoMessage() {}
+func (*LeagueActionRequest) ProtoMessage() {}
 
-func (x *GetFranchiseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[19]
+func (x *LeagueActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1571,52 +2183,55 @@ func (x *GetFranchiseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFranchiseRequest.ProtoReflect.Descriptor instead.
-func (*GetFranchiseRequest) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use LeagueActionRequest.ProtoReflect.Descriptor instead.
+func (*LeagueActionRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetFranchiseRequest) GetLeagueID() string {
+func (x *LeagueActionRequest) GetId() string {
 	if x != nil {
-		return x.LeagueID
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetFranchiseRequest) GetFranchiseID() string {
+func (x *LeagueActionRequest) GetUserId() string {
 	if x != nil {
-		return x.FranchiseID
+		return x.UserId
 	}
 	return ""
 }
 
-type GetFranchiseResponse struct {
+// the clone continues the league in the season, the league itself is archived.
+// Settings, franchises, members, rosters and the picks of the season's and later
+// drafts carry over, invitations, history and protections don't.
+type CloneLeagueRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int64      `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error  string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	Result *Franchise `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Season int32  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	UserId string `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
 }
 
-func (x *GetFranchiseResponse) Reset() {
-	*x = GetFranchiseResponse{}
+func (x *CloneLeagueRequest) Reset() {
+	*x = CloneLeagueRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[20]
+		mi := &file_service_pb_fantasy_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetFranchiseResponse) String() string {
+func (x *CloneLeagueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFranchiseResponse) ProtoMessage() {}
+func (*CloneLeagueRequest) ProtoMessage() {}
 
-func (x *GetFranchiseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[20]
+func (x *CloneLeagueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1627,69 +2242,64 @@ func (x *GetFranchiseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFranchiseResponse.ProtoReflect.Descriptor instead.
-func (*GetFranchiseResponse) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use CloneLeagueRequest.ProtoReflect.Descriptor instead.
+func (*CloneLeagueRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetFranchiseResponse) GetStatus() int64 {
+func (x *CloneLeagueRequest) GetId() string {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *GetFranchiseResponse) GetError() string {
+func (x *CloneLeagueRequest) GetSeason() int32 {
 	if x != nil {
-		return x.Error
+		return x.Season
 	}
-	return ""
+	return 0
 }
 
-func (x *GetFranchiseResponse) GetResult() *Franchise {
+func (x *CloneLeagueRequest) GetUserId() string {
 	if x != nil {
-		return x.Result
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// Prospects
-type CreateProspect struct {
+// a season ends with the draft of its year
+type Season struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FullName            string `protobuf:"bytes,1,opt,name=fullName,proto3" json:"fullName,omitempty"`
-	FirstName           string `protobuf:"bytes,2,opt,name=firstName,proto3" json:"firstName,omitempty"`
-	LastName            string `protobuf:"bytes,3,opt,name=lastName,proto3" json:"lastName,omitempty"`
-	NhlTeam             string `protobuf:"bytes,4,opt,name=nhlTeam,proto3" json:"nhlTeam,omitempty"`
-	Birthdate           string `protobuf:"bytes,5,opt,name=birthdate,proto3" json:"birthdate,omitempty"`
-	Height              string `protobuf:"bytes,6,opt,name=height,proto3" json:"height,omitempty"`
-	Weight              string `protobuf:"bytes,7,opt,name=weight,proto3" json:"weight,omitempty"`
-	DraftYear           string `protobuf:"bytes,8,opt,name=draftYear,proto3" json:"draftYear,omitempty"`
-	NhlDraftRound       string `protobuf:"bytes,9,opt,name=nhlDraftRound,proto3" json:"nhlDraftRound,omitempty"`
-	NhlDraftPickOverall string `protobuf:"bytes,10,opt,name=nhlDraftPickOverall,proto3" json:"nhlDraftPickOverall,omitempty"`
-	NhlDraftPickInRound string `protobuf:"bytes,11,opt,name=nhlDraftPickInRound,proto3" json:"nhlDraftPickInRound,omitempty"`
-	PositionCode        string `protobuf:"bytes,12,opt,name=positionCode,proto3" json:"positionCode,omitempty"`
+	ID        string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	LeagueID  string                 `protobuf:"bytes,2,opt,name=leagueID,proto3" json:"leagueID,omitempty"`
+	Year      int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Status    string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ClosedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=closedAt,proto3" json:"closedAt,omitempty"`
 }
 
-func (x *CreateProspect) Reset() {
-	*x = CreateProspect{}
+func (x *Season) Reset() {
+	*x = Season{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[21]
+		mi := &file_service_pb_fantasy_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateProspect) String() string {
+func (x *Season) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProspect) ProtoMessage() {}
+func (*Season) ProtoMessage() {}
 
-func (x *CreateProspect) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[21]
+func (x *Season) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1700,120 +2310,159 @@ func (x *CreateProspect) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProspect.ProtoReflect.Descriptor instead.
-func (*CreateProspect) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Season.ProtoReflect.Descriptor instead.
+func (*Season) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CreateProspect) GetFullName() string {
+func (x *Season) GetID() string {
 	if x != nil {
-		return x.FullName
+		return x.ID
 	}
 	return ""
 }
 
-func (x *CreateProspect) GetFirstName() string {
+func (x *Season) GetLeagueID() string {
 	if x != nil {
-		return x.FirstName
+		return x.LeagueID
 	}
 	return ""
 }
 
-func (x *CreateProspect) GetLastName() string {
+func (x *Season) GetYear() int32 {
 	if x != nil {
-		return x.LastName
+		return x.Year
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateProspect) GetNhlTeam() string {
+func (x *Season) GetStartDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NhlTeam
+		return x.StartDate
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateProspect) GetBirthdate() string {
+func (x *Season) GetEndDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Birthdate
+		return x.EndDate
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateProspect) GetHeight() string {
+func (x *Season) GetStatus() string {
 	if x != nil {
-		return x.Height
+		return x.Status
 	}
 	return ""
 }
 
-func (x *CreateProspect) GetWeight() string {
+func (x *Season) GetClosedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Weight
+		return x.ClosedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateProspect) GetDraftYear() string {
-	if x != nil {
-		return x.DraftYear
+// closes the active season and starts the next one, unset dates run from july to june
+type RolloverSeasonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeagueID  string                 `protobuf:"bytes,1,opt,name=leagueID,proto3" json:"leagueID,omitempty"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	UserId    string                 `protobuf:"bytes,4,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *RolloverSeasonRequest) Reset() {
+	*x = RolloverSeasonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *CreateProspect) GetNhlDraftRound() string {
+func (x *RolloverSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloverSeasonRequest) ProtoMessage() {}
+
+func (x *RolloverSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloverSeasonRequest.ProtoReflect.Descriptor instead.
+func (*RolloverSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RolloverSeasonRequest) GetLeagueID() string {
 	if x != nil {
-		return x.NhlDraftRound
+		return x.LeagueID
 	}
 	return ""
 }
 
-func (x *CreateProspect) GetNhlDraftPickOverall() string {
+func (x *RolloverSeasonRequest) GetStartDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NhlDraftPickOverall
+		return x.StartDate
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateProspect) GetNhlDraftPickInRound() string {
+func (x *RolloverSeasonRequest) GetEndDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NhlDraftPickInRound
+		return x.EndDate
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateProspect) GetPositionCode() string {
+func (x *RolloverSeasonRequest) GetUserId() string {
 	if x != nil {
-		return x.PositionCode
+		return x.UserId
 	}
 	return ""
 }
 
-type CreateProspectRequest struct {
+type SeasonResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Prospect *Prospect `protobuf:"bytes,1,opt,name=prospect,proto3" json:"prospect,omitempty"`
+	Status int64   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string  `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result *Season `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *CreateProspectRequest) Reset() {
-	*x = CreateProspectRequest{}
+func (x *SeasonResponse) Reset() {
+	*x = SeasonResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[22]
+		mi := &file_service_pb_fantasy_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateProspectRequest) String() string {
+func (x *SeasonResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProspectRequest) ProtoMessage() {}
+func (*SeasonResponse) ProtoMessage() {}
 
-func (x *CreateProspectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[22]
+func (x *SeasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1824,45 +2473,59 @@ func (x *CreateProspectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProspectRequest.ProtoReflect.Descriptor instead.
-func (*CreateProspectRequest) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use SeasonResponse.ProtoReflect.Descriptor instead.
+func (*SeasonResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CreateProspectRequest) GetProspect() *Prospect {
+func (x *SeasonResponse) GetStatus() int64 {
 	if x != nil {
-		return x.Prospect
+		return x.Status
 	}
-	return nil
+	return 0
 }
 
-type CreateProspectResponse struct {
-	state         protoimpl.MessageState
+func (x *SeasonResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SeasonResponse) GetResult() *Season {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type SeasonsResponse struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status     int64  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error      string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	ProspectID string `protobuf:"bytes,3,opt,name=prospectID,proto3" json:"prospectID,omitempty"`
+	Status int64     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string    `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result []*Season `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *CreateProspectResponse) Reset() {
-	*x = CreateProspectResponse{}
+func (x *SeasonsResponse) Reset() {
+	*x = SeasonsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[23]
+		mi := &file_service_pb_fantasy_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateProspectResponse) String() string {
+func (x *SeasonsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProspectResponse) ProtoMessage() {}
+func (*SeasonsResponse) ProtoMessage() {}
 
-func (x *CreateProspectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[23]
+func (x *SeasonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1873,57 +2536,59 @@ func (x *CreateProspectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProspectResponse.ProtoReflect.Descriptor instead.
-func (*CreateProspectResponse) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use SeasonsResponse.ProtoReflect.Descriptor instead.
+func (*SeasonsResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CreateProspectResponse) GetStatus() int64 {
+func (x *SeasonsResponse) GetStatus() int64 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *CreateProspectResponse) GetError() string {
+func (x *SeasonsResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-func (x *CreateProspectResponse) GetProspectID() string {
+func (x *SeasonsResponse) GetResult() []*Season {
 	if x != nil {
-		return x.ProspectID
+		return x.Result
 	}
-	return ""
+	return nil
 }
 
-type CreateProspectsBulkRequest struct {
+type LeagueResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Prospects []*CreateProspect `protobuf:"bytes,1,rep,name=prospects,proto3" json:"prospects,omitempty"`
+	Status   int64  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error    string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	LeagueId string `protobuf:"bytes,3,opt,name=leagueId,proto3" json:"leagueId,omitempty"`
 }
 
-func (x *CreateProspectsBulkRequest) Reset() {
-	*x = CreateProspectsBulkRequest{}
+func (x *LeagueResponse) Reset() {
+	*x = LeagueResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[24]
+		mi := &file_service_pb_fantasy_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateProspectsBulkRequest) String() string {
+func (x *LeagueResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProspectsBulkRequest) ProtoMessage() {}
+func (*LeagueResponse) ProtoMessage() {}
 
-func (x *CreateProspectsBulkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[24]
+func (x *LeagueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1934,45 +2599,60 @@ func (x *CreateProspectsBulkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProspectsBulkRequest.ProtoReflect.Descriptor instead.
-func (*CreateProspectsBulkRequest) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use LeagueResponse.ProtoReflect.Descriptor instead.
+func (*LeagueResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CreateProspectsBulkRequest) GetProspects() []*CreateProspect {
+func (x *LeagueResponse) GetStatus() int64 {
 	if x != nil {
-		return x.Prospects
+		return x.Status
 	}
-	return nil
+	return 0
 }
 
-type CreateProspectsBulkResponse struct {
+func (x *LeagueResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *LeagueResponse) GetLeagueId() string {
+	if x != nil {
+		return x.LeagueId
+	}
+	return ""
+}
+
+// get all leagues
+type GetLeaguesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status      int64    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error       string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	ProspectIds []string `protobuf:"bytes,3,rep,name=prospectIds,proto3" json:"prospectIds,omitempty"`
+	PageSize  int32  `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	OrderBy   string `protobuf:"bytes,3,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
 }
 
-func (x *CreateProspectsBulkResponse) Reset() {
-	*x = CreateProspectsBulkResponse{}
+func (x *GetLeaguesRequest) Reset() {
+	*x = GetLeaguesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[25]
+		mi := &file_service_pb_fantasy_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateProspectsBulkResponse) String() string {
+func (x *GetLeaguesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProspectsBulkResponse) ProtoMessage() {}
+func (*GetLeaguesRequest) ProtoMessage() {}
 
-func (x *CreateProspectsBulkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[25]
+func (x *GetLeaguesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1983,59 +2663,60 @@ func (x *CreateProspectsBulkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProspectsBulkResponse.ProtoReflect.Descriptor instead.
-func (*CreateProspectsBulkResponse) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetLeaguesRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaguesRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreateProspectsBulkResponse) GetStatus() int64 {
+func (x *GetLeaguesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Status
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *CreateProspectsBulkResponse) GetError() string {
+func (x *GetLeaguesRequest) GetPageToken() string {
 	if x != nil {
-		return x.Error
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *CreateProspectsBulkResponse) GetProspectIds() []string {
+func (x *GetLeaguesRequest) GetOrderBy() string {
 	if x != nil {
-		return x.ProspectIds
+		return x.OrderBy
 	}
-	return nil
+	return ""
 }
 
-type GetPicksResponse struct {
+type GetLeaguesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int64   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error  string  `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	Picks  []*Pick `protobuf:"bytes,3,rep,name=picks,proto3" json:"picks,omitempty"`
+	Status        int64     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string    `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result        []*League `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
+	NextPageToken string    `protobuf:"bytes,4,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 }
 
-func (x *GetPicksResponse) Reset() {
-	*x = GetPicksResponse{}
+func (x *GetLeaguesResponse) Reset() {
+	*x = GetLeaguesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[26]
+		mi := &file_service_pb_fantasy_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPicksResponse) String() string {
+func (x *GetLeaguesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPicksResponse) ProtoMessage() {}
+func (*GetLeaguesResponse) ProtoMessage() {}
 
-func (x *GetPicksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[26]
+func (x *GetLeaguesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2046,60 +2727,68 @@ func (x *GetPicksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPicksResponse.ProtoReflect.Descriptor instead.
-func (*GetPicksResponse) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetLeaguesResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaguesResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetPicksResponse) GetStatus() int64 {
+func (x *GetLeaguesResponse) GetStatus() int64 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *GetPicksResponse) GetError() string {
+func (x *GetLeaguesResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-func (x *GetPicksResponse) GetPicks() []*Pick {
+func (x *GetLeaguesResponse) GetResult() []*League {
 	if x != nil {
-		return x.Picks
+		return x.Result
 	}
 	return nil
 }
 
-type CreateOrUpdatePick struct {
+func (x *GetLeaguesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// get single league
+type GetLeagueRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Franchise       string `protobuf:"bytes,1,opt,name=Franchise,proto3" json:"Franchise,omitempty"`
-	FranchiseID     string `protobuf:"bytes,2,opt,name=FranchiseID,proto3" json:"FranchiseID,omitempty"`
-	Year            string `protobuf:"bytes,3,opt,name=Year,proto3" json:"Year,omitempty"`
-	LotteryPosition int32  `protobuf:"varint,4,opt,name=LotteryPosition,proto3" json:"LotteryPosition,omitempty"`
+	LeagueId  string `protobuf:"bytes,1,opt,name=leagueId,proto3" json:"leagueId,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	OrderBy   string `protobuf:"bytes,4,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
 }
 
-func (x *CreateOrUpdatePick) Reset() {
-	*x = CreateOrUpdatePick{}
+func (x *GetLeagueRequest) Reset() {
+	*x = GetLeagueRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[27]
+		mi := &file_service_pb_fantasy_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateOrUpdatePick) String() string {
+func (x *GetLeagueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrUpdatePick) ProtoMessage() {}
+func (*GetLeagueRequest) ProtoMessage() {}
 
-func (x *CreateOrUpdatePick) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[27]
+func (x *GetLeagueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2110,65 +2799,66 @@ func (x *CreateOrUpdatePick) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrUpdatePick.ProtoReflect.Descriptor instead.
-func (*CreateOrUpdatePick) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetLeagueRequest.ProtoReflect.Descriptor instead.
+func (*GetLeagueRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CreateOrUpdatePick) GetFranchise() string {
+func (x *GetLeagueRequest) GetLeagueId() string {
 	if x != nil {
-		return x.Franchise
+		return x.LeagueId
 	}
 	return ""
 }
 
-func (x *CreateOrUpdatePick) GetFranchiseID() string {
+func (x *GetLeagueRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.FranchiseID
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateOrUpdatePick) GetYear() string {
+func (x *GetLeagueRequest) GetPageToken() string {
 	if x != nil {
-		return x.Year
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *CreateOrUpdatePick) GetLotteryPosition() int32 {
+func (x *GetLeagueRequest) GetOrderBy() string {
 	if x != nil {
-		return x.LotteryPosition
+		return x.OrderBy
 	}
-	return 0
+	return ""
 }
 
-type CreateOrUpdatePicksRequest struct {
+type GetLeagueResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LeagueID string                `protobuf:"bytes,1,opt,name=LeagueID,proto3" json:"LeagueID,omitempty"`
-	Picks    []*CreateOrUpdatePick `protobuf:"bytes,2,rep,name=picks,proto3" json:"picks,omitempty"`
+	Status int64   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string  `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result *League `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *CreateOrUpdatePicksRequest) Reset() {
-	*x = CreateOrUpdatePicksRequest{}
+func (x *GetLeagueResponse) Reset() {
+	*x = GetLeagueResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[28]
+		mi := &file_service_pb_fantasy_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateOrUpdatePicksRequest) String() string {
+func (x *GetLeagueResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrUpdatePicksRequest) ProtoMessage() {}
+func (*GetLeagueResponse) ProtoMessage() {}
 
-func (x *CreateOrUpdatePicksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[28]
+func (x *GetLeagueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2179,52 +2869,60 @@ func (x *CreateOrUpdatePicksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrUpdatePicksRequest.ProtoReflect.Descriptor instead.
-func (*CreateOrUpdatePicksRequest) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetLeagueResponse.ProtoReflect.Descriptor instead.
+func (*GetLeagueResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *CreateOrUpdatePicksRequest) GetLeagueID() string {
+func (x *GetLeagueResponse) GetStatus() int64 {
 	if x != nil {
-		return x.LeagueID
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetLeagueResponse) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-func (x *CreateOrUpdatePicksRequest) GetPicks() []*CreateOrUpdatePick {
+func (x *GetLeagueResponse) GetResult() *League {
 	if x != nil {
-		return x.Picks
+		return x.Result
 	}
 	return nil
 }
 
-type GetPicksRequest struct {
+type GetLeagueFranchisesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LeagueID    string `protobuf:"bytes,1,opt,name=LeagueID,proto3" json:"LeagueID,omitempty"`
-	FranchiseID string `protobuf:"bytes,2,opt,name=FranchiseID,proto3" json:"FranchiseID,omitempty"`
-	Year        string `protobuf:"bytes,3,opt,name=Year,proto3" json:"Year,omitempty"`
+	Status        int64        `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string       `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result        []*Franchise `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
+	NextPageToken string       `protobuf:"bytes,4,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 }
 
-func (x *GetPicksRequest) Reset() {
-	*x = GetPicksRequest{}
+func (x *GetLeagueFranchisesResponse) Reset() {
+	*x = GetLeagueFranchisesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[29]
+		mi := &file_service_pb_fantasy_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPicksRequest) String() string {
+func (x *GetLeagueFranchisesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPicksRequest) ProtoMessage() {}
+func (*GetLeagueFranchisesResponse) ProtoMessage() {}
 
-func (x *GetPicksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[29]
+func (x *GetLeagueFranchisesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2235,60 +2933,67 @@ func (x *GetPicksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPicksRequest.ProtoReflect.Descriptor instead.
-func (*GetPicksRequest) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetLeagueFranchisesResponse.ProtoReflect.Descriptor instead.
+func (*GetLeagueFranchisesResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetPicksRequest) GetLeagueID() string {
+func (x *GetLeagueFranchisesResponse) GetStatus() int64 {
 	if x != nil {
-		return x.LeagueID
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *GetPicksRequest) GetFranchiseID() string {
+func (x *GetLeagueFranchisesResponse) GetError() string {
 	if x != nil {
-		return x.FranchiseID
+		return x.Error
 	}
 	return ""
 }
 
-func (x *GetPicksRequest) GetYear() string {
+func (x *GetLeagueFranchisesResponse) GetResult() []*Franchise {
 	if x != nil {
-		return x.Year
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetLeagueFranchisesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type DraftRequest struct {
+// get all leagues an associated franchises
+// every league with each franchise the user is a member of, FranchiseID is empty
+// for leagues the user has no franchise in
+type GetLeagueFranchisePairsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LeagueID    string `protobuf:"bytes,1,opt,name=LeagueID,proto3" json:"LeagueID,omitempty"`
-	FranchiseID string `protobuf:"bytes,2,opt,name=FranchiseID,proto3" json:"FranchiseID,omitempty"`
-	ProspectID  string `protobuf:"bytes,3,opt,name=ProspectID,proto3" json:"ProspectID,omitempty"`
-	PickID      string `protobuf:"bytes,4,opt,name=PickID,proto3" json:"PickID,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
 }
 
-func (x *DraftRequest) Reset() {
-	*x = DraftRequest{}
+func (x *GetLeagueFranchisePairsRequest) Reset() {
+	*x = GetLeagueFranchisePairsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[30]
+		mi := &file_service_pb_fantasy_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DraftRequest) String() string {
+func (x *GetLeagueFranchisePairsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DraftRequest) ProtoMessage() {}
+func (*GetLeagueFranchisePairsRequest) ProtoMessage() {}
 
-func (x *DraftRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[30]
+func (x *GetLeagueFranchisePairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2299,51 +3004,85 @@ func (x *DraftRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DraftRequest.ProtoReflect.Descriptor instead.
-func (*DraftRequest) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetLeagueFranchisePairsRequest.ProtoReflect.Descriptor instead.
+func (*GetLeagueFranchisePairsRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *DraftRequest) GetLeagueID() string {
+func (x *GetLeagueFranchisePairsRequest) GetUserId() string {
 	if x != nil {
-		return x.LeagueID
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *DraftRequest) GetFranchiseID() string {
-	if x != nil {
-		return x.FranchiseID
+type LeagueFranchisePair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeagueID    string `protobuf:"bytes,1,opt,name=LeagueID,proto3" json:"LeagueID,omitempty"`
+	FranchiseID string `protobuf:"bytes,2,opt,name=FranchiseID,proto3" json:"FranchiseID,omitempty"`
+}
+
+func (x *LeagueFranchisePair) Reset() {
+	*x = LeagueFranchisePair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *DraftRequest) GetProspectID() string {
+func (x *LeagueFranchisePair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeagueFranchisePair) ProtoMessage() {}
+
+func (x *LeagueFranchisePair) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeagueFranchisePair.ProtoReflect.Descriptor instead.
+func (*LeagueFranchisePair) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LeagueFranchisePair) GetLeagueID() string {
 	if x != nil {
-		return x.ProspectID
+		return x.LeagueID
 	}
 	return ""
 }
 
-func (x *DraftRequest) GetPickID() string {
+func (x *LeagueFranchisePair) GetFranchiseID() string {
 	if x != nil {
-		return x.PickID
+		return x.FranchiseID
 	}
 	return ""
 }
 
-type TradePayload struct {
+type GetLeagueFranchisePairsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FranchiseID string   `protobuf:"bytes,1,opt,name=FranchiseID,proto3" json:"FranchiseID,omitempty"`
-	Picks       []string `protobuf:"bytes,2,rep,name=Picks,proto3" json:"Picks,omitempty"`
-	Prospects   []string `protobuf:"bytes,3,rep,name=Prospects,proto3" json:"Prospects,omitempty"`
+	Status int64                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result []*LeagueFranchisePair `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *TradePayload) Reset() {
-	*x = TradePayload{}
+func (x *GetLeagueFranchisePairsResponse) Reset() {
+	*x = GetLeagueFranchisePairsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_pb_fantasy_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2351,13 +3090,13 @@ func (x *TradePayload) Reset() {
 	}
 }
 
-func (x *TradePayload) String() string {
+func (x *GetLeagueFranchisePairsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TradePayload) ProtoMessage() {}
+func (*GetLeagueFranchisePairsResponse) ProtoMessage() {}
 
-func (x *TradePayload) ProtoReflect() protoreflect.Message {
+func (x *GetLeagueFranchisePairsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_service_pb_fantasy_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2369,43 +3108,50 @@ func (x *TradePayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TradePayload.ProtoReflect.Descriptor instead.
-func (*TradePayload) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLeagueFranchisePairsResponse.ProtoReflect.Descriptor instead.
+func (*GetLeagueFranchisePairsResponse) Descriptor() ([]byte, []int) {
 	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *TradePayload) GetFranchiseID() string {
+func (x *GetLeagueFranchisePairsResponse) GetStatus() int64 {
 	if x != nil {
-		return x.FranchiseID
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *TradePayload) GetPicks() []string {
+func (x *GetLeagueFranchisePairsResponse) GetError() string {
 	if x != nil {
-		return x.Picks
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-func (x *TradePayload) GetProspects() []string {
+func (x *GetLeagueFranchisePairsResponse) GetResult() []*LeagueFranchisePair {
 	if x != nil {
-		return x.Prospects
+		return x.Result
 	}
 	return nil
 }
 
-type TradeRequest struct {
+// create franchise, only the commissioner or admin may create franchises for others,
+// everyone else claims an invitation
+type FranchiseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	First  *TradePayload `protobuf:"bytes,1,opt,name=First,proto3" json:"First,omitempty"`
-	Second *TradePayload `protobuf:"bytes,2,opt,name=Second,proto3" json:"Second,omitempty"`
+	Name           string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	OwnerID        string `protobuf:"bytes,2,opt,name=OwnerID,proto3" json:"OwnerID,omitempty"`
+	OwnerName      string `protobuf:"bytes,3,opt,name=OwnerName,proto3" json:"OwnerName,omitempty"`
+	FoundationYear string `protobuf:"bytes,4,opt,name=FoundationYear,proto3" json:"FoundationYear,omitempty"`
+	LeagueId       string `protobuf:"bytes,5,opt,name=LeagueId,proto3" json:"LeagueId,omitempty"`
+	Founded        int32  `protobuf:"varint,6,opt,name=founded,proto3" json:"founded,omitempty"`
+	UserId         string `protobuf:"bytes,7,opt,name=userId,proto3" json:"userId,omitempty"`
 }
 
-func (x *TradeRequest) Reset() {
-	*x = TradeRequest{}
+func (x *FranchiseRequest) Reset() {
+	*x = FranchiseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_service_pb_fantasy_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2413,13 +3159,13 @@ func (x *TradeRequest) Reset() {
 	}
 }
 
-func (x *TradeRequest) String() string {
+func (x *FranchiseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TradeRequest) ProtoMessage() {}
+func (*FranchiseRequest) ProtoMessage() {}
 
-func (x *TradeRequest) ProtoReflect() protoreflect.Message {
+func (x *FranchiseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_pb_fantasy_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2431,99 +3177,87 @@ func (x *TradeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TradeRequest.ProtoReflect.Descriptor instead.
-func (*TradeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FranchiseRequest.ProtoReflect.Descriptor instead.
+func (*FranchiseRequest) Descriptor() ([]byte, []int) {
 	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *TradeRequest) GetFirst() *TradePayload {
+func (x *FranchiseRequest) GetName() string {
 	if x != nil {
-		return x.First
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *TradeRequest) GetSecond() *TradePayload {
+func (x *FranchiseRequest) GetOwnerID() string {
 	if x != nil {
-		return x.Second
+		return x.OwnerID
 	}
-	return nil
-}
-
-type TextSearchRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	return ""
 }
 
-func (x *TextSearchRequest) Reset() {
-	*x = TextSearchRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[33]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *FranchiseRequest) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
 	}
+	return ""
 }
 
-func (x *TextSearchRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FranchiseRequest) GetFoundationYear() string {
+	if x != nil {
+		return x.FoundationYear
+	}
+	return ""
 }
 
-func (*TextSearchRequest) ProtoMessage() {}
-
-func (x *TextSearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[33]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *FranchiseRequest) GetLeagueId() string {
+	if x != nil {
+		return x.LeagueId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use TextSearchRequest.ProtoReflect.Descriptor instead.
-func (*TextSearchRequest) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{33}
+func (x *FranchiseRequest) GetFounded() int32 {
+	if x != nil {
+		return x.Founded
+	}
+	return 0
 }
 
-func (x *TextSearchRequest) GetText() string {
+func (x *FranchiseRequest) GetUserId() string {
 	if x != nil {
-		return x.Text
+		return x.UserId
 	}
 	return ""
 }
 
-type ProspectsResponse struct {
+type FranchiseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status    int64       `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error     string      `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	Prospects []*Prospect `protobuf:"bytes,3,rep,name=prospects,proto3" json:"prospects,omitempty"`
+	Status      int64  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error       string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	FranchiseId string `protobuf:"bytes,3,opt,name=FranchiseId,proto3" json:"FranchiseId,omitempty"`
 }
 
-func (x *ProspectsResponse) Reset() {
-	*x = ProspectsResponse{}
+func (x *FranchiseResponse) Reset() {
+	*x = FranchiseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_pb_fantasy_proto_msgTypes[34]
+		mi := &file_service_pb_fantasy_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProspectsResponse) String() string {
+func (x *FranchiseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProspectsResponse) ProtoMessage() {}
+func (*FranchiseResponse) ProtoMessage() {}
 
-func (x *ProspectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_pb_fantasy_proto_msgTypes[34]
+func (x *FranchiseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2534,585 +3268,8610 @@ func (x *ProspectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProspectsResponse.ProtoReflect.Descriptor instead.
-func (*ProspectsResponse) Descriptor() ([]byte, []int) {
-	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use FranchiseResponse.ProtoReflect.Descriptor instead.
+func (*FranchiseResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ProspectsResponse) GetStatus() int64 {
+func (x *FranchiseResponse) GetStatus() int64 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *ProspectsResponse) GetError() string {
+func (x *FranchiseResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-func (x *ProspectsResponse) GetProspects() []*Prospect {
+func (x *FranchiseResponse) GetFranchiseId() string {
 	if x != nil {
-		return x.Prospects
+		return x.FranchiseId
+	}
+	return ""
+}
+
+// get single league
+type GetFranchiseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeagueID    string          `protobuf:"bytes,1,opt,name=LeagueID,proto3" json:"LeagueID,omitempty"`
+	FranchiseID string          `protobuf:"bytes,2,opt,name=FranchiseID,proto3" json:"FranchiseID,omitempty"`
+	PageSize    int32           `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken   string          `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	OrderBy     string          `protobuf:"bytes,5,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	Filter      *ProspectFilter `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *GetFranchiseRequest) Reset() {
+	*x = GetFranchiseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFranchiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFranchiseRequest) ProtoMessage() {}
+
+func (x *GetFranchiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFranchiseRequest.ProtoReflect.Descriptor instead.
+func (*GetFranchiseRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetFranchiseRequest) GetLeagueID() string {
+	if x != nil {
+		return x.LeagueID
+	}
+	return ""
+}
+
+func (x *GetFranchiseRequest) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *GetFranchiseRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetFranchiseRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetFranchiseRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetFranchiseRequest) GetFilter() *ProspectFilter {
+	if x != nil {
+		return x.Filter
 	}
 	return nil
 }
 
-var File_service_pb_fantasy_proto protoreflect.FileDescriptor
+type GetFranchiseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_service_pb_fantasy_proto_rawDesc = []byte{
-	0x0a, 0x18, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x62, 0x2f, 0x66, 0x61, 0x6e,
-	0x74, 0x61, 0x73, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x66, 0x61, 0x6e, 0x74,
-	0x61, 0x73, 0x79, 0x22, 0xcc, 0x03, 0x0a, 0x06, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14,
-	0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x22,
-	0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26,
-	0x0a, 0x0e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x4d, 0x61, 0x78, 0x46, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x4d,
-	0x61, 0x78, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c,
-	0x4d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0c, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73,
-	0x12, 0x2c, 0x0a, 0x11, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x47,
-	0x6f, 0x61, 0x6c, 0x69, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x44, 0x72, 0x61,
-	0x66, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x47, 0x6f, 0x61, 0x6c, 0x69, 0x65, 0x12, 0x2c,
-	0x0a, 0x11, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x53, 0x6b, 0x61,
-	0x74, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x44, 0x72, 0x61, 0x66, 0x74,
-	0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x53, 0x6b, 0x61, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b,
-	0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x32,
-	0x0a, 0x0a, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x0a, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73,
-	0x65, 0x73, 0x22, 0xdc, 0x01, 0x0a, 0x09, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44,
-	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c,
-	0x0a, 0x09, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e,
-	0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x59, 0x65, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44,
-	0x12, 0x2f, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x18, 0x07, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72,
-	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
-	0x73, 0x22, 0x9f, 0x04, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a,
-	0x0a, 0x08, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x69,
-	0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x46,
-	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x68, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4e, 0x68, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x22,
-	0x0a, 0x0c, 0x4e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65,
-	0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x42, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x57, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x4e, 0x68, 0x6c, 0x44, 0x72, 0x61,
-	0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x13, 0x4e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63,
-	0x6b, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x4e, 0x68, 0x6c, 0x50,
-	0x69, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x4e, 0x68, 0x6c, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x12, 0x22, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44,
-	0x12, 0x20, 0x0a, 0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65,
-	0x49, 0x44, 0x12, 0x21, 0x0a, 0x04, 0x50, 0x69, 0x63, 0x6b, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x69, 0x63, 0x6b, 0x52,
-	0x04, 0x50, 0x69, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x22, 0x88, 0x03, 0x0a, 0x04, 0x50, 0x69, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
-	0x44, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x44, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x72,
-	0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x44, 0x72,
-	0x61, 0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x4f,
-	0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50,
-	0x69, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
-	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x61,
-	0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x4c, 0x61, 0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d,
-	0x4c, 0x61, 0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x4c, 0x61, 0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x1e,
-	0x0a, 0x0a, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xa1,
-	0x01, 0x0a, 0x09, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09,
-	0x44, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x44, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x72,
-	0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x44, 0x72,
-	0x61, 0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x4f,
-	0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50,
-	0x69, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x22, 0x59, 0x0a, 0x0f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8f, 0x03,
-	0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x44, 0x12,
-	0x22, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61,
-	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x4d, 0x61, 0x78, 0x46, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
-	0x4d, 0x61, 0x78, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x73, 0x12, 0x22, 0x0a,
-	0x0c, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0c, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
-	0x73, 0x12, 0x2c, 0x0a, 0x11, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73,
-	0x47, 0x6f, 0x61, 0x6c, 0x69, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x44, 0x72,
-	0x61, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x47, 0x6f, 0x61, 0x6c, 0x69, 0x65, 0x12,
-	0x2c, 0x0a, 0x11, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x53, 0x6b,
-	0x61, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x44, 0x72, 0x61, 0x66,
-	0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x53, 0x6b, 0x61, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a,
-	0x0b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22,
-	0x55, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
-	0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06,
-	0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x22, 0x5a, 0x0a, 0x0e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65,
-	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65,
-	0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x65,
-	0x61, 0x67, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x61,
-	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x22, 0x2e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x67,
-	0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x67,
-	0x75, 0x65, 0x49, 0x64, 0x22, 0x6a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73,
-	0x79, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x22, 0x77, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a,
-	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73,
-	0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x38, 0x0a, 0x1e, 0x47, 0x65, 0x74,
-	0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x50,
-	0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65,
-	0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65,
-	0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68,
-	0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x22, 0x85, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74,
-	0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x50,
-	0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x61, 0x6e,
-	0x74, 0x61, 0x73, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x69, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x22, 0xa2, 0x01, 0x0a, 0x10, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x59,
-	0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x6f, 0x75, 0x6e, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x61,
-	0x67, 0x75, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x61,
-	0x67, 0x75, 0x65, 0x49, 0x64, 0x22, 0x63, 0x0a, 0x11, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x72, 0x61, 0x6e,
-	0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a,
-	0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x22,
-	0x70, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e,
-	0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x22, 0x9a, 0x03, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73,
-	0x70, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x68,
-	0x6c, 0x54, 0x65, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x68, 0x6c,
-	0x54, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72,
-	0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x6e, 0x68, 0x6c, 0x44, 0x72, 0x61,
-	0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x13, 0x6e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63,
-	0x6b, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x12, 0x30, 0x0a, 0x13, 0x6e, 0x68, 0x6c, 0x44,
-	0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50,
-	0x69, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x46,
-	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x73, 0x70,
-	0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74,
-	0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x22, 0x66, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e,
-	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44, 0x22, 0x53,
-	0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
-	0x73, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x09,
-	0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65,
-	0x63, 0x74, 0x73, 0x22, 0x6d, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49,
-	0x64, 0x73, 0x22, 0x65, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x05, 0x70, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x69,
-	0x63, 0x6b, 0x52, 0x05, 0x70, 0x69, 0x63, 0x6b, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x63, 0x6b,
-	0x12, 0x1c, 0x0a, 0x09, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44,
-	0x12, 0x12, 0x0a, 0x04, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x59, 0x65, 0x61, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x50,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x4c,
-	0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6b,
-	0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x05, 0x70, 0x69, 0x63, 0x6b,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73,
-	0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x69, 0x63, 0x6b, 0x52, 0x05, 0x70, 0x69, 0x63, 0x6b, 0x73, 0x22, 0x63, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
-	0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x59, 0x65, 0x61, 0x72,
-	0x22, 0x84, 0x01, 0x0a, 0x0c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a,
-	0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12,
-	0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12,
-	0x16, 0x0a, 0x06, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x22, 0x64, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x64, 0x65,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x69, 0x63,
-	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x50, 0x69, 0x63, 0x6b, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x22, 0x6a, 0x0a,
-	0x0c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a,
-	0x05, 0x46, 0x69, 0x72, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66,
-	0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x52, 0x05, 0x46, 0x69, 0x72, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x53, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x61, 0x6e,
-	0x74, 0x61, 0x73, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x52, 0x06, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0x27, 0x0a, 0x11, 0x54, 0x65, 0x78,
-	0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
-	0x78, 0x74, 0x22, 0x72, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63,
-	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61,
-	0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x09, 0x70, 0x72, 0x6f,
-	0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x32, 0xa6, 0x0b, 0x0a, 0x0e, 0x46, 0x61, 0x6e, 0x74, 0x61,
-	0x73, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x12, 0x16, 0x2e, 0x66, 0x61, 0x6e, 0x74,
-	0x61, 0x73, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x67,
-	0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a,
-	0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x66, 0x61, 0x6e,
-	0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
-	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67,
-	0x75, 0x65, 0x12, 0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74,
-	0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x12, 0x1c, 0x2e, 0x66, 0x61,
-	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x61, 0x6e, 0x74,
-	0x61, 0x73, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75,
-	0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x66, 0x61,
-	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
-	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68,
-	0x69, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a,
-	0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73,
-	0x65, 0x12, 0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72, 0x61, 0x6e,
-	0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x66,
-	0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65,
-	0x74, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x12, 0x1c, 0x2e, 0x66, 0x61, 0x6e,
-	0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61,
-	0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x66, 0x61,
-	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73,
-	0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x61,
-	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73,
-	0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62,
-	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
-	0x73, 0x42, 0x75, 0x6c, 0x6b, 0x12, 0x23, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x42,
-	0x75, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x61, 0x6e,
-	0x74, 0x61, 0x73, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70,
-	0x65, 0x63, 0x74, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x13, 0x54, 0x65, 0x78, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x66, 0x61, 0x6e, 0x74,
-	0x61, 0x73, 0x79, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e,
-	0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65,
-	0x63, 0x74, 0x73, 0x42, 0x79, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x12, 0x1c,
-	0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6e,
-	0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x66,
-	0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x50, 0x69, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73,
-	0x65, 0x12, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x61,
-	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50,
-	0x69, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x59, 0x65, 0x61, 0x72, 0x12, 0x18, 0x2e, 0x66, 0x61, 0x6e,
-	0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x3a, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x15, 0x2e, 0x66, 0x61, 0x6e,
-	0x74, 0x61, 0x73, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a,
-	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x69, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x63,
-	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74,
-	0x61, 0x73, 0x79, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x72,
-	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x15, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
-	0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x55, 0x6e, 0x64,
-	0x72, 0x61, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x15, 0x2e, 0x66,
-	0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x6e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46, 0x72, 0x61, 0x6e,
-	0x63, 0x68, 0x69, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x66, 0x61, 0x6e,
-	0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65,
-	0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65,
-	0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
-	0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Status int64      `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result *Franchise `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-var (
-	file_service_pb_fantasy_proto_rawDescOnce sync.Once
-	file_service_pb_fantasy_proto_rawDescData = file_service_pb_fantasy_proto_rawDesc
-)
+func (x *GetFranchiseResponse) Reset() {
+	*x = GetFranchiseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_service_pb_fantasy_proto_rawDescGZIP() []byte {
-	file_service_pb_fantasy_proto_rawDescOnce.Do(func() {
-		file_service_pb_fantasy_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_pb_fantasy_proto_rawDescData)
-	})
-	return file_service_pb_fantasy_proto_rawDescData
+func (x *GetFranchiseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_service_pb_fantasy_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
-var file_service_pb_fantasy_proto_goTypes = []interface{}{
-	(*League)(nil),                          // 0: fantasy.League
-	(*Franchise)(nil),                       // 1: fantasy.Franchise
-	(*Prospect)(nil),                        // 2: fantasy.Prospect
-	(*Pick)(nil),                            // 3: fantasy.Pick
-	(*DraftPick)(nil),                       // 4: fantasy.DraftPick
-	(*DefaultResponse)(nil),                 // 5: fantasy.DefaultResponse
-	(*LeagueRequest)(nil),                   // 6: fantasy.LeagueRequest
-	(*LeagueUpdateRequest)(nil),             // 7: fantasy.LeagueUpdateRequest
-	(*LeagueResponse)(nil),                  // 8: fantasy.LeagueResponse
-	(*GetLeaguesRequest)(nil),               // 9: fantasy.GetLeaguesRequest
-	(*GetLeaguesResponse)(nil),              // 10: fantasy.GetLeaguesResponse
-	(*GetLeagueRequest)(nil),                // 11: fantasy.GetLeagueRequest
-	(*GetLeagueResponse)(nil),               // 12: fantasy.GetLeagueResponse
-	(*GetLeagueFranchisesResponse)(nil),     // 13: fantasy.GetLeagueFranchisesResponse
-	(*GetLeagueFranchisePairsRequest)(nil),  // 14: fantasy.GetLeagueFranchisePairsRequest
-	(*LeagueFranchisePair)(nil),             // 15: fantasy.LeagueFranchisePair
-	(*GetLeagueFranchisePairsResponse)(nil), // 16: fantasy.GetLeagueFranchisePairsResponse
-	(*FranchiseRequest)(nil),                // 17: fantasy.FranchiseRequest
-	(*FranchiseResponse)(nil),               // 18: fantasy.FranchiseResponse
-	(*GetFranchiseRequest)(nil),             // 19: fantasy.GetFranchiseRequest
-	(*GetFranchiseResponse)(nil),            // 20: fantasy.GetFranchiseResponse
-	(*CreateProspect)(nil),                  // 21: fantasy.CreateProspect
-	(*CreateProspectRequest)(nil),           // 22: fantasy.CreateProspectRequest
-	(*CreateProspectResponse)(nil),          // 23: fantasy.CreateProspectResponse
-	(*CreateProspectsBulkRequest)(nil),      // 24: fantasy.CreateProspectsBulkRequest
-	(*CreateProspectsBulkResponse)(nil),     // 25: fantasy.CreateProspectsBulkResponse
-	(*GetPicksResponse)(nil),                // 26: fantasy.GetPicksResponse
-	(*CreateOrUpdatePick)(nil),              // 27: fantasy.CreateOrUpdatePick
-	(*CreateOrUpdatePicksRequest)(nil),      // 28: fantasy.CreateOrUpdatePicksRequest
-	(*GetPicksRequest)(nil),                 // 29: fantasy.GetPicksRequest
-	(*DraftRequest)(nil),                    // 30: fantasy.DraftRequest
-	(*TradePayload)(nil),                    // 31: fantasy.TradePayload
-	(*TradeRequest)(nil),                    // 32: fantasy.TradeRequest
-	(*TextSearchRequest)(nil),               // 33: fantasy.TextSearchRequest
-	(*ProspectsResponse)(nil),               // 34: fantasy.ProspectsResponse
+func (*GetFranchiseResponse) ProtoMessage() {}
+
+func (x *GetFranchiseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_service_pb_fantasy_proto_depIdxs = []int32{
-	1,  // 0: fantasy.League.Franchises:type_name -> fantasy.Franchise
-	2,  // 1: fantasy.Franchise.Prospects:type_name -> fantasy.Prospect
-	3,  // 2: fantasy.Prospect.Pick:type_name -> fantasy.Pick
-	6,  // 3: fantasy.LeagueUpdateRequest.league:type_name -> fantasy.LeagueRequest
-	0,  // 4: fantasy.GetLeaguesResponse.result:type_name -> fantasy.League
-	0,  // 5: fantasy.GetLeagueResponse.result:type_name -> fantasy.League
-	1,  // 6: fantasy.GetLeagueFranchisesResponse.result:type_name -> fantasy.Franchise
-	15, // 7: fantasy.GetLeagueFranchisePairsResponse.result:type_name -> fantasy.LeagueFranchisePair
-	1,  // 8: fantasy.GetFranchiseResponse.result:type_name -> fantasy.Franchise
-	2,  // 9: fantasy.CreateProspectRequest.prospect:type_name -> fantasy.Prospect
-	21, // 10: fantasy.CreateProspectsBulkRequest.prospects:type_name -> fantasy.CreateProspect
-	3,  // 11: fantasy.GetPicksResponse.picks:type_name -> fantasy.Pick
-	27, // 12: fantasy.CreateOrUpdatePicksRequest.picks:type_name -> fantasy.CreateOrUpdatePick
-	31, // 13: fantasy.TradeRequest.First:type_name -> fantasy.TradePayload
-	31, // 14: fantasy.TradeRequest.Second:type_name -> fantasy.TradePayload
-	2,  // 15: fantasy.ProspectsResponse.prospects:type_name -> fantasy.Prospect
-	6,  // 16: fantasy.FantasyService.CreateLeague:input_type -> fantasy.LeagueRequest
-	9,  // 17: fantasy.FantasyService.GetLeagues:input_type -> fantasy.GetLeaguesRequest
-	11, // 18: fantasy.FantasyService.GetLeague:input_type -> fantasy.GetLeagueRequest
-	7,  // 19: fantasy.FantasyService.UpdateLeague:input_type -> fantasy.LeagueUpdateRequest
-	11, // 20: fantasy.FantasyService.GetLeagueFranchises:input_type -> fantasy.GetLeagueRequest
-	17, // 21: fantasy.FantasyService.CreateFranchise:input_type -> fantasy.FranchiseRequest
-	19, // 22: fantasy.FantasyService.GetFranchise:input_type -> fantasy.GetFranchiseRequest
-	22, // 23: fantasy.FantasyService.CreateProspect:input_type -> fantasy.CreateProspectRequest
-	24, // 24: fantasy.FantasyService.CreateProspectsBulk:input_type -> fantasy.CreateProspectsBulkRequest
-	33, // 25: fantasy.FantasyService.TextSearchProspects:input_type -> fantasy.TextSearchRequest
-	19, // 26: fantasy.FantasyService.GetProspectsByFranchise:input_type -> fantasy.GetFranchiseRequest
-	29, // 27: fantasy.FantasyService.GetPicksByFranchise:input_type -> fantasy.GetPicksRequest
-	29, // 28: fantasy.FantasyService.GetPicksByYear:input_type -> fantasy.GetPicksRequest
-	32, // 29: fantasy.FantasyService.Trade:input_type -> fantasy.TradeRequest
-	28, // 30: fantasy.FantasyService.CreateOrUpdatePicks:input_type -> fantasy.CreateOrUpdatePicksRequest
-	30, // 31: fantasy.FantasyService.DraftProspect:input_type -> fantasy.DraftRequest
-	30, // 32: fantasy.FantasyService.UndraftProspect:input_type -> fantasy.DraftRequest
-	14, // 33: fantasy.FantasyService.GetLeagueFranchisePairs:input_type -> fantasy.GetLeagueFranchisePairsRequest
-	8,  // 34: fantasy.FantasyService.CreateLeague:output_type -> fantasy.LeagueResponse
-	10, // 35: fantasy.FantasyService.GetLeagues:output_type -> fantasy.GetLeaguesResponse
-	12, // 36: fantasy.FantasyService.GetLeague:output_type -> fantasy.GetLeagueResponse
-	8,  // 37: fantasy.FantasyService.UpdateLeague:output_type -> fantasy.LeagueResponse
-	13, // 38: fantasy.FantasyService.GetLeagueFranchises:output_type -> fantasy.GetLeagueFranchisesResponse
-	18, // 39: fantasy.FantasyService.CreateFranchise:output_type -> fantasy.FranchiseResponse
-	20, // 40: fantasy.FantasyService.GetFranchise:output_type -> fantasy.GetFranchiseResponse
-	23, // 41: fantasy.FantasyService.CreateProspect:output_type -> fantasy.CreateProspectResponse
-	25, // 42: fantasy.FantasyService.CreateProspectsBulk:output_type -> fantasy.CreateProspectsBulkResponse
-	34, // 43: fantasy.FantasyService.TextSearchProspects:output_type -> fantasy.ProspectsResponse
-	34, // 44: fantasy.FantasyService.GetProspectsByFranchise:output_type -> fantasy.ProspectsResponse
-	26, // 45: fantasy.FantasyService.GetPicksByFranchise:output_type -> fantasy.GetPicksResponse
-	26, // 46: fantasy.FantasyService.GetPicksByYear:output_type -> fantasy.GetPicksResponse
-	5,  // 47: fantasy.FantasyService.Trade:output_type -> fantasy.DefaultResponse
-	5,  // 48: fantasy.FantasyService.CreateOrUpdatePicks:output_type -> fantasy.DefaultResponse
-	5,  // 49: fantasy.FantasyService.DraftProspect:output_type -> fantasy.DefaultResponse
-	5,  // 50: fantasy.FantasyService.UndraftProspect:output_type -> fantasy.DefaultResponse
-	16, // 51: fantasy.FantasyService.GetLeagueFranchisePairs:output_type -> fantasy.GetLeagueFranchisePairsResponse
-	34, // [34:52] is the sub-list for method output_type
-	16, // [16:34] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+
+// Deprecated: Use GetFranchiseResponse.ProtoReflect.Descriptor instead.
+func (*GetFranchiseResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{35}
 }
 
-func init() { file_service_pb_fantasy_proto_init() }
-func file_service_pb_fantasy_proto_init() {
-	if File_service_pb_fantasy_proto != nil {
-		return
+func (x *GetFranchiseResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_service_pb_fantasy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*League); i {
+	return 0
+}
+
+func (x *GetFranchiseResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetFranchiseResponse) GetResult() *Franchise {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// a rostered prospect with the pick it was drafted with. The franchise holds the rights of drafted
+// prospects up to rightsUntil, the draft year of the pick plus the league's draft rights years of
+// goalies or skaters minus one, it is unset without pick or draft rights
+type RosterProspect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prospect    *Prospect `protobuf:"bytes,1,opt,name=prospect,proto3" json:"prospect,omitempty"`
+	RightsUntil int32     `protobuf:"varint,2,opt,name=rightsUntil,proto3" json:"rightsUntil,omitempty"`
+	// the league's season lies after rightsUntil
+	RightsExpired bool `protobuf:"varint,3,opt,name=rightsExpired,proto3" json:"rightsExpired,omitempty"`
+}
+
+func (x *RosterProspect) Reset() {
+	*x = RosterProspect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RosterProspect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterProspect) ProtoMessage() {}
+
+func (x *RosterProspect) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterProspect.ProtoReflect.Descriptor instead.
+func (*RosterProspect) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RosterProspect) GetProspect() *Prospect {
+	if x != nil {
+		return x.Prospect
+	}
+	return nil
+}
+
+func (x *RosterProspect) GetRightsUntil() int32 {
+	if x != nil {
+		return x.RightsUntil
+	}
+	return 0
+}
+
+func (x *RosterProspect) GetRightsExpired() bool {
+	if x != nil {
+		return x.RightsExpired
+	}
+	return false
+}
+
+type PositionCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position  Position `protobuf:"varint,1,opt,name=position,proto3,enum=fantasy.Position" json:"position,omitempty"`
+	Prospects int32    `protobuf:"varint,2,opt,name=prospects,proto3" json:"prospects,omitempty"`
+	Protected int32    `protobuf:"varint,3,opt,name=protected,proto3" json:"protected,omitempty"`
+}
+
+func (x *PositionCount) Reset() {
+	*x = PositionCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PositionCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionCount) ProtoMessage() {}
+
+func (x *PositionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionCount.ProtoReflect.Descriptor instead.
+func (*PositionCount) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PositionCount) GetPosition() Position {
+	if x != nil {
+		return x.Position
+	}
+	return Position_POSITION_UNSPECIFIED
+}
+
+func (x *PositionCount) GetProspects() int32 {
+	if x != nil {
+		return x.Prospects
+	}
+	return 0
+}
+
+func (x *PositionCount) GetProtected() int32 {
+	if x != nil {
+		return x.Protected
+	}
+	return 0
+}
+
+type RosterUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prospects         int32 `protobuf:"varint,1,opt,name=prospects,proto3" json:"prospects,omitempty"`
+	MaxProspects      int32 `protobuf:"varint,2,opt,name=maxProspects,proto3" json:"maxProspects,omitempty"`
+	Protected         int32 `protobuf:"varint,3,opt,name=protected,proto3" json:"protected,omitempty"`
+	Goalies           int32 `protobuf:"varint,4,opt,name=goalies,proto3" json:"goalies,omitempty"`
+	Skaters           int32 `protobuf:"varint,5,opt,name=skaters,proto3" json:"skaters,omitempty"`
+	DraftRightsGoalie int32 `protobuf:"varint,6,opt,name=draftRightsGoalie,proto3" json:"draftRightsGoalie,omitempty"`
+	DraftRightsSkater int32 `protobuf:"varint,7,opt,name=draftRightsSkater,proto3" json:"draftRightsSkater,omitempty"`
+	RightsExpired     int32 `protobuf:"varint,8,opt,name=rightsExpired,proto3" json:"rightsExpired,omitempty"`
+}
+
+func (x *RosterUsage) Reset() {
+	*x = RosterUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RosterUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterUsage) ProtoMessage() {}
+
+func (x *RosterUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterUsage.ProtoReflect.Descriptor instead.
+func (*RosterUsage) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RosterUsage) GetProspects() int32 {
+	if x != nil {
+		return x.Prospects
+	}
+	return 0
+}
+
+func (x *RosterUsage) GetMaxProspects() int32 {
+	if x != nil {
+		return x.MaxProspects
+	}
+	return 0
+}
+
+func (x *RosterUsage) GetProtected() int32 {
+	if x != nil {
+		return x.Protected
+	}
+	return 0
+}
+
+func (x *RosterUsage) GetGoalies() int32 {
+	if x != nil {
+		return x.Goalies
+	}
+	return 0
+}
+
+func (x *RosterUsage) GetSkaters() int32 {
+	if x != nil {
+		return x.Skaters
+	}
+	return 0
+}
+
+func (x *RosterUsage) GetDraftRightsGoalie() int32 {
+	if x != nil {
+		return x.DraftRightsGoalie
+	}
+	return 0
+}
+
+func (x *RosterUsage) GetDraftRightsSkater() int32 {
+	if x != nil {
+		return x.DraftRightsSkater
+	}
+	return 0
+}
+
+func (x *RosterUsage) GetRightsExpired() int32 {
+	if x != nil {
+		return x.RightsExpired
+	}
+	return 0
+}
+
+type PicksOfYear struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year  int32   `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Picks []*Pick `protobuf:"bytes,2,rep,name=picks,proto3" json:"picks,omitempty"`
+}
+
+func (x *PicksOfYear) Reset() {
+	*x = PicksOfYear{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PicksOfYear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PicksOfYear) ProtoMessage() {}
+
+func (x *PicksOfYear) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PicksOfYear.ProtoReflect.Descriptor instead.
+func (*PicksOfYear) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PicksOfYear) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *PicksOfYear) GetPicks() []*Pick {
+	if x != nil {
+		return x.Picks
+	}
+	return nil
+}
+
+// the prospects, by position, the roster usage of the league's limits and the owned picks by draft year
+type FranchiseRoster struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Franchise *Franchise        `protobuf:"bytes,1,opt,name=franchise,proto3" json:"franchise,omitempty"`
+	Prospects []*RosterProspect `protobuf:"bytes,2,rep,name=prospects,proto3" json:"prospects,omitempty"`
+	Positions []*PositionCount  `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions,omitempty"`
+	Usage     *RosterUsage      `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	Picks     []*PicksOfYear    `protobuf:"bytes,5,rep,name=picks,proto3" json:"picks,omitempty"`
+}
+
+func (x *FranchiseRoster) Reset() {
+	*x = FranchiseRoster{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FranchiseRoster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FranchiseRoster) ProtoMessage() {}
+
+func (x *FranchiseRoster) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FranchiseRoster.ProtoReflect.Descriptor instead.
+func (*FranchiseRoster) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FranchiseRoster) GetFranchise() *Franchise {
+	if x != nil {
+		return x.Franchise
+	}
+	return nil
+}
+
+func (x *FranchiseRoster) GetProspects() []*RosterProspect {
+	if x != nil {
+		return x.Prospects
+	}
+	return nil
+}
+
+func (x *FranchiseRoster) GetPositions() []*PositionCount {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *FranchiseRoster) GetUsage() *RosterUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *FranchiseRoster) GetPicks() []*PicksOfYear {
+	if x != nil {
+		return x.Picks
+	}
+	return nil
+}
+
+type FranchiseRosterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int64            `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result *FranchiseRoster `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *FranchiseRosterResponse) Reset() {
+	*x = FranchiseRosterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FranchiseRosterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FranchiseRosterResponse) ProtoMessage() {}
+
+func (x *FranchiseRosterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FranchiseRosterResponse.ProtoReflect.Descriptor instead.
+func (*FranchiseRosterResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FranchiseRosterResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *FranchiseRosterResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FranchiseRosterResponse) GetResult() *FranchiseRoster {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// update name and foundation year, unset fields are kept
+type FranchiseUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Founded int32  `protobuf:"varint,3,opt,name=founded,proto3" json:"founded,omitempty"`
+	UserId  string `protobuf:"bytes,4,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *FranchiseUpdateRequest) Reset() {
+	*x = FranchiseUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FranchiseUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FranchiseUpdateRequest) ProtoMessage() {}
+
+func (x *FranchiseUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FranchiseUpdateRequest.ProtoReflect.Descriptor instead.
+func (*FranchiseUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FranchiseUpdateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FranchiseUpdateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FranchiseUpdateRequest) GetFounded() int32 {
+	if x != nil {
+		return x.Founded
+	}
+	return 0
+}
+
+func (x *FranchiseUpdateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// the new owner becomes the owner member, the previous owner leaves the franchise
+type TransferFranchiseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerID   string `protobuf:"bytes,2,opt,name=ownerID,proto3" json:"ownerID,omitempty"`
+	OwnerName string `protobuf:"bytes,3,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	UserId    string `protobuf:"bytes,4,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *TransferFranchiseRequest) Reset() {
+	*x = TransferFranchiseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferFranchiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferFranchiseRequest) ProtoMessage() {}
+
+func (x *TransferFranchiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferFranchiseRequest.ProtoReflect.Descriptor instead.
+func (*TransferFranchiseRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TransferFranchiseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransferFranchiseRequest) GetOwnerID() string {
+	if x != nil {
+		return x.OwnerID
+	}
+	return ""
+}
+
+func (x *TransferFranchiseRequest) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *TransferFranchiseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// soft delete, the prospects return to the pool and the picks go to the league's orphan franchise
+type DeleteFranchiseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *DeleteFranchiseRequest) Reset() {
+	*x = DeleteFranchiseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFranchiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFranchiseRequest) ProtoMessage() {}
+
+func (x *DeleteFranchiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFranchiseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFranchiseRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeleteFranchiseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteFranchiseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// name and owner of a franchise after a change
+type FranchiseChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Change    string                 `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerID   string                 `protobuf:"bytes,3,opt,name=ownerID,proto3" json:"ownerID,omitempty"`
+	OwnerName string                 `protobuf:"bytes,4,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changedAt,proto3" json:"changedAt,omitempty"`
+}
+
+func (x *FranchiseChange) Reset() {
+	*x = FranchiseChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FranchiseChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FranchiseChange) ProtoMessage() {}
+
+func (x *FranchiseChange) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FranchiseChange.ProtoReflect.Descriptor instead.
+func (*FranchiseChange) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *FranchiseChange) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+func (x *FranchiseChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FranchiseChange) GetOwnerID() string {
+	if x != nil {
+		return x.OwnerID
+	}
+	return ""
+}
+
+func (x *FranchiseChange) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *FranchiseChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type FranchiseHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int64              `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string             `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result []*FranchiseChange `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *FranchiseHistoryResponse) Reset() {
+	*x = FranchiseHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FranchiseHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FranchiseHistoryResponse) ProtoMessage() {}
+
+func (x *FranchiseHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FranchiseHistoryResponse.ProtoReflect.Descriptor instead.
+func (*FranchiseHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *FranchiseHistoryResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *FranchiseHistoryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FranchiseHistoryResponse) GetResult() []*FranchiseChange {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// role is one of co-owner and assistant-gm, the owner changes by transfer
+type FranchiseMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FranchiseID string `protobuf:"bytes,1,opt,name=franchiseID,proto3" json:"franchiseID,omitempty"`
+	MemberID    string `protobuf:"bytes,2,opt,name=memberID,proto3" json:"memberID,omitempty"`
+	MemberName  string `protobuf:"bytes,3,opt,name=memberName,proto3" json:"memberName,omitempty"`
+	Role        string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	UserId      string `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *FranchiseMemberRequest) Reset() {
+	*x = FranchiseMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FranchiseMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FranchiseMemberRequest) ProtoMessage() {}
+
+func (x *FranchiseMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FranchiseMemberRequest.ProtoReflect.Descriptor instead.
+func (*FranchiseMemberRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *FranchiseMemberRequest) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *FranchiseMemberRequest) GetMemberID() string {
+	if x != nil {
+		return x.MemberID
+	}
+	return ""
+}
+
+func (x *FranchiseMemberRequest) GetMemberName() string {
+	if x != nil {
+		return x.MemberName
+	}
+	return ""
+}
+
+func (x *FranchiseMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *FranchiseMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type FranchiseMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID      string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	UserName    string   `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	Role        string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Permissions []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (x *FranchiseMember) Reset() {
+	*x = FranchiseMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FranchiseMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FranchiseMember) ProtoMessage() {}
+
+func (x *FranchiseMember) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FranchiseMember.ProtoReflect.Descriptor instead.
+func (*FranchiseMember) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *FranchiseMember) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *FranchiseMember) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *FranchiseMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *FranchiseMember) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type FranchiseMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int64              `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string             `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result []*FranchiseMember `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *FranchiseMembersResponse) Reset() {
+	*x = FranchiseMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FranchiseMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FranchiseMembersResponse) ProtoMessage() {}
+
+func (x *FranchiseMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FranchiseMembersResponse.ProtoReflect.Descriptor instead.
+func (*FranchiseMembersResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FranchiseMembersResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *FranchiseMembersResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FranchiseMembersResponse) GetResult() []*FranchiseMember {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// the commissioner or admin of the league (userId) invites someone by email,
+// the invitation reserves a franchise slot until it is claimed, revoked or expires
+type InvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeagueID      string `protobuf:"bytes,1,opt,name=leagueID,proto3" json:"leagueID,omitempty"`
+	Email         string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	FranchiseName string `protobuf:"bytes,4,opt,name=franchiseName,proto3" json:"franchiseName,omitempty"`
+	UserId        string `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *InvitationRequest) Reset() {
+	*x = InvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationRequest) ProtoMessage() {}
+
+func (x *InvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationRequest.ProtoReflect.Descriptor instead.
+func (*InvitationRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *InvitationRequest) GetLeagueID() string {
+	if x != nil {
+		return x.LeagueID
+	}
+	return ""
+}
+
+func (x *InvitationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InvitationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InvitationRequest) GetFranchiseName() string {
+	if x != nil {
+		return x.FranchiseName
+	}
+	return ""
+}
+
+func (x *InvitationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// the token is mailed to the invitee as well
+type InvitationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status       int64  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error        string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	InvitationID string `protobuf:"bytes,3,opt,name=invitationID,proto3" json:"invitationID,omitempty"`
+	Token        string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *InvitationResponse) Reset() {
+	*x = InvitationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationResponse) ProtoMessage() {}
+
+func (x *InvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationResponse.ProtoReflect.Descriptor instead.
+func (*InvitationResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *InvitationResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *InvitationResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *InvitationResponse) GetInvitationID() string {
+	if x != nil {
+		return x.InvitationID
+	}
+	return ""
+}
+
+func (x *InvitationResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevokeInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RevokeInvitationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevokeInvitationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// the authenticated user (userId) becomes the owner of the reserved franchise,
+// name and founded override the ones of the invitation
+type ClaimFranchiseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token    string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId   string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	UserName string `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"`
+	Name     string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Founded  int32  `protobuf:"varint,5,opt,name=founded,proto3" json:"founded,omitempty"`
+}
+
+func (x *ClaimFranchiseRequest) Reset() {
+	*x = ClaimFranchiseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimFranchiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimFranchiseRequest) ProtoMessage() {}
+
+func (x *ClaimFranchiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimFranchiseRequest.ProtoReflect.Descriptor instead.
+func (*ClaimFranchiseRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ClaimFranchiseRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ClaimFranchiseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClaimFranchiseRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ClaimFranchiseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClaimFranchiseRequest) GetFounded() int32 {
+	if x != nil {
+		return x.Founded
+	}
+	return 0
+}
+
+// Prospects
+type CreateProspect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FullName            string                 `protobuf:"bytes,1,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	FirstName           string                 `protobuf:"bytes,2,opt,name=firstName,proto3" json:"firstName,omitempty"`
+	LastName            string                 `protobuf:"bytes,3,opt,name=lastName,proto3" json:"lastName,omitempty"`
+	NhlTeam             string                 `protobuf:"bytes,4,opt,name=nhlTeam,proto3" json:"nhlTeam,omitempty"`
+	Birthdate           string                 `protobuf:"bytes,5,opt,name=birthdate,proto3" json:"birthdate,omitempty"`
+	Height              string                 `protobuf:"bytes,6,opt,name=height,proto3" json:"height,omitempty"`
+	Weight              string                 `protobuf:"bytes,7,opt,name=weight,proto3" json:"weight,omitempty"`
+	DraftYear           string                 `protobuf:"bytes,8,opt,name=draftYear,proto3" json:"draftYear,omitempty"`
+	NhlDraftRound       string                 `protobuf:"bytes,9,opt,name=nhlDraftRound,proto3" json:"nhlDraftRound,omitempty"`
+	NhlDraftPickOverall string                 `protobuf:"bytes,10,opt,name=nhlDraftPickOverall,proto3" json:"nhlDraftPickOverall,omitempty"`
+	NhlDraftPickInRound string                 `protobuf:"bytes,11,opt,name=nhlDraftPickInRound,proto3" json:"nhlDraftPickInRound,omitempty"`
+	PositionCode        string                 `protobuf:"bytes,12,opt,name=positionCode,proto3" json:"positionCode,omitempty"`
+	Position            Position               `protobuf:"varint,13,opt,name=position,proto3,enum=fantasy.Position" json:"position,omitempty"`
+	DateOfBirth         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	HeightInches        int32                  `protobuf:"varint,15,opt,name=heightInches,proto3" json:"heightInches,omitempty"`
+	WeightPounds        int32                  `protobuf:"varint,16,opt,name=weightPounds,proto3" json:"weightPounds,omitempty"`
+	NhlDraft            *NhlDraft              `protobuf:"bytes,17,opt,name=nhlDraft,proto3" json:"nhlDraft,omitempty"`
+}
+
+func (x *CreateProspect) Reset() {
+	*x = CreateProspect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProspect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProspect) ProtoMessage() {}
+
+func (x *CreateProspect) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProspect.ProtoReflect.Descriptor instead.
+func (*CreateProspect) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateProspect) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *CreateProspect) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *CreateProspect) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *CreateProspect) GetNhlTeam() string {
+	if x != nil {
+		return x.NhlTeam
+	}
+	return ""
+}
+
+func (x *CreateProspect) GetBirthdate() string {
+	if x != nil {
+		return x.Birthdate
+	}
+	return ""
+}
+
+func (x *CreateProspect) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+func (x *CreateProspect) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
+func (x *CreateProspect) GetDraftYear() string {
+	if x != nil {
+		return x.DraftYear
+	}
+	return ""
+}
+
+func (x *CreateProspect) GetNhlDraftRound() string {
+	if x != nil {
+		return x.NhlDraftRound
+	}
+	return ""
+}
+
+func (x *CreateProspect) GetNhlDraftPickOverall() string {
+	if x != nil {
+		return x.NhlDraftPickOverall
+	}
+	return ""
+}
+
+func (x *CreateProspect) GetNhlDraftPickInRound() string {
+	if x != nil {
+		return x.NhlDraftPickInRound
+	}
+	return ""
+}
+
+func (x *CreateProspect) GetPositionCode() string {
+	if x != nil {
+		return x.PositionCode
+	}
+	return ""
+}
+
+func (x *CreateProspect) GetPosition() Position {
+	if x != nil {
+		return x.Position
+	}
+	return Position_POSITION_UNSPECIFIED
+}
+
+func (x *CreateProspect) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *CreateProspect) GetHeightInches() int32 {
+	if x != nil {
+		return x.HeightInches
+	}
+	return 0
+}
+
+func (x *CreateProspect) GetWeightPounds() int32 {
+	if x != nil {
+		return x.WeightPounds
+	}
+	return 0
+}
+
+func (x *CreateProspect) GetNhlDraft() *NhlDraft {
+	if x != nil {
+		return x.NhlDraft
+	}
+	return nil
+}
+
+type CreateProspectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prospect *Prospect `protobuf:"bytes,1,opt,name=prospect,proto3" json:"prospect,omitempty"`
+}
+
+func (x *CreateProspectRequest) Reset() {
+	*x = CreateProspectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProspectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProspectRequest) ProtoMessage() {}
+
+func (x *CreateProspectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProspectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProspectRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateProspectRequest) GetProspect() *Prospect {
+	if x != nil {
+		return x.Prospect
+	}
+	return nil
+}
+
+type CreateProspectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     int64  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error      string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ProspectID string `protobuf:"bytes,3,opt,name=prospectID,proto3" json:"prospectID,omitempty"`
+}
+
+func (x *CreateProspectResponse) Reset() {
+	*x = CreateProspectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProspectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProspectResponse) ProtoMessage() {}
+
+func (x *CreateProspectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProspectResponse.ProtoReflect.Descriptor instead.
+func (*CreateProspectResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateProspectResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *CreateProspectResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CreateProspectResponse) GetProspectID() string {
+	if x != nil {
+		return x.ProspectID
+	}
+	return ""
+}
+
+type CreateProspectsBulkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prospects []*CreateProspect `protobuf:"bytes,1,rep,name=prospects,proto3" json:"prospects,omitempty"`
+}
+
+func (x *CreateProspectsBulkRequest) Reset() {
+	*x = CreateProspectsBulkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProspectsBulkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProspectsBulkRequest) ProtoMessage() {}
+
+func (x *CreateProspectsBulkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProspectsBulkRequest.ProtoReflect.Descriptor instead.
+func (*CreateProspectsBulkRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateProspectsBulkRequest) GetProspects() []*CreateProspect {
+	if x != nil {
+		return x.Prospects
+	}
+	return nil
+}
+
+type CreateProspectsBulkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      int64    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error       string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ProspectIds []string `protobuf:"bytes,3,rep,name=prospectIds,proto3" json:"prospectIds,omitempty"`
+}
+
+func (x *CreateProspectsBulkResponse) Reset() {
+	*x = CreateProspectsBulkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProspectsBulkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProspectsBulkResponse) ProtoMessage() {}
+
+func (x *CreateProspectsBulkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProspectsBulkResponse.ProtoReflect.Descriptor instead.
+func (*CreateProspectsBulkResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateProspectsBulkResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *CreateProspectsBulkResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CreateProspectsBulkResponse) GetProspectIds() []string {
+	if x != nil {
+		return x.ProspectIds
+	}
+	return nil
+}
+
+// the set values of the prospect replace the stored ones, empty values are kept
+type UpdateProspectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProspectID string          `protobuf:"bytes,1,opt,name=prospectID,proto3" json:"prospectID,omitempty"`
+	Prospect   *CreateProspect `protobuf:"bytes,2,opt,name=prospect,proto3" json:"prospect,omitempty"`
+}
+
+func (x *UpdateProspectRequest) Reset() {
+	*x = UpdateProspectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProspectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProspectRequest) ProtoMessage() {}
+
+func (x *UpdateProspectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProspectRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProspectRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateProspectRequest) GetProspectID() string {
+	if x != nil {
+		return x.ProspectID
+	}
+	return ""
+}
+
+func (x *UpdateProspectRequest) GetProspect() *CreateProspect {
+	if x != nil {
+		return x.Prospect
+	}
+	return nil
+}
+
+// the dropped prospect's picks, roster spot, stats, watchlist entries and listings move to the kept one,
+// its values fill the empty values of the kept one
+type MergeProspectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeepID string `protobuf:"bytes,1,opt,name=keepID,proto3" json:"keepID,omitempty"`
+	DropID string `protobuf:"bytes,2,opt,name=dropID,proto3" json:"dropID,omitempty"`
+}
+
+func (x *MergeProspectsRequest) Reset() {
+	*x = MergeProspectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeProspectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeProspectsRequest) ProtoMessage() {}
+
+func (x *MergeProspectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeProspectsRequest.ProtoReflect.Descriptor instead.
+func (*MergeProspectsRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MergeProspectsRequest) GetKeepID() string {
+	if x != nil {
+		return x.KeepID
+	}
+	return ""
+}
+
+func (x *MergeProspectsRequest) GetDropID() string {
+	if x != nil {
+		return x.DropID
+	}
+	return ""
+}
+
+// changes lists the changed values as "field: old -> new"
+type ProspectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   int64     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error    string    `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Prospect *Prospect `protobuf:"bytes,3,opt,name=prospect,proto3" json:"prospect,omitempty"`
+	Changes  []string  `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *ProspectResponse) Reset() {
+	*x = ProspectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProspectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProspectResponse) ProtoMessage() {}
+
+func (x *ProspectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProspectResponse.ProtoReflect.Descriptor instead.
+func (*ProspectResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ProspectResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ProspectResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ProspectResponse) GetProspect() *Prospect {
+	if x != nil {
+		return x.Prospect
+	}
+	return nil
+}
+
+func (x *ProspectResponse) GetChanges() []string {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// pairs with a name similarity of at least minSimilarity (0 for the default) and compatible birthdates,
+// nhlDraftYear restricts the report to a draft class
+type DuplicateProspectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinSimilarity float64 `protobuf:"fixed64,1,opt,name=minSimilarity,proto3" json:"minSimilarity,omitempty"`
+	NhlDraftYear  int32   `protobuf:"varint,2,opt,name=nhlDraftYear,proto3" json:"nhlDraftYear,omitempty"`
+}
+
+func (x *DuplicateProspectsRequest) Reset() {
+	*x = DuplicateProspectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DuplicateProspectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateProspectsRequest) ProtoMessage() {}
+
+func (x *DuplicateProspectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateProspectsRequest.ProtoReflect.Descriptor instead.
+func (*DuplicateProspectsRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DuplicateProspectsRequest) GetMinSimilarity() float64 {
+	if x != nil {
+		return x.MinSimilarity
+	}
+	return 0
+}
+
+func (x *DuplicateProspectsRequest) GetNhlDraftYear() int32 {
+	if x != nil {
+		return x.NhlDraftYear
+	}
+	return 0
+}
+
+type DuplicateProspects struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	First      *Prospect `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	Second     *Prospect `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+	Similarity float64   `protobuf:"fixed64,3,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	Reason     string    `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *DuplicateProspects) Reset() {
+	*x = DuplicateProspects{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DuplicateProspects) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateProspects) ProtoMessage() {}
+
+func (x *DuplicateProspects) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateProspects.ProtoReflect.Descriptor instead.
+func (*DuplicateProspects) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DuplicateProspects) GetFirst() *Prospect {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *DuplicateProspects) GetSecond() *Prospect {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+func (x *DuplicateProspects) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *DuplicateProspects) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DuplicateProspectsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int64                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string                `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result []*DuplicateProspects `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *DuplicateProspectsResponse) Reset() {
+	*x = DuplicateProspectsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DuplicateProspectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateProspectsResponse) ProtoMessage() {}
+
+func (x *DuplicateProspectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateProspectsResponse.ProtoReflect.Descriptor instead.
+func (*DuplicateProspectsResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DuplicateProspectsResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *DuplicateProspectsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DuplicateProspectsResponse) GetResult() []*DuplicateProspects {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// the status applies from the effective date on, today if unset. Dates before the current
+// status's only add to the history, a new current status applies the rule of the prospect's league
+type ProspectStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProspectID    string                 `protobuf:"bytes,1,opt,name=prospectID,proto3" json:"prospectID,omitempty"`
+	Status        ProspectStatus         `protobuf:"varint,2,opt,name=status,proto3,enum=fantasy.ProspectStatus" json:"status,omitempty"`
+	EffectiveDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effectiveDate,proto3" json:"effectiveDate,omitempty"`
+}
+
+func (x *ProspectStatusRequest) Reset() {
+	*x = ProspectStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProspectStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProspectStatusRequest) ProtoMessage() {}
+
+func (x *ProspectStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProspectStatusRequest.ProtoReflect.Descriptor instead.
+func (*ProspectStatusRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ProspectStatusRequest) GetProspectID() string {
+	if x != nil {
+		return x.ProspectID
+	}
+	return ""
+}
+
+func (x *ProspectStatusRequest) GetStatus() ProspectStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProspectStatus_PROSPECT_STATUS_UNSPECIFIED
+}
+
+func (x *ProspectStatusRequest) GetEffectiveDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return nil
+}
+
+type ProspectStatusHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProspectID string `protobuf:"bytes,1,opt,name=prospectID,proto3" json:"prospectID,omitempty"`
+}
+
+func (x *ProspectStatusHistoryRequest) Reset() {
+	*x = ProspectStatusHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProspectStatusHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProspectStatusHistoryRequest) ProtoMessage() {}
+
+func (x *ProspectStatusHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProspectStatusHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ProspectStatusHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ProspectStatusHistoryRequest) GetProspectID() string {
+	if x != nil {
+		return x.ProspectID
+	}
+	return ""
+}
+
+type ProspectStatusChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        ProspectStatus         `protobuf:"varint,1,opt,name=status,proto3,enum=fantasy.ProspectStatus" json:"status,omitempty"`
+	EffectiveDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=effectiveDate,proto3" json:"effectiveDate,omitempty"`
+	RecordedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=recordedAt,proto3" json:"recordedAt,omitempty"`
+}
+
+func (x *ProspectStatusChange) Reset() {
+	*x = ProspectStatusChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProspectStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProspectStatusChange) ProtoMessage() {}
+
+func (x *ProspectStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProspectStatusChange.ProtoReflect.Descriptor instead.
+func (*ProspectStatusChange) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ProspectStatusChange) GetStatus() ProspectStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProspectStatus_PROSPECT_STATUS_UNSPECIFIED
+}
+
+func (x *ProspectStatusChange) GetEffectiveDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return nil
+}
+
+func (x *ProspectStatusChange) GetRecordedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return nil
+}
+
+type ProspectStatusHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   int64                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error    string                  `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Prospect *Prospect               `protobuf:"bytes,3,opt,name=prospect,proto3" json:"prospect,omitempty"`
+	Result   []*ProspectStatusChange `protobuf:"bytes,4,rep,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ProspectStatusHistoryResponse) Reset() {
+	*x = ProspectStatusHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProspectStatusHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProspectStatusHistoryResponse) ProtoMessage() {}
+
+func (x *ProspectStatusHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProspectStatusHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ProspectStatusHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ProspectStatusHistoryResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ProspectStatusHistoryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ProspectStatusHistoryResponse) GetProspect() *Prospect {
+	if x != nil {
+		return x.Prospect
+	}
+	return nil
+}
+
+func (x *ProspectStatusHistoryResponse) GetResult() []*ProspectStatusChange {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// the owners of the franchise (userId) rank prospects for the draft of the year, the league's
+// season if unset. The prospects are listed best first, an empty list withdraws the ranking
+type SubmitRankingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FranchiseID string   `protobuf:"bytes,1,opt,name=franchiseID,proto3" json:"franchiseID,omitempty"`
+	UserId      string   `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Year        int32    `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	ProspectIds []string `protobuf:"bytes,4,rep,name=prospectIds,proto3" json:"prospectIds,omitempty"`
+}
+
+func (x *SubmitRankingRequest) Reset() {
+	*x = SubmitRankingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitRankingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRankingRequest) ProtoMessage() {}
+
+func (x *SubmitRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRankingRequest.ProtoReflect.Descriptor instead.
+func (*SubmitRankingRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SubmitRankingRequest) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *SubmitRankingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitRankingRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *SubmitRankingRequest) GetProspectIds() []string {
+	if x != nil {
+		return x.ProspectIds
+	}
+	return nil
+}
+
+// the rankings for the draft of the year, restricted to the source or the franchise if set
+type GetRankingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year        int32  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Source      string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	FranchiseID string `protobuf:"bytes,3,opt,name=franchiseID,proto3" json:"franchiseID,omitempty"`
+}
+
+func (x *GetRankingsRequest) Reset() {
+	*x = GetRankingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRankingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRankingsRequest) ProtoMessage() {}
+
+func (x *GetRankingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRankingsRequest.ProtoReflect.Descriptor instead.
+func (*GetRankingsRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetRankingsRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetRankingsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *GetRankingsRequest) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+type RankedProspect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank     int32     `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Prospect *Prospect `protobuf:"bytes,2,opt,name=prospect,proto3" json:"prospect,omitempty"`
+}
+
+func (x *RankedProspect) Reset() {
+	*x = RankedProspect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RankedProspect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankedProspect) ProtoMessage() {}
+
+func (x *RankedProspect) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankedProspect.ProtoReflect.Descriptor instead.
+func (*RankedProspect) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RankedProspect) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *RankedProspect) GetProspect() *Prospect {
+	if x != nil {
+		return x.Prospect
+	}
+	return nil
+}
+
+// source is set for imported rankings, franchiseID for the rankings of franchises
+type Ranking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source        string            `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	FranchiseID   string            `protobuf:"bytes,2,opt,name=franchiseID,proto3" json:"franchiseID,omitempty"`
+	FranchiseName string            `protobuf:"bytes,3,opt,name=franchiseName,proto3" json:"franchiseName,omitempty"`
+	Prospects     []*RankedProspect `protobuf:"bytes,4,rep,name=prospects,proto3" json:"prospects,omitempty"`
+}
+
+func (x *Ranking) Reset() {
+	*x = Ranking{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ranking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ranking) ProtoMessage() {}
+
+func (x *Ranking) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ranking.ProtoReflect.Descriptor instead.
+func (*Ranking) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Ranking) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Ranking) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *Ranking) GetFranchiseName() string {
+	if x != nil {
+		return x.FranchiseName
+	}
+	return ""
+}
+
+func (x *Ranking) GetProspects() []*RankedProspect {
+	if x != nil {
+		return x.Prospects
+	}
+	return nil
+}
+
+type RankingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int64      `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result []*Ranking `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *RankingsResponse) Reset() {
+	*x = RankingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RankingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankingsResponse) ProtoMessage() {}
+
+func (x *RankingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankingsResponse.ProtoReflect.Descriptor instead.
+func (*RankingsResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RankingsResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *RankingsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RankingsResponse) GetResult() []*Ranking {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// the imported rankings and the rankings of the league's franchises for the draft of the year,
+// the league's season if unset, are combined to the consensus of the prospects not drafted in the league
+type DraftBoardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeagueID string          `protobuf:"bytes,1,opt,name=leagueID,proto3" json:"leagueID,omitempty"`
+	Year     int32           `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Method   ConsensusMethod `protobuf:"varint,3,opt,name=method,proto3,enum=fantasy.ConsensusMethod" json:"method,omitempty"`
+}
+
+func (x *DraftBoardRequest) Reset() {
+	*x = DraftBoardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DraftBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftBoardRequest) ProtoMessage() {}
+
+func (x *DraftBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftBoardRequest.ProtoReflect.Descriptor instead.
+func (*DraftBoardRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DraftBoardRequest) GetLeagueID() string {
+	if x != nil {
+		return x.LeagueID
+	}
+	return ""
+}
+
+func (x *DraftBoardRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *DraftBoardRequest) GetMethod() ConsensusMethod {
+	if x != nil {
+		return x.Method
+	}
+	return ConsensusMethod_CONSENSUS_METHOD_AVERAGE
+}
+
+// score is the average or median rank over the rankings listing the prospect, ties are broken by
+// the number of rankings, then the best rank
+type DraftBoardEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank      int32     `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Score     float64   `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Rankings  int32     `protobuf:"varint,3,opt,name=rankings,proto3" json:"rankings,omitempty"`
+	BestRank  int32     `protobuf:"varint,4,opt,name=bestRank,proto3" json:"bestRank,omitempty"`
+	WorstRank int32     `protobuf:"varint,5,opt,name=worstRank,proto3" json:"worstRank,omitempty"`
+	Prospect  *Prospect `protobuf:"bytes,6,opt,name=prospect,proto3" json:"prospect,omitempty"`
+}
+
+func (x *DraftBoardEntry) Reset() {
+	*x = DraftBoardEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DraftBoardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftBoardEntry) ProtoMessage() {}
+
+func (x *DraftBoardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftBoardEntry.ProtoReflect.Descriptor instead.
+func (*DraftBoardEntry) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DraftBoardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *DraftBoardEntry) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *DraftBoardEntry) GetRankings() int32 {
+	if x != nil {
+		return x.Rankings
+	}
+	return 0
+}
+
+func (x *DraftBoardEntry) GetBestRank() int32 {
+	if x != nil {
+		return x.BestRank
+	}
+	return 0
+}
+
+func (x *DraftBoardEntry) GetWorstRank() int32 {
+	if x != nil {
+		return x.WorstRank
+	}
+	return 0
+}
+
+func (x *DraftBoardEntry) GetProspect() *Prospect {
+	if x != nil {
+		return x.Prospect
+	}
+	return nil
+}
+
+type DraftBoardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int64              `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string             `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result []*DraftBoardEntry `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
+	// the number of rankings the board combines
+	Rankings int32 `protobuf:"varint,4,opt,name=rankings,proto3" json:"rankings,omitempty"`
+}
+
+func (x *DraftBoardResponse) Reset() {
+	*x = DraftBoardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DraftBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftBoardResponse) ProtoMessage() {}
+
+func (x *DraftBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftBoardResponse.ProtoReflect.Descriptor instead.
+func (*DraftBoardResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DraftBoardResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *DraftBoardResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DraftBoardResponse) GetResult() []*DraftBoardEntry {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *DraftBoardResponse) GetRankings() int32 {
+	if x != nil {
+		return x.Rankings
+	}
+	return 0
+}
+
+type Standing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FranchiseID   string `protobuf:"bytes,1,opt,name=franchiseID,proto3" json:"franchiseID,omitempty"`
+	FranchiseName string `protobuf:"bytes,2,opt,name=franchiseName,proto3" json:"franchiseName,omitempty"`
+	Wins          int32  `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses        int32  `protobuf:"varint,4,opt,name=losses,proto3" json:"losses,omitempty"`
+	Points        int32  `protobuf:"varint,5,opt,name=points,proto3" json:"points,omitempty"`
+	Rank          int32  `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+}
+
+func (x *Standing) Reset() {
+	*x = Standing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Standing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Standing) ProtoMessage() {}
+
+func (x *Standing) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Standing.ProtoReflect.Descriptor instead.
+func (*Standing) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Standing) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *Standing) GetFranchiseName() string {
+	if x != nil {
+		return x.FranchiseName
+	}
+	return ""
+}
+
+func (x *Standing) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *Standing) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *Standing) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Standing) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+// the standings replace the league's standings of the season ending with the draft of the year,
+// the league's season if unset. Without ranks they are ranked by points, wins and losses.
+// userId is the acting commissioner or admin
+type StandingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeagueID  string      `protobuf:"bytes,1,opt,name=leagueID,proto3" json:"leagueID,omitempty"`
+	Year      int32       `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	UserId    string      `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	Standings []*Standing `protobuf:"bytes,4,rep,name=standings,proto3" json:"standings,omitempty"`
+}
+
+func (x *StandingsRequest) Reset() {
+	*x = StandingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingsRequest) ProtoMessage() {}
+
+func (x *StandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingsRequest.ProtoReflect.Descriptor instead.
+func (*StandingsRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *StandingsRequest) GetLeagueID() string {
+	if x != nil {
+		return x.LeagueID
+	}
+	return ""
+}
+
+func (x *StandingsRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *StandingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StandingsRequest) GetStandings() []*Standing {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+type GetStandingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeagueID string `protobuf:"bytes,1,opt,name=leagueID,proto3" json:"leagueID,omitempty"`
+	Year     int32  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+}
+
+func (x *GetStandingsRequest) Reset() {
+	*x = GetStandingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStandingsRequest) ProtoMessage() {}
+
+func (x *GetStandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStandingsRequest.ProtoReflect.Descriptor instead.
+func (*GetStandingsRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetStandingsRequest) GetLeagueID() string {
+	if x != nil {
+		return x.LeagueID
+	}
+	return ""
+}
+
+func (x *GetStandingsRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type StandingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int64       `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string      `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Year   int32       `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	Result []*Standing `protobuf:"bytes,4,rep,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *StandingsResponse) Reset() {
+	*x = StandingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StandingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingsResponse) ProtoMessage() {}
+
+func (x *StandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingsResponse.ProtoReflect.Descriptor instead.
+func (*StandingsResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *StandingsResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *StandingsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *StandingsResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *StandingsResponse) GetResult() []*Standing {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// standingsWeight between 0 and 1 weighs the standings against the prospect pipeline, 0.5 if unset
+type PowerRankingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeagueID        string  `protobuf:"bytes,1,opt,name=leagueID,proto3" json:"leagueID,omitempty"`
+	Year            int32   `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	StandingsWeight float64 `protobuf:"fixed64,3,opt,name=standingsWeight,proto3" json:"standingsWeight,omitempty"`
+}
+
+func (x *PowerRankingsRequest) Reset() {
+	*x = PowerRankingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PowerRankingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerRankingsRequest) ProtoMessage() {}
+
+func (x *PowerRankingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerRankingsRequest.ProtoReflect.Descriptor instead.
+func (*PowerRankingsRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *PowerRankingsRequest) GetLeagueID() string {
+	if x != nil {
+		return x.LeagueID
+	}
+	return ""
+}
+
+func (x *PowerRankingsRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *PowerRankingsRequest) GetStandingsWeight() float64 {
+	if x != nil {
+		return x.StandingsWeight
+	}
+	return 0
+}
+
+// the scores are percentages of the league's best, standingsScore of the most points and
+// pipelineScore of the most valuable prospects. score combines both by the weight
+type PowerRanking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank           int32   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	FranchiseID    string  `protobuf:"bytes,2,opt,name=franchiseID,proto3" json:"franchiseID,omitempty"`
+	FranchiseName  string  `protobuf:"bytes,3,opt,name=franchiseName,proto3" json:"franchiseName,omitempty"`
+	Score          float64 `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	StandingsScore float64 `protobuf:"fixed64,5,opt,name=standingsScore,proto3" json:"standingsScore,omitempty"`
+	PipelineScore  float64 `protobuf:"fixed64,6,opt,name=pipelineScore,proto3" json:"pipelineScore,omitempty"`
+	PipelineValue  float64 `protobuf:"fixed64,7,opt,name=pipelineValue,proto3" json:"pipelineValue,omitempty"`
+	// unset for franchises without standing
+	StandingsRank int32 `protobuf:"varint,8,opt,name=standingsRank,proto3" json:"standingsRank,omitempty"`
+}
+
+func (x *PowerRanking) Reset() {
+	*x = PowerRanking{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PowerRanking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerRanking) ProtoMessage() {}
+
+func (x *PowerRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerRanking.ProtoReflect.Descriptor instead.
+func (*PowerRanking) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *PowerRanking) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PowerRanking) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *PowerRanking) GetFranchiseName() string {
+	if x != nil {
+		return x.FranchiseName
+	}
+	return ""
+}
+
+func (x *PowerRanking) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PowerRanking) GetStandingsScore() float64 {
+	if x != nil {
+		return x.StandingsScore
+	}
+	return 0
+}
+
+func (x *PowerRanking) GetPipelineScore() float64 {
+	if x != nil {
+		return x.PipelineScore
+	}
+	return 0
+}
+
+func (x *PowerRanking) GetPipelineValue() float64 {
+	if x != nil {
+		return x.PipelineValue
+	}
+	return 0
+}
+
+func (x *PowerRanking) GetStandingsRank() int32 {
+	if x != nil {
+		return x.StandingsRank
+	}
+	return 0
+}
+
+type PowerRankingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int64           `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Year   int32           `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	Result []*PowerRanking `protobuf:"bytes,4,rep,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *PowerRankingsResponse) Reset() {
+	*x = PowerRankingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PowerRankingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerRankingsResponse) ProtoMessage() {}
+
+func (x *PowerRankingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerRankingsResponse.ProtoReflect.Descriptor instead.
+func (*PowerRankingsResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *PowerRankingsResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PowerRankingsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PowerRankingsResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *PowerRankingsResponse) GetResult() []*PowerRanking {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetPicksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        int64   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string  `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Picks         []*Pick `protobuf:"bytes,3,rep,name=picks,proto3" json:"picks,omitempty"`
+	NextPageToken string  `protobuf:"bytes,4,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (x *GetPicksResponse) Reset() {
+	*x = GetPicksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPicksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPicksResponse) ProtoMessage() {}
+
+func (x *GetPicksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPicksResponse.ProtoReflect.Descriptor instead.
+func (*GetPicksResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetPicksResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetPicksResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetPicksResponse) GetPicks() []*Pick {
+	if x != nil {
+		return x.Picks
+	}
+	return nil
+}
+
+func (x *GetPicksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateOrUpdatePick struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Franchise       string `protobuf:"bytes,1,opt,name=Franchise,proto3" json:"Franchise,omitempty"`
+	FranchiseID     string `protobuf:"bytes,2,opt,name=FranchiseID,proto3" json:"FranchiseID,omitempty"`
+	Year            string `protobuf:"bytes,3,opt,name=Year,proto3" json:"Year,omitempty"`
+	LotteryPosition int32  `protobuf:"varint,4,opt,name=LotteryPosition,proto3" json:"LotteryPosition,omitempty"`
+	DraftYear       int32  `protobuf:"varint,5,opt,name=draftYear,proto3" json:"draftYear,omitempty"`
+}
+
+func (x *CreateOrUpdatePick) Reset() {
+	*x = CreateOrUpdatePick{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateOrUpdatePick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrUpdatePick) ProtoMessage() {}
+
+func (x *CreateOrUpdatePick) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrUpdatePick.ProtoReflect.Descriptor instead.
+func (*CreateOrUpdatePick) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CreateOrUpdatePick) GetFranchise() string {
+	if x != nil {
+		return x.Franchise
+	}
+	return ""
+}
+
+func (x *CreateOrUpdatePick) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *CreateOrUpdatePick) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *CreateOrUpdatePick) GetLotteryPosition() int32 {
+	if x != nil {
+		return x.LotteryPosition
+	}
+	return 0
+}
+
+func (x *CreateOrUpdatePick) GetDraftYear() int32 {
+	if x != nil {
+		return x.DraftYear
+	}
+	return 0
+}
+
+// sets the draft positions of the league's franchises. Only the commissioner or admin may set them.
+type CreateOrUpdatePicksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeagueID string                `protobuf:"bytes,1,opt,name=LeagueID,proto3" json:"LeagueID,omitempty"`
+	Picks    []*CreateOrUpdatePick `protobuf:"bytes,2,rep,name=picks,proto3" json:"picks,omitempty"`
+	UserId   string                `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *CreateOrUpdatePicksRequest) Reset() {
+	*x = CreateOrUpdatePicksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateOrUpdatePicksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrUpdatePicksRequest) ProtoMessage() {}
+
+func (x *CreateOrUpdatePicksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrUpdatePicksRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrUpdatePicksRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateOrUpdatePicksRequest) GetLeagueID() string {
+	if x != nil {
+		return x.LeagueID
+	}
+	return ""
+}
+
+func (x *CreateOrUpdatePicksRequest) GetPicks() []*CreateOrUpdatePick {
+	if x != nil {
+		return x.Picks
+	}
+	return nil
+}
+
+func (x *CreateOrUpdatePicksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// voids the pick, it is kept for the history. Only the commissioner or admin may forfeit picks.
+type ForfeitPickRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PickID string `protobuf:"bytes,1,opt,name=pickID,proto3" json:"pickID,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	UserId string `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *ForfeitPickRequest) Reset() {
+	*x = ForfeitPickRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForfeitPickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForfeitPickRequest) ProtoMessage() {}
+
+func (x *ForfeitPickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForfeitPickRequest.ProtoReflect.Descriptor instead.
+func (*ForfeitPickRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ForfeitPickRequest) GetPickID() string {
+	if x != nil {
+		return x.PickID
+	}
+	return ""
+}
+
+func (x *ForfeitPickRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ForfeitPickRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// issues an extra pick to the franchise in the slot of the round, at the end of the round if the slot is zero.
+// Only the commissioner or admin may issue picks.
+type SupplementalPickRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FranchiseID string `protobuf:"bytes,1,opt,name=franchiseID,proto3" json:"franchiseID,omitempty"`
+	Year        int32  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Round       int32  `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Slot        int32  `protobuf:"varint,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	Reason      string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	UserId      string `protobuf:"bytes,6,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *SupplementalPickRequest) Reset() {
+	*x = SupplementalPickRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SupplementalPickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupplementalPickRequest) ProtoMessage() {}
+
+func (x *SupplementalPickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupplementalPickRequest.ProtoReflect.Descriptor instead.
+func (*SupplementalPickRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SupplementalPickRequest) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *SupplementalPickRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *SupplementalPickRequest) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *SupplementalPickRequest) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *SupplementalPickRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SupplementalPickRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type PickResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int64  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result *Pick  `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *PickResponse) Reset() {
+	*x = PickResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickResponse) ProtoMessage() {}
+
+func (x *PickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickResponse.ProtoReflect.Descriptor instead.
+func (*PickResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PickResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PickResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PickResponse) GetResult() *Pick {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetPicksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeagueID    string     `protobuf:"bytes,1,opt,name=LeagueID,proto3" json:"LeagueID,omitempty"`
+	FranchiseID string     `protobuf:"bytes,2,opt,name=FranchiseID,proto3" json:"FranchiseID,omitempty"`
+	Year        string     `protobuf:"bytes,3,opt,name=Year,proto3" json:"Year,omitempty"`
+	PageSize    int32      `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken   string     `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	OrderBy     string     `protobuf:"bytes,6,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	Drafted     BoolFilter `protobuf:"varint,7,opt,name=drafted,proto3,enum=fantasy.BoolFilter" json:"drafted,omitempty"`
+	DraftYear   int32      `protobuf:"varint,8,opt,name=draftYear,proto3" json:"draftYear,omitempty"`
+}
+
+func (x *GetPicksRequest) Reset() {
+	*x = GetPicksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPicksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPicksRequest) ProtoMessage() {}
+
+func (x *GetPicksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPicksRequest.ProtoReflect.Descriptor instead.
+func (*GetPicksRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetPicksRequest) GetLeagueID() string {
+	if x != nil {
+		return x.LeagueID
+	}
+	return ""
+}
+
+func (x *GetPicksRequest) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *GetPicksRequest) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *GetPicksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetPicksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetPicksRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetPicksRequest) GetDrafted() BoolFilter {
+	if x != nil {
+		return x.Drafted
+	}
+	return BoolFilter_BOOL_FILTER_ANY
+}
+
+func (x *GetPicksRequest) GetDraftYear() int32 {
+	if x != nil {
+		return x.DraftYear
+	}
+	return 0
+}
+
+type DraftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeagueID    string `protobuf:"bytes,1,opt,name=LeagueID,proto3" json:"LeagueID,omitempty"`
+	FranchiseID string `protobuf:"bytes,2,opt,name=FranchiseID,proto3" json:"FranchiseID,omitempty"`
+	ProspectID  string `protobuf:"bytes,3,opt,name=ProspectID,proto3" json:"ProspectID,omitempty"`
+	PickID      string `protobuf:"bytes,4,opt,name=PickID,proto3" json:"PickID,omitempty"`
+	UserId      string `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *DraftRequest) Reset() {
+	*x = DraftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftRequest) ProtoMessage() {}
+
+func (x *DraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftRequest.ProtoReflect.Descriptor instead.
+func (*DraftRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DraftRequest) GetLeagueID() string {
+	if x != nil {
+		return x.LeagueID
+	}
+	return ""
+}
+
+func (x *DraftRequest) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *DraftRequest) GetProspectID() string {
+	if x != nil {
+		return x.ProspectID
+	}
+	return ""
+}
+
+func (x *DraftRequest) GetPickID() string {
+	if x != nil {
+		return x.PickID
+	}
+	return ""
+}
+
+func (x *DraftRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// the undrafted prospects meeting the league's eligibility rules for the draft of the year,
+// the league's season if unset
+type EligibleProspectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeagueID  string `protobuf:"bytes,1,opt,name=leagueID,proto3" json:"leagueID,omitempty"`
+	Year      int32  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	PageSize  int32  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	OrderBy   string `protobuf:"bytes,5,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+}
+
+func (x *EligibleProspectsRequest) Reset() {
+	*x = EligibleProspectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EligibleProspectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EligibleProspectsRequest) ProtoMessage() {}
+
+func (x *EligibleProspectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EligibleProspectsRequest.ProtoReflect.Descriptor instead.
+func (*EligibleProspectsRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *EligibleProspectsRequest) GetLeagueID() string {
+	if x != nil {
+		return x.LeagueID
+	}
+	return ""
+}
+
+func (x *EligibleProspectsRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *EligibleProspectsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *EligibleProspectsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *EligibleProspectsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type TradePayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FranchiseID      string             `protobuf:"bytes,1,opt,name=FranchiseID,proto3" json:"FranchiseID,omitempty"`
+	Picks            []string           `protobuf:"bytes,2,rep,name=Picks,proto3" json:"Picks,omitempty"`
+	Prospects        []string           `protobuf:"bytes,3,rep,name=Prospects,proto3" json:"Prospects,omitempty"`
+	ConditionalPicks []*ConditionalPick `protobuf:"bytes,4,rep,name=conditionalPicks,proto3" json:"conditionalPicks,omitempty"`
+	SwapRights       []*SwapRight       `protobuf:"bytes,5,rep,name=swapRights,proto3" json:"swapRights,omitempty"`
+}
+
+func (x *TradePayload) Reset() {
+	*x = TradePayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradePayload) ProtoMessage() {}
+
+func (x *TradePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradePayload.ProtoReflect.Descriptor instead.
+func (*TradePayload) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *TradePayload) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *TradePayload) GetPicks() []string {
+	if x != nil {
+		return x.Picks
+	}
+	return nil
+}
+
+func (x *TradePayload) GetProspects() []string {
+	if x != nil {
+		return x.Prospects
+	}
+	return nil
+}
+
+func (x *TradePayload) GetConditionalPicks() []*ConditionalPick {
+	if x != nil {
+		return x.ConditionalPicks
+	}
+	return nil
+}
+
+func (x *TradePayload) GetSwapRights() []*SwapRight {
+	if x != nil {
+		return x.SwapRights
+	}
+	return nil
+}
+
+// grants the other franchise the right to swap its pick with the pick of this franchise of the same draft and round
+type SwapRight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PickID     string `protobuf:"bytes,1,opt,name=pickID,proto3" json:"pickID,omitempty"`
+	SwapPickID string `protobuf:"bytes,2,opt,name=swapPickID,proto3" json:"swapPickID,omitempty"`
+}
+
+func (x *SwapRight) Reset() {
+	*x = SwapRight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapRight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapRight) ProtoMessage() {}
+
+func (x *SwapRight) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapRight.ProtoReflect.Descriptor instead.
+func (*SwapRight) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SwapRight) GetPickID() string {
+	if x != nil {
+		return x.PickID
+	}
+	return ""
+}
+
+func (x *SwapRight) GetSwapPickID() string {
+	if x != nil {
+		return x.SwapPickID
+	}
+	return ""
+}
+
+// a pick protected in the top positions of its round, the substitute pick is optional
+type ConditionalPick struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PickID           string `protobuf:"bytes,1,opt,name=pickID,proto3" json:"pickID,omitempty"`
+	ProtectedTop     int32  `protobuf:"varint,2,opt,name=protectedTop,proto3" json:"protectedTop,omitempty"`
+	SubstitutePickID string `protobuf:"bytes,3,opt,name=substitutePickID,proto3" json:"substitutePickID,omitempty"`
+}
+
+func (x *ConditionalPick) Reset() {
+	*x = ConditionalPick{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConditionalPick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConditionalPick) ProtoMessage() {}
+
+func (x *ConditionalPick) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConditionalPick.ProtoReflect.Descriptor instead.
+func (*ConditionalPick) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ConditionalPick) GetPickID() string {
+	if x != nil {
+		return x.PickID
+	}
+	return ""
+}
+
+func (x *ConditionalPick) GetProtectedTop() int32 {
+	if x != nil {
+		return x.ProtectedTop
+	}
+	return 0
+}
+
+func (x *ConditionalPick) GetSubstitutePickID() string {
+	if x != nil {
+		return x.SubstitutePickID
+	}
+	return ""
+}
+
+// the acting user needs the trade permission for both franchises, each side gives only its own assets
+type TradeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	First  *TradePayload `protobuf:"bytes,1,opt,name=First,proto3" json:"First,omitempty"`
+	Second *TradePayload `protobuf:"bytes,2,opt,name=Second,proto3" json:"Second,omitempty"`
+	UserId string        `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *TradeRequest) Reset() {
+	*x = TradeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeRequest) ProtoMessage() {}
+
+func (x *TradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeRequest.ProtoReflect.Descriptor instead.
+func (*TradeRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *TradeRequest) GetFirst() *TradePayload {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *TradeRequest) GetSecond() *TradePayload {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+func (x *TradeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// the value of a traded asset on the pick value chart
+type AssetValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// pick, conditional pick, swap right or prospect
+	Kind        string  `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Description string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Value       float64 `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AssetValue) Reset() {
+	*x = AssetValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetValue) ProtoMessage() {}
+
+func (x *AssetValue) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetValue.ProtoReflect.Descriptor instead.
+func (*AssetValue) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *AssetValue) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *AssetValue) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AssetValue) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AssetValue) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// the assets a side gives, delta is the value it receives minus the value it gives
+type TradeSideValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FranchiseID string        `protobuf:"bytes,1,opt,name=franchiseID,proto3" json:"franchiseID,omitempty"`
+	Given       float64       `protobuf:"fixed64,2,opt,name=given,proto3" json:"given,omitempty"`
+	Received    float64       `protobuf:"fixed64,3,opt,name=received,proto3" json:"received,omitempty"`
+	Delta       float64       `protobuf:"fixed64,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	Assets      []*AssetValue `protobuf:"bytes,5,rep,name=assets,proto3" json:"assets,omitempty"`
+}
+
+func (x *TradeSideValue) Reset() {
+	*x = TradeSideValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeSideValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeSideValue) ProtoMessage() {}
+
+func (x *TradeSideValue) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeSideValue.ProtoReflect.Descriptor instead.
+func (*TradeSideValue) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *TradeSideValue) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *TradeSideValue) GetGiven() float64 {
+	if x != nil {
+		return x.Given
+	}
+	return 0
+}
+
+func (x *TradeSideValue) GetReceived() float64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *TradeSideValue) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *TradeSideValue) GetAssets() []*AssetValue {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+type TradeEvaluationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int64           `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	First  *TradeSideValue `protobuf:"bytes,3,opt,name=first,proto3" json:"first,omitempty"`
+	Second *TradeSideValue `protobuf:"bytes,4,opt,name=second,proto3" json:"second,omitempty"`
+}
+
+func (x *TradeEvaluationResponse) Reset() {
+	*x = TradeEvaluationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeEvaluationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeEvaluationResponse) ProtoMessage() {}
+
+func (x *TradeEvaluationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeEvaluationResponse.ProtoReflect.Descriptor instead.
+func (*TradeEvaluationResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *TradeEvaluationResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *TradeEvaluationResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TradeEvaluationResponse) GetFirst() *TradeSideValue {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *TradeEvaluationResponse) GetSecond() *TradeSideValue {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+// lists the franchise's prospect or pick with what it asks for, listing it again updates the note
+type TradeBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FranchiseID string `protobuf:"bytes,1,opt,name=franchiseID,proto3" json:"franchiseID,omitempty"`
+	ProspectID  string `protobuf:"bytes,2,opt,name=prospectID,proto3" json:"prospectID,omitempty"`
+	PickID      string `protobuf:"bytes,3,opt,name=pickID,proto3" json:"pickID,omitempty"`
+	Note        string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	UserId      string `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *TradeBlockRequest) Reset() {
+	*x = TradeBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeBlockRequest) ProtoMessage() {}
+
+func (x *TradeBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeBlockRequest.ProtoReflect.Descriptor instead.
+func (*TradeBlockRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *TradeBlockRequest) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *TradeBlockRequest) GetProspectID() string {
+	if x != nil {
+		return x.ProspectID
+	}
+	return ""
+}
+
+func (x *TradeBlockRequest) GetPickID() string {
+	if x != nil {
+		return x.PickID
+	}
+	return ""
+}
+
+func (x *TradeBlockRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *TradeBlockRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// position only matches prospects, draftYear and draftRound only match picks
+type ListTradeBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeagueID    string   `protobuf:"bytes,1,opt,name=leagueID,proto3" json:"leagueID,omitempty"`
+	FranchiseID string   `protobuf:"bytes,2,opt,name=franchiseID,proto3" json:"franchiseID,omitempty"`
+	Position    Position `protobuf:"varint,3,opt,name=position,proto3,enum=fantasy.Position" json:"position,omitempty"`
+	DraftYear   int32    `protobuf:"varint,4,opt,name=draftYear,proto3" json:"draftYear,omitempty"`
+	DraftRound  int32    `protobuf:"varint,5,opt,name=draftRound,proto3" json:"draftRound,omitempty"`
+}
+
+func (x *ListTradeBlockRequest) Reset() {
+	*x = ListTradeBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTradeBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTradeBlockRequest) ProtoMessage() {}
+
+func (x *ListTradeBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTradeBlockRequest.ProtoReflect.Descriptor instead.
+func (*ListTradeBlockRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListTradeBlockRequest) GetLeagueID() string {
+	if x != nil {
+		return x.LeagueID
+	}
+	return ""
+}
+
+func (x *ListTradeBlockRequest) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *ListTradeBlockRequest) GetPosition() Position {
+	if x != nil {
+		return x.Position
+	}
+	return Position_POSITION_UNSPECIFIED
+}
+
+func (x *ListTradeBlockRequest) GetDraftYear() int32 {
+	if x != nil {
+		return x.DraftYear
+	}
+	return 0
+}
+
+func (x *ListTradeBlockRequest) GetDraftRound() int32 {
+	if x != nil {
+		return x.DraftRound
+	}
+	return 0
+}
+
+type TradeBlockEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID            string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	FranchiseID   string                 `protobuf:"bytes,2,opt,name=franchiseID,proto3" json:"franchiseID,omitempty"`
+	FranchiseName string                 `protobuf:"bytes,3,opt,name=franchiseName,proto3" json:"franchiseName,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	Prospect      *Prospect              `protobuf:"bytes,5,opt,name=prospect,proto3" json:"prospect,omitempty"`
+	Pick          *Pick                  `protobuf:"bytes,6,opt,name=pick,proto3" json:"pick,omitempty"`
+	ListedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=listedAt,proto3" json:"listedAt,omitempty"`
+}
+
+func (x *TradeBlockEntry) Reset() {
+	*x = TradeBlockEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeBlockEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeBlockEntry) ProtoMessage() {}
+
+func (x *TradeBlockEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeBlockEntry.ProtoReflect.Descriptor instead.
+func (*TradeBlockEntry) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *TradeBlockEntry) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *TradeBlockEntry) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *TradeBlockEntry) GetFranchiseName() string {
+	if x != nil {
+		return x.FranchiseName
+	}
+	return ""
+}
+
+func (x *TradeBlockEntry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *TradeBlockEntry) GetProspect() *Prospect {
+	if x != nil {
+		return x.Prospect
+	}
+	return nil
+}
+
+func (x *TradeBlockEntry) GetPick() *Pick {
+	if x != nil {
+		return x.Pick
+	}
+	return nil
+}
+
+func (x *TradeBlockEntry) GetListedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ListedAt
+	}
+	return nil
+}
+
+// what a franchise is looking for in trades
+type TradeProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FranchiseID   string `protobuf:"bytes,1,opt,name=franchiseID,proto3" json:"franchiseID,omitempty"`
+	FranchiseName string `protobuf:"bytes,2,opt,name=franchiseName,proto3" json:"franchiseName,omitempty"`
+	LookingFor    string `protobuf:"bytes,3,opt,name=lookingFor,proto3" json:"lookingFor,omitempty"`
+}
+
+func (x *TradeProfile) Reset() {
+	*x = TradeProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeProfile) ProtoMessage() {}
+
+func (x *TradeProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeProfile.ProtoReflect.Descriptor instead.
+func (*TradeProfile) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *TradeProfile) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *TradeProfile) GetFranchiseName() string {
+	if x != nil {
+		return x.FranchiseName
+	}
+	return ""
+}
+
+func (x *TradeProfile) GetLookingFor() string {
+	if x != nil {
+		return x.LookingFor
+	}
+	return ""
+}
+
+type TradeBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   int64              `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error    string             `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result   []*TradeBlockEntry `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
+	Profiles []*TradeProfile    `protobuf:"bytes,4,rep,name=profiles,proto3" json:"profiles,omitempty"`
+}
+
+func (x *TradeBlockResponse) Reset() {
+	*x = TradeBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeBlockResponse) ProtoMessage() {}
+
+func (x *TradeBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeBlockResponse.ProtoReflect.Descriptor instead.
+func (*TradeBlockResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *TradeBlockResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *TradeBlockResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TradeBlockResponse) GetResult() []*TradeBlockEntry {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *TradeBlockResponse) GetProfiles() []*TradeProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type TradeProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FranchiseID string `protobuf:"bytes,1,opt,name=franchiseID,proto3" json:"franchiseID,omitempty"`
+	LookingFor  string `protobuf:"bytes,2,opt,name=lookingFor,proto3" json:"lookingFor,omitempty"`
+	UserId      string `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *TradeProfileRequest) Reset() {
+	*x = TradeProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeProfileRequest) ProtoMessage() {}
+
+func (x *TradeProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeProfileRequest.ProtoReflect.Descriptor instead.
+func (*TradeProfileRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *TradeProfileRequest) GetFranchiseID() string {
+	if x != nil {
+		return x.FranchiseID
+	}
+	return ""
+}
+
+func (x *TradeProfileRequest) GetLookingFor() string {
+	if x != nil {
+		return x.LookingFor
+	}
+	return ""
+}
+
+func (x *TradeProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// a prospect on the private watchlist of the user, rank 0 is unranked
+type WatchlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     string   `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ProspectID string   `protobuf:"bytes,2,opt,name=prospectID,proto3" json:"prospectID,omitempty"`
+	Note       string   `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	Tags       []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Rank       int32    `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+}
+
+func (x *WatchlistRequest) Reset() {
+	*x = WatchlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistRequest) ProtoMessage() {}
+
+func (x *WatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistRequest.ProtoReflect.Descriptor instead.
+func (*WatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *WatchlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WatchlistRequest) GetProspectID() string {
+	if x != nil {
+		return x.ProspectID
+	}
+	return ""
+}
+
+func (x *WatchlistRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *WatchlistRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *WatchlistRequest) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+// tag only returns the entries with the tag
+type GetWatchlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Tag    string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *GetWatchlistRequest) Reset() {
+	*x = GetWatchlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWatchlistRequest) ProtoMessage() {}
+
+func (x *GetWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*GetWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetWatchlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetWatchlistRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type WatchlistEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ProspectID string                 `protobuf:"bytes,2,opt,name=prospectID,proto3" json:"prospectID,omitempty"`
+	Note       string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	Tags       []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Rank       int32                  `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	AddedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=addedAt,proto3" json:"addedAt,omitempty"`
+	// the prospect, not set on the entry of a prospect
+	Prospect *Prospect `protobuf:"bytes,7,opt,name=prospect,proto3" json:"prospect,omitempty"`
+}
+
+func (x *WatchlistEntry) Reset() {
+	*x = WatchlistEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistEntry) ProtoMessage() {}
+
+func (x *WatchlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistEntry.ProtoReflect.Descriptor instead.
+func (*WatchlistEntry) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *WatchlistEntry) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *WatchlistEntry) GetProspectID() string {
+	if x != nil {
+		return x.ProspectID
+	}
+	return ""
+}
+
+func (x *WatchlistEntry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *WatchlistEntry) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *WatchlistEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *WatchlistEntry) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+func (x *WatchlistEntry) GetProspect() *Prospect {
+	if x != nil {
+		return x.Prospect
+	}
+	return nil
+}
+
+type WatchlistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int64             `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string            `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result []*WatchlistEntry `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *WatchlistResponse) Reset() {
+	*x = WatchlistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistResponse) ProtoMessage() {}
+
+func (x *WatchlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistResponse.ProtoReflect.Descriptor instead.
+func (*WatchlistResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *WatchlistResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *WatchlistResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WatchlistResponse) GetResult() []*WatchlistEntry {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// season is the year the season starts, 0 returns all seasons
+type GetProspectStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProspectID string `protobuf:"bytes,1,opt,name=prospectID,proto3" json:"prospectID,omitempty"`
+	Season     int32  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+}
+
+func (x *GetProspectStatsRequest) Reset() {
+	*x = GetProspectStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProspectStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProspectStatsRequest) ProtoMessage() {}
+
+func (x *GetProspectStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProspectStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetProspectStatsRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetProspectStatsRequest) GetProspectID() string {
+	if x != nil {
+		return x.ProspectID
+	}
+	return ""
+}
+
+func (x *GetProspectStatsRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+// savePercentage and goalsAgainstAverage are only set for goalies
+type ProspectSeasonStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                  string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Season              int32   `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	SeasonLabel         string  `protobuf:"bytes,3,opt,name=seasonLabel,proto3" json:"seasonLabel,omitempty"`
+	Level               string  `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`
+	Team                string  `protobuf:"bytes,5,opt,name=team,proto3" json:"team,omitempty"`
+	GamesPlayed         int32   `protobuf:"varint,6,opt,name=gamesPlayed,proto3" json:"gamesPlayed,omitempty"`
+	Goals               int32   `protobuf:"varint,7,opt,name=goals,proto3" json:"goals,omitempty"`
+	Assists             int32   `protobuf:"varint,8,opt,name=assists,proto3" json:"assists,omitempty"`
+	Points              int32   `protobuf:"varint,9,opt,name=points,proto3" json:"points,omitempty"`
+	PenaltyMinutes      int32   `protobuf:"varint,10,opt,name=penaltyMinutes,proto3" json:"penaltyMinutes,omitempty"`
+	SavePercentage      float64 `protobuf:"fixed64,11,opt,name=savePercentage,proto3" json:"savePercentage,omitempty"`
+	GoalsAgainstAverage float64 `protobuf:"fixed64,12,opt,name=goalsAgainstAverage,proto3" json:"goalsAgainstAverage,omitempty"`
+}
+
+func (x *ProspectSeasonStats) Reset() {
+	*x = ProspectSeasonStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProspectSeasonStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProspectSeasonStats) ProtoMessage() {}
+
+func (x *ProspectSeasonStats) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProspectSeasonStats.ProtoReflect.Descriptor instead.
+func (*ProspectSeasonStats) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ProspectSeasonStats) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *ProspectSeasonStats) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *ProspectSeasonStats) GetSeasonLabel() string {
+	if x != nil {
+		return x.SeasonLabel
+	}
+	return ""
+}
+
+func (x *ProspectSeasonStats) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *ProspectSeasonStats) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *ProspectSeasonStats) GetGamesPlayed() int32 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+func (x *ProspectSeasonStats) GetGoals() int32 {
+	if x != nil {
+		return x.Goals
+	}
+	return 0
+}
+
+func (x *ProspectSeasonStats) GetAssists() int32 {
+	if x != nil {
+		return x.Assists
+	}
+	return 0
+}
+
+func (x *ProspectSeasonStats) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *ProspectSeasonStats) GetPenaltyMinutes() int32 {
+	if x != nil {
+		return x.PenaltyMinutes
+	}
+	return 0
+}
+
+func (x *ProspectSeasonStats) GetSavePercentage() float64 {
+	if x != nil {
+		return x.SavePercentage
+	}
+	return 0
+}
+
+func (x *ProspectSeasonStats) GetGoalsAgainstAverage() float64 {
+	if x != nil {
+		return x.GoalsAgainstAverage
+	}
+	return 0
+}
+
+type ProspectStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   int64                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error    string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Prospect *Prospect              `protobuf:"bytes,3,opt,name=prospect,proto3" json:"prospect,omitempty"`
+	Result   []*ProspectSeasonStats `protobuf:"bytes,4,rep,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ProspectStatsResponse) Reset() {
+	*x = ProspectStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProspectStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProspectStatsResponse) ProtoMessage() {}
+
+func (x *ProspectStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProspectStatsResponse.ProtoReflect.Descriptor instead.
+func (*ProspectStatsResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ProspectStatsResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ProspectStatsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ProspectStatsResponse) GetProspect() *Prospect {
+	if x != nil {
+		return x.Prospect
+	}
+	return nil
+}
+
+func (x *ProspectStatsResponse) GetResult() []*ProspectSeasonStats {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type TextSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text   string          `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Filter *ProspectFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// only return prospects that are not drafted in this league
+	AvailableInLeagueID string `protobuf:"bytes,3,opt,name=availableInLeagueID,proto3" json:"availableInLeagueID,omitempty"`
+	PageSize            int32  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// annotates the prospects with the watchlist entries of the user
+	WatchlistUserId string `protobuf:"bytes,5,opt,name=watchlistUserId,proto3" json:"watchlistUserId,omitempty"`
+}
+
+func (x *TextSearchRequest) Reset() {
+	*x = TextSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextSearchRequest) ProtoMessage() {}
+
+func (x *TextSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextSearchRequest.ProtoReflect.Descriptor instead.
+func (*TextSearchRequest) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *TextSearchRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TextSearchRequest) GetFilter() *ProspectFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *TextSearchRequest) GetAvailableInLeagueID() string {
+	if x != nil {
+		return x.AvailableInLeagueID
+	}
+	return ""
+}
+
+func (x *TextSearchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *TextSearchRequest) GetWatchlistUserId() string {
+	if x != nil {
+		return x.WatchlistUserId
+	}
+	return ""
+}
+
+type ProspectsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        int64       `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string      `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Prospects     []*Prospect `protobuf:"bytes,3,rep,name=prospects,proto3" json:"prospects,omitempty"`
+	NextPageToken string      `protobuf:"bytes,4,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (x *ProspectsResponse) Reset() {
+	*x = ProspectsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_pb_fantasy_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProspectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProspectsResponse) ProtoMessage() {}
+
+func (x *ProspectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_pb_fantasy_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProspectsResponse.ProtoReflect.Descriptor instead.
+func (*ProspectsResponse) Descriptor() ([]byte, []int) {
+	return file_service_pb_fantasy_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ProspectsResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ProspectsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ProspectsResponse) GetProspects() []*Prospect {
+	if x != nil {
+		return x.Prospects
+	}
+	return nil
+}
+
+func (x *ProspectsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_service_pb_fantasy_proto protoreflect.FileDescriptor
+
+var file_service_pb_fantasy_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x62, 0x2f, 0x66, 0x61, 0x6e,
+	0x74, 0x61, 0x73, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdc, 0x05, 0x0a, 0x06, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x44, 0x12,
+	0x22, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x4d, 0x61, 0x78, 0x46, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x4d, 0x61, 0x78, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x73, 0x12, 0x2c, 0x0a, 0x11, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x47, 0x6f, 0x61, 0x6c, 0x69, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x47, 0x6f, 0x61, 0x6c, 0x69, 0x65, 0x12,
+	0x2c, 0x0a, 0x11, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x53, 0x6b,
+	0x61, 0x74, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x53, 0x6b, 0x61, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a,
+	0x0b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12,
+	0x32, 0x0a, 0x0a, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x73, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x0a, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69,
+	0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x49,
+	0x44, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x50,
+	0x69, 0x63, 0x6b, 0x59, 0x65, 0x61, 0x72, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x50, 0x69, 0x63, 0x6b, 0x59, 0x65, 0x61, 0x72, 0x73, 0x12,
+	0x35, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x13,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x0b, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x22, 0xf6, 0x01, 0x0a, 0x09, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49,
+	0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49,
+	0x44, 0x12, 0x2f, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50,
+	0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x22, 0xcb, 0x07, 0x0a,
+	0x08, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x75, 0x6c,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x75, 0x6c,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x4e, 0x68, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x4e, 0x68, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x4e, 0x68, 0x6c,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x4e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x42, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x42, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x4e,
+	0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x4e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x30, 0x0a, 0x13, 0x4e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63,
+	0x6b, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x4e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72,
+	0x61, 0x6c, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x4e, 0x68, 0x6c, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x6e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x4e, 0x68, 0x6c,
+	0x50, 0x69, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x46,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12, 0x21, 0x0a,
+	0x04, 0x50, 0x69, 0x63, 0x6b, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x69, 0x63, 0x6b, 0x52, 0x04, 0x50, 0x69, 0x63, 0x6b,
+	0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x2d,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a,
+	0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x49, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x49, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x6e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e,
+	0x4e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x08, 0x6e, 0x68, 0x6c, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x77, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73,
+	0x79, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x77, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x0b,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x83, 0x06, 0x0a, 0x04, 0x50,
+	0x69, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x44, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x2a, 0x0a, 0x10, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x4f, 0x76,
+	0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x12, 0x2a, 0x0a,
+	0x10, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69,
+	0x63, 0x6b, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x6f,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50,
+	0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x4c, 0x61, 0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4c, 0x61, 0x73, 0x74,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x69, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x69, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c,
+	0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x69, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x69, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73,
+	0x79, 0x2e, 0x50, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x73,
+	0x77, 0x61, 0x70, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e,
+	0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x69, 0x63, 0x6b, 0x53, 0x77, 0x61, 0x70, 0x52, 0x05, 0x73,
+	0x77, 0x61, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09,
+	0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x66, 0x6f,
+	0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x66, 0x6f, 0x72,
+	0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x83, 0x03, 0x0a, 0x0d, 0x50, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x72,
+	0x6f, 0x6d, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x66, 0x72, 0x6f, 0x6d, 0x46, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x69, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x66, 0x72, 0x6f, 0x6d, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x46, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x46, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x74, 0x6f, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54,
+	0x6f, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x54, 0x6f, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69,
+	0x74, 0x75, 0x74, 0x65, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x65, 0x50, 0x69, 0x63, 0x6b,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x76, 0x0a, 0x16, 0x50, 0x69, 0x63, 0x6b, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xfc,
+	0x02, 0x0a, 0x08, 0x50, 0x69, 0x63, 0x6b, 0x53, 0x77, 0x61, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x68,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x46, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x13, 0x68, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x46, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x68,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x12,
+	0x2e, 0x0a, 0x12, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x6f, 0x72, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x6f, 0x72, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x32, 0x0a, 0x14, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x6f, 0x72, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x69, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x6f, 0x72, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x6f, 0x72, 0x50, 0x69,
+	0x63, 0x6b, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x6f, 0x72, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6c, 0x0a,
+	0x11, 0x50, 0x69, 0x63, 0x6b, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x69, 0x63, 0x6b, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x78, 0x0a, 0x08, 0x4e,
+	0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x69, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x69, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72,
+	0x61, 0x6c, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x69, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x69, 0x63, 0x6b, 0x49, 0x6e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x6c, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72,
+	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x10, 0x44, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0xbe, 0x01, 0x0a, 0x10,
+	0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x64, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x52,
+	0x0a, 0x64, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x6e, 0x64, 0x72, 0x61, 0x66, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x6e, 0x64, 0x72, 0x61, 0x66,
+	0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa1, 0x01, 0x0a,
+	0x09, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x50, 0x69, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x4f, 0x76, 0x65,
+	0x72, 0x61, 0x6c, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63,
+	0x6b, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x22, 0x59, 0x0a, 0x0f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa1, 0x02, 0x0a, 0x0e,
+	0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x22,
+	0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x68, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x68, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x22, 0x0a, 0x0c,
+	0x6e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x12, 0x2d,
+	0x0a, 0x07, 0x64, 0x72, 0x61, 0x66, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x64, 0x72, 0x61, 0x66, 0x74, 0x65, 0x64, 0x12, 0x31, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x12, 0x2d, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xdf, 0x04, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x59,
+	0x65, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x6f, 0x75, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x4d, 0x61, 0x78,
+	0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x4d, 0x61, 0x78, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x47, 0x6f, 0x61, 0x6c, 0x69, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x47, 0x6f, 0x61, 0x6c, 0x69,
+	0x65, 0x12, 0x2c, 0x0a, 0x11, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x53, 0x6b, 0x61, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x53, 0x6b, 0x61, 0x74, 0x65, 0x72, 0x12,
+	0x20, 0x0a, 0x0b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x50, 0x69, 0x63,
+	0x6b, 0x59, 0x65, 0x61, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x66, 0x75,
+	0x74, 0x75, 0x72, 0x65, 0x50, 0x69, 0x63, 0x6b, 0x59, 0x65, 0x61, 0x72, 0x73, 0x12, 0x35, 0x0a,
+	0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x2e, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x0b, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x22, 0x6d, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x67,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61,
+	0x73, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x06, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x3d, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x54, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x88, 0x02, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x79, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72,
+	0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0xbb, 0x01, 0x0a, 0x15, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65,
+	0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65,
+	0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x67,
+	0x0a, 0x0e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x68, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61,
+	0x73, 0x79, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x5a, 0x0a, 0x0e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x64, 0x22, 0x67, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0x91, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x65,
+	0x61, 0x67, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x82, 0x01, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22,
+	0x6a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x27, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x4c, 0x65, 0x61,
+	0x67, 0x75, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x9d, 0x01, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x2e, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x38, 0x0a, 0x1e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73,
+	0x65, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x22, 0x85, 0x01, 0x0a, 0x1f, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73,
+	0x65, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66,
+	0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0xd4, 0x01, 0x0a, 0x10, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x59, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x6f, 0x75,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x4c,
+	0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c,
+	0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x75, 0x6e, 0x64,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x63, 0x0a, 0x11, 0x46, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b,
+	0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x64, 0x22, 0xd8,
+	0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65,
+	0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61,
+	0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x70, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x2a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x69, 0x73, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x0e,
+	0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x2d,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12,
+	0x24, 0x0a, 0x0d, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x7a, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61,
+	0x73, 0x79, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x22, 0xa3, 0x02, 0x0a, 0x0b, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x6f, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x67, 0x6f, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x6b, 0x61, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x6b,
+	0x61, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x72, 0x61, 0x66, 0x74, 0x52, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x47, 0x6f, 0x61, 0x6c, 0x69, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x64, 0x72, 0x61, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x47, 0x6f, 0x61,
+	0x6c, 0x69, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x72, 0x61, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x53, 0x6b, 0x61, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x64, 0x72, 0x61, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x53, 0x6b, 0x61, 0x74, 0x65,
+	0x72, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x46, 0x0a, 0x0b, 0x50, 0x69, 0x63, 0x6b, 0x73,
+	0x4f, 0x66, 0x59, 0x65, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x23, 0x0a, 0x05, 0x70, 0x69,
+	0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x2e, 0x50, 0x69, 0x63, 0x6b, 0x52, 0x05, 0x70, 0x69, 0x63, 0x6b, 0x73, 0x22,
+	0x88, 0x02, 0x0a, 0x0f, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
+	0x2e, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x69, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61,
+	0x73, 0x79, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x09,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x52, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a,
+	0x0a, 0x05, 0x70, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x69, 0x63, 0x6b, 0x73, 0x4f, 0x66, 0x59,
+	0x65, 0x61, 0x72, 0x52, 0x05, 0x70, 0x69, 0x63, 0x6b, 0x73, 0x22, 0x79, 0x0a, 0x17, 0x46, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x6e, 0x0a, 0x16, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69,
+	0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x7a, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x40, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x0f, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a,
+	0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7a, 0x0a, 0x18, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69,
+	0x73, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x30, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x69, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0xa2, 0x01, 0x0a, 0x16, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x7b, 0x0a, 0x0f, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x69, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x7a, 0x0a, 0x18, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73,
+	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x97, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x66,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x7c, 0x0a, 0x12, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a,
+	0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x41, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x8f, 0x01, 0x0a, 0x15, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x22, 0xfe, 0x04, 0x0a,
+	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x68, 0x6c, 0x54, 0x65, 0x61, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x68, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x12,
+	0x1c, 0x0a, 0x09, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x64, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6e,
+	0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x30, 0x0a, 0x13, 0x6e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63,
+	0x6b, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x6e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72,
+	0x61, 0x6c, 0x6c, 0x12, 0x30, 0x0a, 0x13, 0x6e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50,
+	0x69, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x6e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x6e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f,
+	0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x49, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x49, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x50, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x2d,
+	0x0a, 0x08, 0x6e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x4e, 0x68, 0x6c, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x08, 0x6e, 0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x22, 0x46, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61,
+	0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x22, 0x66, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44, 0x22, 0x53, 0x0a,
+	0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73,
+	0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x70,
+	0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x73, 0x22, 0x6d, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x64,
+	0x73, 0x22, 0x6c, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66,
+	0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x22,
+	0x47, 0x0a, 0x15, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x65, 0x65, 0x70,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x65, 0x65, 0x70, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x6f, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x72, 0x6f, 0x70, 0x49, 0x44, 0x22, 0x89, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x19, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x6d,
+	0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x68, 0x6c, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e,
+	0x68, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x22, 0xa0, 0x01, 0x0a, 0x12,
+	0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x69, 0x6d, 0x69,
+	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x7f,
+	0x0a, 0x1a, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x61, 0x6e,
+	0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0xaa, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0x3e, 0x0a, 0x1c,
+	0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44, 0x22, 0xc5, 0x01, 0x0a,
+	0x14, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e,
+	0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xb3, 0x01, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
+	0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72,
+	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x14, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x69, 0x73, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x22, 0x53, 0x0a, 0x0e, 0x52, 0x61, 0x6e, 0x6b, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x2d, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x22, 0xa0, 0x01, 0x0a,
+	0x07, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65,
+	0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x69, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x22,
+	0x6a, 0x0a, 0x10, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x75, 0x0a, 0x11, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72,
+	0x12, 0x30, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x0f, 0x44, 0x72, 0x61, 0x66, 0x74, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x62, 0x65, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x62, 0x65, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x73,
+	0x74, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x6f, 0x72,
+	0x73, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61,
+	0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x12, 0x44, 0x72, 0x61, 0x66, 0x74, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x61, 0x6e,
+	0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x08, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x69, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x8b, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65,
+	0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65,
+	0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x2f, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x45, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65,
+	0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65,
+	0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x22, 0x80, 0x01, 0x0a, 0x11, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65,
+	0x61, 0x72, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x70, 0x0a,
+	0x14, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x9a, 0x02, 0x0a, 0x0c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x69, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x22, 0x88, 0x01, 0x0a,
+	0x15, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61,
+	0x73, 0x79, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50,
+	0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x05, 0x70, 0x69,
+	0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x2e, 0x50, 0x69, 0x63, 0x6b, 0x52, 0x05, 0x70, 0x69, 0x63, 0x6b, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb0, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09,
+	0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x59, 0x65, 0x61, 0x72,
+	0x12, 0x28, 0x0a, 0x0f, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x4c, 0x6f, 0x74, 0x74, 0x65,
+	0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x72,
+	0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64,
+	0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x22, 0x83, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75,
+	0x65, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x05, 0x70, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x63, 0x6b, 0x52,
+	0x05, 0x70, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x5c,
+	0x0a, 0x12, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa9, 0x01, 0x0a,
+	0x17, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x50, 0x69, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65,
+	0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x63, 0x0a, 0x0c, 0x50, 0x69, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
+	0x2e, 0x50, 0x69, 0x63, 0x6b, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x84, 0x02,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a,
+	0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x59,
+	0x65, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x2d, 0x0a, 0x07, 0x64, 0x72, 0x61, 0x66, 0x74,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61,
+	0x73, 0x79, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x64,
+	0x72, 0x61, 0x66, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x72, 0x61, 0x66, 0x74, 0x59,
+	0x65, 0x61, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x72, 0x61, 0x66, 0x74,
+	0x59, 0x65, 0x61, 0x72, 0x22, 0x9c, 0x01, 0x0a, 0x0c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49,
+	0x44, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x18, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65,
+	0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x42, 0x79, 0x22, 0xde, 0x01, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x69, 0x63, 0x6b, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x50, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x69, 0x63, 0x6b, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x69, 0x63, 0x6b, 0x52,
+	0x10, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x69, 0x63, 0x6b,
+	0x73, 0x12, 0x32, 0x0a, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x69, 0x67, 0x68, 0x74, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x52,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x43, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x52, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x77,
+	0x61, 0x70, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x77, 0x61, 0x70, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x22, 0x79, 0x0a, 0x0f, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x69, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x54, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x75, 0x62,
+	0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x65, 0x50, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x65, 0x50,
+	0x69, 0x63, 0x6b, 0x49, 0x44, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x46, 0x69, 0x72, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x05, 0x46, 0x69,
+	0x72, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x06, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x0a, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x69,
+	0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x69, 0x76,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x12, 0x2b, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0xa7,
+	0x01, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x64, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73,
+	0x79, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x69, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73,
+	0x79, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x69, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x69, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0xc2, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x64, 0x72, 0x61, 0x66, 0x74, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x72, 0x61,
+	0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64,
+	0x72, 0x61, 0x66, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x87, 0x02, 0x0a, 0x0f, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x20, 0x0a,
+	0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x24, 0x0a, 0x0d, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x70, 0x69, 0x63, 0x6b,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
+	0x2e, 0x50, 0x69, 0x63, 0x6b, 0x52, 0x04, 0x70, 0x69, 0x63, 0x6b, 0x12, 0x36, 0x0a, 0x08, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x76, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x69, 0x73, 0x65, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69,
+	0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6c,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6c, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x22, 0xa7, 0x01, 0x0a, 0x12,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x30, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x6f, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6c, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22,
+	0x3f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x22, 0xe1, 0x01, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12,
+	0x34, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73,
+	0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x22, 0x72, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73,
+	0x79, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x51, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xf5, 0x02, 0x0a, 0x13,
+	0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x73,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x73,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x67, 0x61,
+	0x6d, 0x65, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x6f, 0x61,
+	0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x4d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x61, 0x76,
+	0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0e, 0x73, 0x61, 0x76, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x30, 0x0a, 0x13, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73,
+	0x74, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13,
+	0x67, 0x6f, 0x61, 0x6c, 0x73, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x41, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x61, 0x6e,
+	0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0xd0, 0x01, 0x0a, 0x11, 0x54, 0x65, 0x78, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x61, 0x6e,
+	0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x13, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x49, 0x6e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x6e,
+	0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2a, 0x98,
+	0x01, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x14, 0x50,
+	0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x4f,
+	0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x5f, 0x57, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52,
+	0x49, 0x47, 0x48, 0x54, 0x5f, 0x57, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x50,
+	0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x4e, 0x53, 0x45, 0x4d,
+	0x41, 0x4e, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x47, 0x4f, 0x41, 0x4c, 0x49, 0x45, 0x10, 0x05, 0x2a, 0xec, 0x01, 0x0a, 0x0e, 0x50, 0x72,
+	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x1b,
+	0x50, 0x52, 0x4f, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a,
+	0x18, 0x50, 0x52, 0x4f, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x50,
+	0x52, 0x4f, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53,
+	0x49, 0x47, 0x4e, 0x45, 0x44, 0x5f, 0x45, 0x4c, 0x43, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x50,
+	0x52, 0x4f, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41,
+	0x48, 0x4c, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52, 0x4f, 0x53, 0x50, 0x45, 0x43, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x48, 0x4c, 0x5f, 0x47, 0x52, 0x41, 0x44,
+	0x55, 0x41, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x52, 0x4f, 0x53, 0x50,
+	0x45, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x49, 0x47, 0x48, 0x54,
+	0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x50,
+	0x52, 0x4f, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52,
+	0x45, 0x54, 0x49, 0x52, 0x45, 0x44, 0x10, 0x06, 0x2a, 0x5e, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x45, 0x50, 0x10, 0x00,
+	0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x50,
+	0x52, 0x4f, 0x54, 0x45, 0x43, 0x54, 0x10, 0x02, 0x2a, 0x4a, 0x0a, 0x0a, 0x42, 0x6f, 0x6f, 0x6c,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x4f, 0x4f, 0x4c, 0x5f, 0x46,
+	0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x42,
+	0x4f, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x59, 0x45, 0x53, 0x10, 0x01,
+	0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4f, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f,
+	0x4e, 0x4f, 0x10, 0x02, 0x2a, 0x4c, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x53, 0x45,
+	0x4e, 0x53, 0x55, 0x53, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41, 0x56, 0x45, 0x52,
+	0x41, 0x47, 0x45, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x53, 0x45, 0x4e, 0x53,
+	0x55, 0x53, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e,
+	0x10, 0x01, 0x32, 0xed, 0x25, 0x0a, 0x0e, 0x46, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c,
+	0x65, 0x61, 0x67, 0x75, 0x65, 0x12, 0x16, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e,
+	0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4c,
+	0x65, 0x61, 0x67, 0x75, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x44, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x12, 0x19,
+	0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67,
+	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x12, 0x1c, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73,
+	0x79, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e,
+	0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x48, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x67, 0x75,
+	0x65, 0x12, 0x1c, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x67,
+	0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x12, 0x1c, 0x2e, 0x66, 0x61, 0x6e,
+	0x74, 0x61, 0x73, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61,
+	0x73, 0x79, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x4c, 0x65, 0x61,
+	0x67, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x66,
+	0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x4c, 0x65, 0x61, 0x67,
+	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72,
+	0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
+	0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
+	0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x43, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12,
+	0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61,
+	0x67, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e,
+	0x74, 0x61, 0x73, 0x79, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
+	0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1c, 0x2e,
+	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1d, 0x2e,
+	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x66,
+	0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x69, 0x73, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e,
+	0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65,
+	0x61, 0x67, 0x75, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x12, 0x19, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
+	0x2e, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x69, 0x73, 0x65, 0x12, 0x1c, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47,
+	0x65, 0x74, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74,
+	0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x69, 0x73, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73,
+	0x79, 0x2e, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x12, 0x1f,
+	0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69,
+	0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a,
+	0x1a, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69,
+	0x73, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x21, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0f,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x12,
+	0x1f, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x1c, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65,
+	0x74, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x69, 0x73, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x46, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x66,
+	0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x15, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x1f, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x66,
+	0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61,
+	0x73, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0e, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x46, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62,
+	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x73, 0x42, 0x75, 0x6c, 0x6b, 0x12, 0x23, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x42,
+	0x75, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x61, 0x6e,
+	0x74, 0x61, 0x73, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50,
+	0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4d, 0x0a, 0x0e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72,
+	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x62, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x25, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x47, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1b,
+	0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1a, 0x2e, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x13, 0x54, 0x65, 0x78, 0x74, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73,
+	0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73,
+	0x79, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d,
+	0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a,
+	0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x55, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x42,
+	0x79, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x12, 0x1c, 0x2e, 0x66, 0x61, 0x6e,
+	0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61,
+	0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63,
+	0x6b, 0x73, 0x42, 0x79, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x12, 0x18, 0x2e,
+	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73,
+	0x79, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x73,
+	0x42, 0x79, 0x59, 0x65, 0x61, 0x72, 0x12, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x05, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x15, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
+	0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x15, 0x2e, 0x66, 0x61, 0x6e,
+	0x74, 0x61, 0x73, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61,
+	0x73, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4e, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61,
+	0x73, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4f, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x1e, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4b, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56,
+	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e,
+	0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63,
+	0x6b, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x66, 0x61,
+	0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
+	0x2e, 0x50, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x50, 0x69, 0x63, 0x6b, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x19, 0x2e, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x50,
+	0x69, 0x63, 0x6b, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x50, 0x69, 0x63,
+	0x6b, 0x12, 0x1b, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x46, 0x6f, 0x72, 0x66,
+	0x65, 0x69, 0x74, 0x50, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x15, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x50,
+	0x69, 0x63, 0x6b, 0x12, 0x20, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x50, 0x69, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e,
+	0x50, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x72,
+	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73,
+	0x79, 0x2e, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x66, 0x61, 0x6e,
+	0x74, 0x61, 0x73, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x15, 0x2e, 0x66, 0x61, 0x6e, 0x74,
+	0x61, 0x73, 0x79, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f,
+	0x55, 0x6e, 0x64, 0x72, 0x61, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12,
+	0x15, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
+	0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x27, 0x2e,
+	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75,
+	0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x69, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x46, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x69, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_pb_fantasy_proto_rawDescOnce sync.Once
+	file_service_pb_fantasy_proto_rawDescData = file_service_pb_fantasy_proto_rawDesc
+)
+
+func file_service_pb_fantasy_proto_rawDescGZIP() []byte {
+	file_service_pb_fantasy_proto_rawDescOnce.Do(func() {
+		file_service_pb_fantasy_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_pb_fantasy_proto_rawDescData)
+	})
+	return file_service_pb_fantasy_proto_rawDescData
+}
+
+var file_service_pb_fantasy_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_service_pb_fantasy_proto_msgTypes = make([]protoimpl.MessageInfo, 115)
+var file_service_pb_fantasy_proto_goTypes = []interface{}{
+	(Position)(0),                           // 0: fantasy.Position
+	(ProspectStatus)(0),                     // 1: fantasy.ProspectStatus
+	(StatusAction)(0),                       // 2: fantasy.StatusAction
+	(BoolFilter)(0),                         // 3: fantasy.BoolFilter
+	(ConsensusMethod)(0),                    // 4: fantasy.ConsensusMethod
+	(*League)(nil),                          // 5: fantasy.League
+	(*Franchise)(nil),                       // 6: fantasy.Franchise
+	(*Prospect)(nil),                        // 7: fantasy.Prospect
+	(*Pick)(nil),                            // 8: fantasy.Pick
+	(*PickCondition)(nil),                   // 9: fantasy.PickCondition
+	(*PickConditionsResponse)(nil),          // 10: fantasy.PickConditionsResponse
+	(*PickSwap)(nil),                        // 11: fantasy.PickSwap
+	(*PickSwapsResponse)(nil),               // 12: fantasy.PickSwapsResponse
+	(*NhlDraft)(nil),                        // 13: fantasy.NhlDraft
+	(*StatusRule)(nil),                      // 14: fantasy.StatusRule
+	(*DraftYearOffsets)(nil),                // 15: fantasy.DraftYearOffsets
+	(*EligibilityRules)(nil),                // 16: fantasy.EligibilityRules
+	(*DraftPick)(nil),                       // 17: fantasy.DraftPick
+	(*DefaultResponse)(nil),                 // 18: fantasy.DefaultResponse
+	(*ProspectFilter)(nil),                  // 19: fantasy.ProspectFilter
+	(*LeagueRequest)(nil),                   // 20: fantasy.LeagueRequest
+	(*LeagueUpdateRequest)(nil),             // 21: fantasy.LeagueUpdateRequest
+	(*LeagueActionRequest)(nil),             // 22: fantasy.LeagueActionRequest
+	(*CloneLeagueRequest)(nil),              // 23: fantasy.CloneLeagueRequest
+	(*Season)(nil),                          // 24: fantasy.Season
+	(*RolloverSeasonRequest)(nil),           // 25: fantasy.RolloverSeasonRequest
+	(*SeasonResponse)(nil),                  // 26: fantasy.SeasonResponse
+	(*SeasonsResponse)(nil),                 // 27: fantasy.SeasonsResponse
+	(*LeagueResponse)(nil),                  // 28: fantasy.LeagueResponse
+	(*GetLeaguesRequest)(nil),               // 29: fantasy.GetLeaguesRequest
+	(*GetLeaguesResponse)(nil),              // 30: fantasy.GetLeaguesResponse
+	(*GetLeagueRequest)(nil),                // 31: fantasy.GetLeagueRequest
+	(*GetLeagueResponse)(nil),               // 32: fantasy.GetLeagueResponse
+	(*GetLeagueFranchisesResponse)(nil),     // 33: fantasy.GetLeagueFranchisesResponse
+	(*GetLeagueFranchisePairsRequest)(nil),  // 34: fantasy.GetLeagueFranchisePairsRequest
+	(*LeagueFranchisePair)(nil),             // 35: fantasy.LeagueFranchisePair
+	(*GetLeagueFranchisePairsResponse)(nil), // 36: fantasy.GetLeagueFranchisePairsResponse
+	(*FranchiseRequest)(nil),                // 37: fantasy.FranchiseRequest
+	(*FranchiseResponse)(nil),               // 38: fantasy.FranchiseResponse
+	(*GetFranchiseRequest)(nil),             // 39: fantasy.GetFranchiseRequest
+	(*GetFranchiseResponse)(nil),            // 40: fantasy.GetFranchiseResponse
+	(*RosterProspect)(nil),                  // 41: fantasy.RosterProspect
+	(*PositionCount)(nil),                   // 42: fantasy.PositionCount
+	(*RosterUsage)(nil),                     // 43: fantasy.RosterUsage
+	(*PicksOfYear)(nil),                     // 44: fantasy.PicksOfYear
+	(*FranchiseRoster)(nil),                 // 45: fantasy.FranchiseRoster
+	(*FranchiseRosterResponse)(nil),         // 46: fantasy.FranchiseRosterResponse
+	(*FranchiseUpdateRequest)(nil),          // 47: fantasy.FranchiseUpdateRequest
+	(*TransferFranchiseRequest)(nil),        // 48: fantasy.TransferFranchiseRequest
+	(*DeleteFranchiseRequest)(nil),          // 49: fantasy.DeleteFranchiseRequest
+	(*FranchiseChange)(nil),                 // 50: fantasy.FranchiseChange
+	(*FranchiseHistoryResponse)(nil),        // 51: fantasy.FranchiseHistoryResponse
+	(*FranchiseMemberRequest)(nil),          // 52: fantasy.FranchiseMemberRequest
+	(*FranchiseMember)(nil),                 // 53: fantasy.FranchiseMember
+	(*FranchiseMembersResponse)(nil),        // 54: fantasy.FranchiseMembersResponse
+	(*InvitationRequest)(nil),               // 55: fantasy.InvitationRequest
+	(*InvitationResponse)(nil),              // 56: fantasy.InvitationResponse
+	(*RevokeInvitationRequest)(nil),         // 57: fantasy.RevokeInvitationRequest
+	(*ClaimFranchiseRequest)(nil),           // 58: fantasy.ClaimFranchiseRequest
+	(*CreateProspect)(nil),                  // 59: fantasy.CreateProspect
+	(*CreateProspectRequest)(nil),           // 60: fantasy.CreateProspectRequest
+	(*CreateProspectResponse)(nil),          // 61: fantasy.CreateProspectResponse
+	(*CreateProspectsBulkRequest)(nil),      // 62: fantasy.CreateProspectsBulkRequest
+	(*CreateProspectsBulkResponse)(nil),     // 63: fantasy.CreateProspectsBulkResponse
+	(*UpdateProspectRequest)(nil),           // 64: fantasy.UpdateProspectRequest
+	(*MergeProspectsRequest)(nil),           // 65: fantasy.MergeProspectsRequest
+	(*ProspectResponse)(nil),                // 66: fantasy.ProspectResponse
+	(*DuplicateProspectsRequest)(nil),       // 67: fantasy.DuplicateProspectsRequest
+	(*DuplicateProspects)(nil),              // 68: fantasy.DuplicateProspects
+	(*DuplicateProspectsResponse)(nil),      // 69: fantasy.DuplicateProspectsResponse
+	(*ProspectStatusRequest)(nil),           // 70: fantasy.ProspectStatusRequest
+	(*ProspectStatusHistoryRequest)(nil),    // 71: fantasy.ProspectStatusHistoryRequest
+	(*ProspectStatusChange)(nil),            // 72: fantasy.ProspectStatusChange
+	(*ProspectStatusHistoryResponse)(nil),   // 73: fantasy.ProspectStatusHistoryResponse
+	(*SubmitRankingRequest)(nil),            // 74: fantasy.SubmitRankingRequest
+	(*GetRankingsRequest)(nil),              // 75: fantasy.GetRankingsRequest
+	(*RankedProspect)(nil),                  // 76: fantasy.RankedProspect
+	(*Ranking)(nil),                         // 77: fantasy.Ranking
+	(*RankingsResponse)(nil),                // 78: fantasy.RankingsResponse
+	(*DraftBoardRequest)(nil),               // 79: fantasy.DraftBoardRequest
+	(*DraftBoardEntry)(nil),                 // 80: fantasy.DraftBoardEntry
+	(*DraftBoardResponse)(nil),              // 81: fantasy.DraftBoardResponse
+	(*Standing)(nil),                        // 82: fantasy.Standing
+	(*StandingsRequest)(nil),                // 83: fantasy.StandingsRequest
+	(*GetStandingsRequest)(nil),             // 84: fantasy.GetStandingsRequest
+	(*StandingsResponse)(nil),               // 85: fantasy.StandingsResponse
+	(*PowerRankingsRequest)(nil),            // 86: fantasy.PowerRankingsRequest
+	(*PowerRanking)(nil),                    // 87: fantasy.PowerRanking
+	(*PowerRankingsResponse)(nil),           // 88: fantasy.PowerRankingsResponse
+	(*GetPicksResponse)(nil),                // 89: fantasy.GetPicksResponse
+	(*CreateOrUpdatePick)(nil),              // 90: fantasy.CreateOrUpdatePick
+	(*CreateOrUpdatePicksRequest)(nil),      // 91: fantasy.CreateOrUpdatePicksRequest
+	(*ForfeitPickRequest)(nil),              // 92: fantasy.ForfeitPickRequest
+	(*SupplementalPickRequest)(nil),         // 93: fantasy.SupplementalPickRequest
+	(*PickResponse)(nil),                    // 94: fantasy.PickResponse
+	(*GetPicksRequest)(nil),                 // 95: fantasy.GetPicksRequest
+	(*DraftRequest)(nil),                    // 96: fantasy.DraftRequest
+	(*EligibleProspectsRequest)(nil),        // 97: fantasy.EligibleProspectsRequest
+	(*TradePayload)(nil),                    // 98: fantasy.TradePayload
+	(*SwapRight)(nil),                       // 99: fantasy.SwapRight
+	(*ConditionalPick)(nil),                 // 100: fantasy.ConditionalPick
+	(*TradeRequest)(nil),                    // 101: fantasy.TradeRequest
+	(*AssetValue)(nil),                      // 102: fantasy.AssetValue
+	(*TradeSideValue)(nil),                  // 103: fantasy.TradeSideValue
+	(*TradeEvaluationResponse)(nil),         // 104: fantasy.TradeEvaluationResponse
+	(*TradeBlockRequest)(nil),               // 105: fantasy.TradeBlockRequest
+	(*ListTradeBlockRequest)(nil),           // 106: fantasy.ListTradeBlockRequest
+	(*TradeBlockEntry)(nil),                 // 107: fantasy.TradeBlockEntry
+	(*TradeProfile)(nil),                    // 108: fantasy.TradeProfile
+	(*TradeBlockResponse)(nil),              // 109: fantasy.TradeBlockResponse
+	(*TradeProfileRequest)(nil),             // 110: fantasy.TradeProfileRequest
+	(*WatchlistRequest)(nil),                // 111: fantasy.WatchlistRequest
+	(*GetWatchlistRequest)(nil),             // 112: fantasy.GetWatchlistRequest
+	(*WatchlistEntry)(nil),                  // 113: fantasy.WatchlistEntry
+	(*WatchlistResponse)(nil),               // 114: fantasy.WatchlistResponse
+	(*GetProspectStatsRequest)(nil),         // 115: fantasy.GetProspectStatsRequest
+	(*ProspectSeasonStats)(nil),             // 116: fantasy.ProspectSeasonStats
+	(*ProspectStatsResponse)(nil),           // 117: fantasy.ProspectStatsResponse
+	(*TextSearchRequest)(nil),               // 118: fantasy.TextSearchRequest
+	(*ProspectsResponse)(nil),               // 119: fantasy.ProspectsResponse
+	(*timestamppb.Timestamp)(nil),           // 120: google.protobuf.Timestamp
+}
+var file_service_pb_fantasy_proto_depIdxs = []int32{
+	6,   // 0: fantasy.League.Franchises:type_name -> fantasy.Franchise
+	14,  // 1: fantasy.League.statusRules:type_name -> fantasy.StatusRule
+	16,  // 2: fantasy.League.eligibility:type_name -> fantasy.EligibilityRules
+	7,   // 3: fantasy.Franchise.Prospects:type_name -> fantasy.Prospect
+	8,   // 4: fantasy.Prospect.Pick:type_name -> fantasy.Pick
+	0,   // 5: fantasy.Prospect.position:type_name -> fantasy.Position
+	120, // 6: fantasy.Prospect.dateOfBirth:type_name -> google.protobuf.Timestamp
+	13,  // 7: fantasy.Prospect.nhlDraft:type_name -> fantasy.NhlDraft
+	113, // 8: fantasy.Prospect.watchlist:type_name -> fantasy.WatchlistEntry
+	1,   // 9: fantasy.Prospect.status:type_name -> fantasy.ProspectStatus
+	120, // 10: fantasy.Prospect.statusSince:type_name -> google.protobuf.Timestamp
+	9,   // 11: fantasy.Pick.conditions:type_name -> fantasy.PickCondition
+	11,  // 12: fantasy.Pick.swaps:type_name -> fantasy.PickSwap
+	120, // 13: fantasy.Pick.forfeitedAt:type_name -> google.protobuf.Timestamp
+	120, // 14: fantasy.PickCondition.resolvedAt:type_name -> google.protobuf.Timestamp
+	9,   // 15: fantasy.PickConditionsResponse.result:type_name -> fantasy.PickCondition
+	120, // 16: fantasy.PickSwap.resolvedAt:type_name -> google.protobuf.Timestamp
+	11,  // 17: fantasy.PickSwapsResponse.result:type_name -> fantasy.PickSwap
+	1,   // 18: fantasy.StatusRule.status:type_name -> fantasy.ProspectStatus
+	2,   // 19: fantasy.StatusRule.action:type_name -> fantasy.StatusAction
+	15,  // 20: fantasy.EligibilityRules.draftYears:type_name -> fantasy.DraftYearOffsets
+	0,   // 21: fantasy.EligibilityRules.positions:type_name -> fantasy.Position
+	3,   // 22: fantasy.ProspectFilter.drafted:type_name -> fantasy.BoolFilter
+	3,   // 23: fantasy.ProspectFilter.protected:type_name -> fantasy.BoolFilter
+	0,   // 24: fantasy.ProspectFilter.position:type_name -> fantasy.Position
+	14,  // 25: fantasy.LeagueRequest.statusRules:type_name -> fantasy.StatusRule
+	16,  // 26: fantasy.LeagueRequest.eligibility:type_name -> fantasy.EligibilityRules
+	20,  // 27: fantasy.LeagueUpdateRequest.league:type_name -> fantasy.LeagueRequest
+	120, // 28: fantasy.Season.startDate:type_name -> google.protobuf.Timestamp
+	120, // 29: fantasy.Season.endDate:type_name -> google.protobuf.Timestamp
+	120, // 30: fantasy.Season.closedAt:type_name -> google.protobuf.Timestamp
+	120, // 31: fantasy.RolloverSeasonRequest.startDate:type_name -> google.protobuf.Timestamp
+	120, // 32: fantasy.RolloverSeasonRequest.endDate:type_name -> google.protobuf.Timestamp
+	24,  // 33: fantasy.SeasonResponse.result:type_name -> fantasy.Season
+	24,  // 34: fantasy.SeasonsResponse.result:type_name -> fantasy.Season
+	5,   // 35: fantasy.GetLeaguesResponse.result:type_name -> fantasy.League
+	5,   // 36: fantasy.GetLeagueResponse.result:type_name -> fantasy.League
+	6,   // 37: fantasy.GetLeagueFranchisesResponse.result:type_name -> fantasy.Franchise
+	35,  // 38: fantasy.GetLeagueFranchisePairsResponse.result:type_name -> fantasy.LeagueFranchisePair
+	19,  // 39: fantasy.GetFranchiseRequest.filter:type_name -> fantasy.ProspectFilter
+	6,   // 40: fantasy.GetFranchiseResponse.result:type_name -> fantasy.Franchise
+	7,   // 41: fantasy.RosterProspect.prospect:type_name -> fantasy.Prospect
+	0,   // 42: fantasy.PositionCount.position:type_name -> fantasy.Position
+	8,   // 43: fantasy.PicksOfYear.picks:type_name -> fantasy.Pick
+	6,   // 44: fantasy.FranchiseRoster.franchise:type_name -> fantasy.Franchise
+	41,  // 45: fantasy.FranchiseRoster.prospects:type_name -> fantasy.RosterProspect
+	42,  // 46: fantasy.FranchiseRoster.positions:type_name -> fantasy.PositionCount
+	43,  // 47: fantasy.FranchiseRoster.usage:type_name -> fantasy.RosterUsage
+	44,  // 48: fantasy.FranchiseRoster.picks:type_name -> fantasy.PicksOfYear
+	45,  // 49: fantasy.FranchiseRosterResponse.result:type_name -> fantasy.FranchiseRoster
+	120, // 50: fantasy.FranchiseChange.changedAt:type_name -> google.protobuf.Timestamp
+	50,  // 51: fantasy.FranchiseHistoryResponse.result:type_name -> fantasy.FranchiseChange
+	53,  // 52: fantasy.FranchiseMembersResponse.result:type_name -> fantasy.FranchiseMember
+	0,   // 53: fantasy.CreateProspect.position:type_name -> fantasy.Position
+	120, // 54: fantasy.CreateProspect.dateOfBirth:type_name -> google.protobuf.Timestamp
+	13,  // 55: fantasy.CreateProspect.nhlDraft:type_name -> fantasy.NhlDraft
+	7,   // 56: fantasy.CreateProspectRequest.prospect:type_name -> fantasy.Prospect
+	59,  // 57: fantasy.CreateProspectsBulkRequest.prospects:type_name -> fantasy.CreateProspect
+	59,  // 58: fantasy.UpdateProspectRequest.prospect:type_name -> fantasy.CreateProspect
+	7,   // 59: fantasy.ProspectResponse.prospect:type_name -> fantasy.Prospect
+	7,   // 60: fantasy.DuplicateProspects.first:type_name -> fantasy.Prospect
+	7,   // 61: fantasy.DuplicateProspects.second:type_name -> fantasy.Prospect
+	68,  // 62: fantasy.DuplicateProspectsResponse.result:type_name -> fantasy.DuplicateProspects
+	1,   // 63: fantasy.ProspectStatusRequest.status:type_name -> fantasy.ProspectStatus
+	120, // 64: fantasy.ProspectStatusRequest.effectiveDate:type_name -> google.protobuf.Timestamp
+	1,   // 65: fantasy.ProspectStatusChange.status:type_name -> fantasy.ProspectStatus
+	120, // 66: fantasy.ProspectStatusChange.effectiveDate:type_name -> google.protobuf.Timestamp
+	120, // 67: fantasy.ProspectStatusChange.recordedAt:type_name -> google.protobuf.Timestamp
+	7,   // 68: fantasy.ProspectStatusHistoryResponse.prospect:type_name -> fantasy.Prospect
+	72,  // 69: fantasy.ProspectStatusHistoryResponse.result:type_name -> fantasy.ProspectStatusChange
+	7,   // 70: fantasy.RankedProspect.prospect:type_name -> fantasy.Prospect
+	76,  // 71: fantasy.Ranking.prospects:type_name -> fantasy.RankedProspect
+	77,  // 72: fantasy.RankingsResponse.result:type_name -> fantasy.Ranking
+	4,   // 73: fantasy.DraftBoardRequest.method:type_name -> fantasy.ConsensusMethod
+	7,   // 74: fantasy.DraftBoardEntry.prospect:type_name -> fantasy.Prospect
+	80,  // 75: fantasy.DraftBoardResponse.result:type_name -> fantasy.DraftBoardEntry
+	82,  // 76: fantasy.StandingsRequest.standings:type_name -> fantasy.Standing
+	82,  // 77: fantasy.StandingsResponse.result:type_name -> fantasy.Standing
+	87,  // 78: fantasy.PowerRankingsResponse.result:type_name -> fantasy.PowerRanking
+	8,   // 79: fantasy.GetPicksResponse.picks:type_name -> fantasy.Pick
+	90,  // 80: fantasy.CreateOrUpdatePicksRequest.picks:type_name -> fantasy.CreateOrUpdatePick
+	8,   // 81: fantasy.PickResponse.result:type_name -> fantasy.Pick
+	3,   // 82: fantasy.GetPicksRequest.drafted:type_name -> fantasy.BoolFilter
+	100, // 83: fantasy.TradePayload.conditionalPicks:type_name -> fantasy.ConditionalPick
+	99,  // 84: fantasy.TradePayload.swapRights:type_name -> fantasy.SwapRight
+	98,  // 85: fantasy.TradeRequest.First:type_name -> fantasy.TradePayload
+	98,  // 86: fantasy.TradeRequest.Second:type_name -> fantasy.TradePayload
+	102, // 87: fantasy.TradeSideValue.assets:type_name -> fantasy.AssetValue
+	103, // 88: fantasy.TradeEvaluationResponse.first:type_name -> fantasy.TradeSideValue
+	103, // 89: fantasy.TradeEvaluationResponse.second:type_name -> fantasy.TradeSideValue
+	0,   // 90: fantasy.ListTradeBlockRequest.position:type_name -> fantasy.Position
+	7,   // 91: fantasy.TradeBlockEntry.prospect:type_name -> fantasy.Prospect
+	8,   // 92: fantasy.TradeBlockEntry.pick:type_name -> fantasy.Pick
+	120, // 93: fantasy.TradeBlockEntry.listedAt:type_name -> google.protobuf.Timestamp
+	107, // 94: fantasy.TradeBlockResponse.result:type_name -> fantasy.TradeBlockEntry
+	108, // 95: fantasy.TradeBlockResponse.profiles:type_name -> fantasy.TradeProfile
+	120, // 96: fantasy.WatchlistEntry.addedAt:type_name -> google.protobuf.Timestamp
+	7,   // 97: fantasy.WatchlistEntry.prospect:type_name -> fantasy.Prospect
+	113, // 98: fantasy.WatchlistResponse.result:type_name -> fantasy.WatchlistEntry
+	7,   // 99: fantasy.ProspectStatsResponse.prospect:type_name -> fantasy.Prospect
+	116, // 100: fantasy.ProspectStatsResponse.result:type_name -> fantasy.ProspectSeasonStats
+	19,  // 101: fantasy.TextSearchRequest.filter:type_name -> fantasy.ProspectFilter
+	7,   // 102: fantasy.ProspectsResponse.prospects:type_name -> fantasy.Prospect
+	20,  // 103: fantasy.FantasyService.CreateLeague:input_type -> fantasy.LeagueRequest
+	29,  // 104: fantasy.FantasyService.GetLeagues:input_type -> fantasy.GetLeaguesRequest
+	31,  // 105: fantasy.FantasyService.GetLeague:input_type -> fantasy.GetLeagueRequest
+	21,  // 106: fantasy.FantasyService.UpdateLeague:input_type -> fantasy.LeagueUpdateRequest
+	22,  // 107: fantasy.FantasyService.ArchiveLeague:input_type -> fantasy.LeagueActionRequest
+	22,  // 108: fantasy.FantasyService.DeleteLeague:input_type -> fantasy.LeagueActionRequest
+	23,  // 109: fantasy.FantasyService.CloneLeagueForSeason:input_type -> fantasy.CloneLeagueRequest
+	25,  // 110: fantasy.FantasyService.RolloverSeason:input_type -> fantasy.RolloverSeasonRequest
+	31,  // 111: fantasy.FantasyService.GetSeasons:input_type -> fantasy.GetLeagueRequest
+	83,  // 112: fantasy.FantasyService.SetStandings:input_type -> fantasy.StandingsRequest
+	84,  // 113: fantasy.FantasyService.GetStandings:input_type -> fantasy.GetStandingsRequest
+	86,  // 114: fantasy.FantasyService.GetPowerRankings:input_type -> fantasy.PowerRankingsRequest
+	31,  // 115: fantasy.FantasyService.GetLeagueFranchises:input_type -> fantasy.GetLeagueRequest
+	37,  // 116: fantasy.FantasyService.CreateFranchise:input_type -> fantasy.FranchiseRequest
+	39,  // 117: fantasy.FantasyService.GetFranchise:input_type -> fantasy.GetFranchiseRequest
+	39,  // 118: fantasy.FantasyService.GetFranchiseRoster:input_type -> fantasy.GetFranchiseRequest
+	47,  // 119: fantasy.FantasyService.UpdateFranchise:input_type -> fantasy.FranchiseUpdateRequest
+	48,  // 120: fantasy.FantasyService.TransferFranchiseOwnership:input_type -> fantasy.TransferFranchiseRequest
+	49,  // 121: fantasy.FantasyService.DeleteFranchise:input_type -> fantasy.DeleteFranchiseRequest
+	39,  // 122: fantasy.FantasyService.GetFranchiseHistory:input_type -> fantasy.GetFranchiseRequest
+	52,  // 123: fantasy.FantasyService.AddFranchiseMember:input_type -> fantasy.FranchiseMemberRequest
+	52,  // 124: fantasy.FantasyService.RemoveFranchiseMember:input_type -> fantasy.FranchiseMemberRequest
+	39,  // 125: fantasy.FantasyService.GetFranchiseMembers:input_type -> fantasy.GetFranchiseRequest
+	55,  // 126: fantasy.FantasyService.InviteFranchise:input_type -> fantasy.InvitationRequest
+	57,  // 127: fantasy.FantasyService.RevokeInvitation:input_type -> fantasy.RevokeInvitationRequest
+	58,  // 128: fantasy.FantasyService.ClaimFranchise:input_type -> fantasy.ClaimFranchiseRequest
+	60,  // 129: fantasy.FantasyService.CreateProspect:input_type -> fantasy.CreateProspectRequest
+	62,  // 130: fantasy.FantasyService.CreateProspectsBulk:input_type -> fantasy.CreateProspectsBulkRequest
+	64,  // 131: fantasy.FantasyService.UpdateProspect:input_type -> fantasy.UpdateProspectRequest
+	65,  // 132: fantasy.FantasyService.MergeProspects:input_type -> fantasy.MergeProspectsRequest
+	67,  // 133: fantasy.FantasyService.GetDuplicateProspects:input_type -> fantasy.DuplicateProspectsRequest
+	70,  // 134: fantasy.FantasyService.SetProspectStatus:input_type -> fantasy.ProspectStatusRequest
+	71,  // 135: fantasy.FantasyService.GetProspectStatusHistory:input_type -> fantasy.ProspectStatusHistoryRequest
+	74,  // 136: fantasy.FantasyService.SubmitRanking:input_type -> fantasy.SubmitRankingRequest
+	75,  // 137: fantasy.FantasyService.GetRankings:input_type -> fantasy.GetRankingsRequest
+	79,  // 138: fantasy.FantasyService.GetDraftBoard:input_type -> fantasy.DraftBoardRequest
+	118, // 139: fantasy.FantasyService.TextSearchProspects:input_type -> fantasy.TextSearchRequest
+	111, // 140: fantasy.FantasyService.AddToWatchlist:input_type -> fantasy.WatchlistRequest
+	111, // 141: fantasy.FantasyService.UpdateWatchlistEntry:input_type -> fantasy.WatchlistRequest
+	111, // 142: fantasy.FantasyService.RemoveFromWatchlist:input_type -> fantasy.WatchlistRequest
+	112, // 143: fantasy.FantasyService.GetWatchlist:input_type -> fantasy.GetWatchlistRequest
+	115, // 144: fantasy.FantasyService.GetProspectStats:input_type -> fantasy.GetProspectStatsRequest
+	39,  // 145: fantasy.FantasyService.GetProspectsByFranchise:input_type -> fantasy.GetFranchiseRequest
+	95,  // 146: fantasy.FantasyService.GetPicksByFranchise:input_type -> fantasy.GetPicksRequest
+	95,  // 147: fantasy.FantasyService.GetPicksByYear:input_type -> fantasy.GetPicksRequest
+	101, // 148: fantasy.FantasyService.Trade:input_type -> fantasy.TradeRequest
+	101, // 149: fantasy.FantasyService.EvaluateTrade:input_type -> fantasy.TradeRequest
+	105, // 150: fantasy.FantasyService.AddToTradeBlock:input_type -> fantasy.TradeBlockRequest
+	105, // 151: fantasy.FantasyService.RemoveFromTradeBlock:input_type -> fantasy.TradeBlockRequest
+	106, // 152: fantasy.FantasyService.ListTradeBlock:input_type -> fantasy.ListTradeBlockRequest
+	110, // 153: fantasy.FantasyService.SetTradeProfile:input_type -> fantasy.TradeProfileRequest
+	91,  // 154: fantasy.FantasyService.CreateOrUpdatePicks:input_type -> fantasy.CreateOrUpdatePicksRequest
+	31,  // 155: fantasy.FantasyService.GetPickConditions:input_type -> fantasy.GetLeagueRequest
+	31,  // 156: fantasy.FantasyService.GetPickSwaps:input_type -> fantasy.GetLeagueRequest
+	92,  // 157: fantasy.FantasyService.ForfeitPick:input_type -> fantasy.ForfeitPickRequest
+	93,  // 158: fantasy.FantasyService.IssueSupplementalPick:input_type -> fantasy.SupplementalPickRequest
+	97,  // 159: fantasy.FantasyService.ListEligibleProspects:input_type -> fantasy.EligibleProspectsRequest
+	96,  // 160: fantasy.FantasyService.DraftProspect:input_type -> fantasy.DraftRequest
+	96,  // 161: fantasy.FantasyService.UndraftProspect:input_type -> fantasy.DraftRequest
+	34,  // 162: fantasy.FantasyService.GetLeagueFranchisePairs:input_type -> fantasy.GetLeagueFranchisePairsRequest
+	28,  // 163: fantasy.FantasyService.CreateLeague:output_type -> fantasy.LeagueResponse
+	30,  // 164: fantasy.FantasyService.GetLeagues:output_type -> fantasy.GetLeaguesResponse
+	32,  // 165: fantasy.FantasyService.GetLeague:output_type -> fantasy.GetLeagueResponse
+	28,  // 166: fantasy.FantasyService.UpdateLeague:output_type -> fantasy.LeagueResponse
+	28,  // 167: fantasy.FantasyService.ArchiveLeague:output_type -> fantasy.LeagueResponse
+	18,  // 168: fantasy.FantasyService.DeleteLeague:output_type -> fantasy.DefaultResponse
+	28,  // 169: fantasy.FantasyService.CloneLeagueForSeason:output_type -> fantasy.LeagueResponse
+	26,  // 170: fantasy.FantasyService.RolloverSeason:output_type -> fantasy.SeasonResponse
+	27,  // 171: fantasy.FantasyService.GetSeasons:output_type -> fantasy.SeasonsResponse
+	18,  // 172: fantasy.FantasyService.SetStandings:output_type -> fantasy.DefaultResponse
+	85,  // 173: fantasy.FantasyService.GetStandings:output_type -> fantasy.StandingsResponse
+	88,  // 174: fantasy.FantasyService.GetPowerRankings:output_type -> fantasy.PowerRankingsResponse
+	33,  // 175: fantasy.FantasyService.GetLeagueFranchises:output_type -> fantasy.GetLeagueFranchisesResponse
+	38,  // 176: fantasy.FantasyService.CreateFranchise:output_type -> fantasy.FranchiseResponse
+	40,  // 177: fantasy.FantasyService.GetFranchise:output_type -> fantasy.GetFranchiseResponse
+	46,  // 178: fantasy.FantasyService.GetFranchiseRoster:output_type -> fantasy.FranchiseRosterResponse
+	38,  // 179: fantasy.FantasyService.UpdateFranchise:output_type -> fantasy.FranchiseResponse
+	38,  // 180: fantasy.FantasyService.TransferFranchiseOwnership:output_type -> fantasy.FranchiseResponse
+	18,  // 181: fantasy.FantasyService.DeleteFranchise:output_type -> fantasy.DefaultResponse
+	51,  // 182: fantasy.FantasyService.GetFranchiseHistory:output_type -> fantasy.FranchiseHistoryResponse
+	18,  // 183: fantasy.FantasyService.AddFranchiseMember:output_type -> fantasy.DefaultResponse
+	18,  // 184: fantasy.FantasyService.RemoveFranchiseMember:output_type -> fantasy.DefaultResponse
+	54,  // 185: fantasy.FantasyService.GetFranchiseMembers:output_type -> fantasy.FranchiseMembersResponse
+	56,  // 186: fantasy.FantasyService.InviteFranchise:output_type -> fantasy.InvitationResponse
+	18,  // 187: fantasy.FantasyService.RevokeInvitation:output_type -> fantasy.DefaultResponse
+	38,  // 188: fantasy.FantasyService.ClaimFranchise:output_type -> fantasy.FranchiseResponse
+	61,  // 189: fantasy.FantasyService.CreateProspect:output_type -> fantasy.CreateProspectResponse
+	63,  // 190: fantasy.FantasyService.CreateProspectsBulk:output_type -> fantasy.CreateProspectsBulkResponse
+	66,  // 191: fantasy.FantasyService.UpdateProspect:output_type -> fantasy.ProspectResponse
+	66,  // 192: fantasy.FantasyService.MergeProspects:output_type -> fantasy.ProspectResponse
+	69,  // 193: fantasy.FantasyService.GetDuplicateProspects:output_type -> fantasy.DuplicateProspectsResponse
+	66,  // 194: fantasy.FantasyService.SetProspectStatus:output_type -> fantasy.ProspectResponse
+	73,  // 195: fantasy.FantasyService.GetProspectStatusHistory:output_type -> fantasy.ProspectStatusHistoryResponse
+	18,  // 196: fantasy.FantasyService.SubmitRanking:output_type -> fantasy.DefaultResponse
+	78,  // 197: fantasy.FantasyService.GetRankings:output_type -> fantasy.RankingsResponse
+	81,  // 198: fantasy.FantasyService.GetDraftBoard:output_type -> fantasy.DraftBoardResponse
+	119, // 199: fantasy.FantasyService.TextSearchProspects:output_type -> fantasy.ProspectsResponse
+	18,  // 200: fantasy.FantasyService.AddToWatchlist:output_type -> fantasy.DefaultResponse
+	18,  // 201: fantasy.FantasyService.UpdateWatchlistEntry:output_type -> fantasy.DefaultResponse
+	18,  // 202: fantasy.FantasyService.RemoveFromWatchlist:output_type -> fantasy.DefaultResponse
+	114, // 203: fantasy.FantasyService.GetWatchlist:output_type -> fantasy.WatchlistResponse
+	117, // 204: fantasy.FantasyService.GetProspectStats:output_type -> fantasy.ProspectStatsResponse
+	119, // 205: fantasy.FantasyService.GetProspectsByFranchise:output_type -> fantasy.ProspectsResponse
+	89,  // 206: fantasy.FantasyService.GetPicksByFranchise:output_type -> fantasy.GetPicksResponse
+	89,  // 207: fantasy.FantasyService.GetPicksByYear:output_type -> fantasy.GetPicksResponse
+	18,  // 208: fantasy.FantasyService.Trade:output_type -> fantasy.DefaultResponse
+	104, // 209: fantasy.FantasyService.EvaluateTrade:output_type -> fantasy.TradeEvaluationResponse
+	18,  // 210: fantasy.FantasyService.AddToTradeBlock:output_type -> fantasy.DefaultResponse
+	18,  // 211: fantasy.FantasyService.RemoveFromTradeBlock:output_type -> fantasy.DefaultResponse
+	109, // 212: fantasy.FantasyService.ListTradeBlock:output_type -> fantasy.TradeBlockResponse
+	18,  // 213: fantasy.FantasyService.SetTradeProfile:output_type -> fantasy.DefaultResponse
+	18,  // 214: fantasy.FantasyService.CreateOrUpdatePicks:output_type -> fantasy.DefaultResponse
+	10,  // 215: fantasy.FantasyService.GetPickConditions:output_type -> fantasy.PickConditionsResponse
+	12,  // 216: fantasy.FantasyService.GetPickSwaps:output_type -> fantasy.PickSwapsResponse
+	18,  // 217: fantasy.FantasyService.ForfeitPick:output_type -> fantasy.DefaultResponse
+	94,  // 218: fantasy.FantasyService.IssueSupplementalPick:output_type -> fantasy.PickResponse
+	119, // 219: fantasy.FantasyService.ListEligibleProspects:output_type -> fantasy.ProspectsResponse
+	18,  // 220: fantasy.FantasyService.DraftProspect:output_type -> fantasy.DefaultResponse
+	18,  // 221: fantasy.FantasyService.UndraftProspect:output_type -> fantasy.DefaultResponse
+	36,  // 222: fantasy.FantasyService.GetLeagueFranchisePairs:output_type -> fantasy.GetLeagueFranchisePairsResponse
+	163, // [163:223] is the sub-list for method output_type
+	103, // [103:163] is the sub-list for method input_type
+	103, // [103:103] is the sub-list for extension type_name
+	103, // [103:103] is the sub-list for extension extendee
+	0,   // [0:103] is the sub-list for field type_name
+}
+
+func init() { file_service_pb_fantasy_proto_init() }
+func file_service_pb_fantasy_proto_init() {
+	if File_service_pb_fantasy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_service_pb_fantasy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*League); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Franchise); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Prospect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pick); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PickCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PickConditionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PickSwap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PickSwapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NhlDraft); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DraftYearOffsets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EligibilityRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DraftPick); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DefaultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProspectFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeagueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeagueUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeagueActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneLeagueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Season); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloverSeasonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeasonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeasonsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeagueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaguesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaguesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeagueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeagueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeagueFranchisesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeagueFranchisePairsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeagueFranchisePair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeagueFranchisePairsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FranchiseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FranchiseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFranchiseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFranchiseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RosterProspect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PositionCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RosterUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PicksOfYear); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FranchiseRoster); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FranchiseRosterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FranchiseUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferFranchiseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFranchiseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FranchiseChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FranchiseHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FranchiseMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FranchiseMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FranchiseMembersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvitationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvitationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeInvitationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimFranchiseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProspect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProspectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProspectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProspectsBulkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProspectsBulkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProspectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeProspectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProspectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3123,8 +11882,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Franchise); i {
+		file_service_pb_fantasy_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DuplicateProspectsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3135,8 +11894,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Prospect); i {
+		file_service_pb_fantasy_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DuplicateProspects); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3147,8 +11906,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pick); i {
+		file_service_pb_fantasy_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DuplicateProspectsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3159,8 +11918,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DraftPick); i {
+		file_service_pb_fantasy_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProspectStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3171,8 +11930,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefaultResponse); i {
+		file_service_pb_fantasy_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProspectStatusHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3183,8 +11942,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeagueRequest); i {
+		file_service_pb_fantasy_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProspectStatusChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3195,8 +11954,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeagueUpdateRequest); i {
+		file_service_pb_fantasy_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProspectStatusHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3207,8 +11966,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeagueResponse); i {
+		file_service_pb_fantasy_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitRankingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3219,8 +11978,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLeaguesRequest); i {
+		file_service_pb_fantasy_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRankingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3231,8 +11990,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLeaguesResponse); i {
+		file_service_pb_fantasy_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RankedProspect); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3243,8 +12002,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLeagueRequest); i {
+		file_service_pb_fantasy_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ranking); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3255,8 +12014,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLeagueResponse); i {
+		file_service_pb_fantasy_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RankingsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3267,8 +12026,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLeagueFranchisesResponse); i {
+		file_service_pb_fantasy_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DraftBoardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3279,8 +12038,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLeagueFranchisePairsRequest); i {
+		file_service_pb_fantasy_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DraftBoardEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3291,8 +12050,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeagueFranchisePair); i {
+		file_service_pb_fantasy_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DraftBoardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3303,8 +12062,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLeagueFranchisePairsResponse); i {
+		file_service_pb_fantasy_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Standing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3315,8 +12074,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FranchiseRequest); i {
+		file_service_pb_fantasy_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StandingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3327,8 +12086,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FranchiseResponse); i {
+		file_service_pb_fantasy_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStandingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3339,8 +12098,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFranchiseRequest); i {
+		file_service_pb_fantasy_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StandingsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3351,8 +12110,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFranchiseResponse); i {
+		file_service_pb_fantasy_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PowerRankingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3363,8 +12122,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateProspect); i {
+		file_service_pb_fantasy_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PowerRanking); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3375,8 +12134,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateProspectRequest); i {
+		file_service_pb_fantasy_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PowerRankingsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3387,8 +12146,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateProspectResponse); i {
+		file_service_pb_fantasy_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPicksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3399,8 +12158,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateProspectsBulkRequest); i {
+		file_service_pb_fantasy_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrUpdatePick); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3411,8 +12170,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateProspectsBulkResponse); i {
+		file_service_pb_fantasy_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrUpdatePicksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3423,8 +12182,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPicksResponse); i {
+		file_service_pb_fantasy_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForfeitPickRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3435,8 +12194,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateOrUpdatePick); i {
+		file_service_pb_fantasy_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SupplementalPickRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3447,8 +12206,8 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateOrUpdatePicksRequest); i {
+		file_service_pb_fantasy_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PickResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3459,7 +12218,7 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_service_pb_fantasy_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPicksRequest); i {
 			case 0:
 				return &v.state
@@ -3471,7 +12230,7 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_service_pb_fantasy_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DraftRequest); i {
 			case 0:
 				return &v.state
@@ -3483,7 +12242,19 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_service_pb_fantasy_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EligibleProspectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TradePayload); i {
 			case 0:
 				return &v.state
@@ -3495,7 +12266,31 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_service_pb_fantasy_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapRight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConditionalPick); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TradeRequest); i {
 			case 0:
 				return &v.state
@@ -3507,7 +12302,199 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_service_pb_fantasy_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeSideValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeEvaluationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTradeBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeBlockEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWatchlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchlistEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchlistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProspectStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProspectSeasonStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProspectStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_pb_fantasy_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TextSearchRequest); i {
 			case 0:
 				return &v.state
@@ -3519,7 +12506,7 @@ func file_service_pb_fantasy_proto_init() {
 				return nil
 			}
 		}
-		file_service_pb_fantasy_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_service_pb_fantasy_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProspectsResponse); i {
 			case 0:
 				return &v.state
@@ -3537,13 +12524,14 @@ func file_service_pb_fantasy_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_pb_fantasy_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   35,
+			NumEnums:      5,
+			NumMessages:   115,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_service_pb_fantasy_proto_goTypes,
 		DependencyIndexes: file_service_pb_fantasy_proto_depIdxs,
+		EnumInfos:         file_service_pb_fantasy_proto_enumTypes,
 		MessageInfos:      file_service_pb_fantasy_proto_msgTypes,
 	}.Build()
 	File_service_pb_fantasy_proto = out.File
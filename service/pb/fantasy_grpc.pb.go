@@ -26,20 +26,61 @@ type FantasyServiceClient interface {
 	GetLeagues(ctx context.Context, in *GetLeaguesRequest, opts ...grpc.CallOption) (*GetLeaguesResponse, error)
 	GetLeague(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*GetLeagueResponse, error)
 	UpdateLeague(ctx context.Context, in *LeagueUpdateRequest, opts ...grpc.CallOption) (*LeagueResponse, error)
+	ArchiveLeague(ctx context.Context, in *LeagueActionRequest, opts ...grpc.CallOption) (*LeagueResponse, error)
+	DeleteLeague(ctx context.Context, in *LeagueActionRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	CloneLeagueForSeason(ctx context.Context, in *CloneLeagueRequest, opts ...grpc.CallOption) (*LeagueResponse, error)
+	RolloverSeason(ctx context.Context, in *RolloverSeasonRequest, opts ...grpc.CallOption) (*SeasonResponse, error)
+	GetSeasons(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*SeasonsResponse, error)
+	SetStandings(ctx context.Context, in *StandingsRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetStandings(ctx context.Context, in *GetStandingsRequest, opts ...grpc.CallOption) (*StandingsResponse, error)
+	GetPowerRankings(ctx context.Context, in *PowerRankingsRequest, opts ...grpc.CallOption) (*PowerRankingsResponse, error)
 	GetLeagueFranchises(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*GetLeagueFranchisesResponse, error)
 	CreateFranchise(ctx context.Context, in *FranchiseRequest, opts ...grpc.CallOption) (*FranchiseResponse, error)
 	GetFranchise(ctx context.Context, in *GetFranchiseRequest, opts ...grpc.CallOption) (*GetFranchiseResponse, error)
+	GetFranchiseRoster(ctx context.Context, in *GetFranchiseRequest, opts ...grpc.CallOption) (*FranchiseRosterResponse, error)
+	UpdateFranchise(ctx context.Context, in *FranchiseUpdateRequest, opts ...grpc.CallOption) (*FranchiseResponse, error)
+	TransferFranchiseOwnership(ctx context.Context, in *TransferFranchiseRequest, opts ...grpc.CallOption) (*FranchiseResponse, error)
+	DeleteFranchise(ctx context.Context, in *DeleteFranchiseRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetFranchiseHistory(ctx context.Context, in *GetFranchiseRequest, opts ...grpc.CallOption) (*FranchiseHistoryResponse, error)
+	AddFranchiseMember(ctx context.Context, in *FranchiseMemberRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	RemoveFranchiseMember(ctx context.Context, in *FranchiseMemberRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetFranchiseMembers(ctx context.Context, in *GetFranchiseRequest, opts ...grpc.CallOption) (*FranchiseMembersResponse, error)
+	InviteFranchise(ctx context.Context, in *InvitationRequest, opts ...grpc.CallOption) (*InvitationResponse, error)
+	RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	ClaimFranchise(ctx context.Context, in *ClaimFranchiseRequest, opts ...grpc.CallOption) (*FranchiseResponse, error)
 	CreateProspect(ctx context.Context, in *CreateProspectRequest, opts ...grpc.CallOption) (*CreateProspectResponse, error)
 	CreateProspectsBulk(ctx context.Context, in *CreateProspectsBulkRequest, opts ...grpc.CallOption) (*CreateProspectsBulkResponse, error)
+	UpdateProspect(ctx context.Context, in *UpdateProspectRequest, opts ...grpc.CallOption) (*ProspectResponse, error)
+	MergeProspects(ctx context.Context, in *MergeProspectsRequest, opts ...grpc.CallOption) (*ProspectResponse, error)
+	GetDuplicateProspects(ctx context.Context, in *DuplicateProspectsRequest, opts ...grpc.CallOption) (*DuplicateProspectsResponse, error)
+	SetProspectStatus(ctx context.Context, in *ProspectStatusRequest, opts ...grpc.CallOption) (*ProspectResponse, error)
+	GetProspectStatusHistory(ctx context.Context, in *ProspectStatusHistoryRequest, opts ...grpc.CallOption) (*ProspectStatusHistoryResponse, error)
+	SubmitRanking(ctx context.Context, in *SubmitRankingRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetRankings(ctx context.Context, in *GetRankingsRequest, opts ...grpc.CallOption) (*RankingsResponse, error)
+	GetDraftBoard(ctx context.Context, in *DraftBoardRequest, opts ...grpc.CallOption) (*DraftBoardResponse, error)
 	TextSearchProspects(ctx context.Context, in *TextSearchRequest, opts ...grpc.CallOption) (*ProspectsResponse, error)
+	AddToWatchlist(ctx context.Context, in *WatchlistRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	UpdateWatchlistEntry(ctx context.Context, in *WatchlistRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	RemoveFromWatchlist(ctx context.Context, in *WatchlistRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetWatchlist(ctx context.Context, in *GetWatchlistRequest, opts ...grpc.CallOption) (*WatchlistResponse, error)
+	GetProspectStats(ctx context.Context, in *GetProspectStatsRequest, opts ...grpc.CallOption) (*ProspectStatsResponse, error)
 	GetProspectsByFranchise(ctx context.Context, in *GetFranchiseRequest, opts ...grpc.CallOption) (*ProspectsResponse, error)
 	GetPicksByFranchise(ctx context.Context, in *GetPicksRequest, opts ...grpc.CallOption) (*GetPicksResponse, error)
 	GetPicksByYear(ctx context.Context, in *GetPicksRequest, opts ...grpc.CallOption) (*GetPicksResponse, error)
 	Trade(ctx context.Context, in *TradeRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	EvaluateTrade(ctx context.Context, in *TradeRequest, opts ...grpc.CallOption) (*TradeEvaluationResponse, error)
+	AddToTradeBlock(ctx context.Context, in *TradeBlockRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	RemoveFromTradeBlock(ctx context.Context, in *TradeBlockRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	ListTradeBlock(ctx context.Context, in *ListTradeBlockRequest, opts ...grpc.CallOption) (*TradeBlockResponse, error)
+	SetTradeProfile(ctx context.Context, in *TradeProfileRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	CreateOrUpdatePicks(ctx context.Context, in *CreateOrUpdatePicksRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetPickConditions(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*PickConditionsResponse, error)
+	GetPickSwaps(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*PickSwapsResponse, error)
+	ForfeitPick(ctx context.Context, in *ForfeitPickRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	IssueSupplementalPick(ctx context.Context, in *SupplementalPickRequest, opts ...grpc.CallOption) (*PickResponse, error)
+	ListEligibleProspects(ctx context.Context, in *EligibleProspectsRequest, opts ...grpc.CallOption) (*ProspectsResponse, error)
 	DraftProspect(ctx context.Context, in *DraftRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	UndraftProspect(ctx context.Context, in *DraftRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
-	//
 	GetLeagueFranchisePairs(ctx context.Context, in *GetLeagueFranchisePairsRequest, opts ...grpc.CallOption) (*GetLeagueFranchisePairsResponse, error)
 }
 
@@ -87,6 +128,78 @@ func (c *fantasyServiceClient) UpdateLeague(ctx context.Context, in *LeagueUpdat
 	return out, nil
 }
 
+func (c *fantasyServiceClient) ArchiveLeague(ctx context.Context, in *LeagueActionRequest, opts ...grpc.CallOption) (*LeagueResponse, error) {
+	out := new(LeagueResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/ArchiveLeague", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) DeleteLeague(ctx context.Context, in *LeagueActionRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/DeleteLeague", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) CloneLeagueForSeason(ctx context.Context, in *CloneLeagueRequest, opts ...grpc.CallOption) (*LeagueResponse, error) {
+	out := new(LeagueResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/CloneLeagueForSeason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) RolloverSeason(ctx context.Context, in *RolloverSeasonRequest, opts ...grpc.CallOption) (*SeasonResponse, error) {
+	out := new(SeasonResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/RolloverSeason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) GetSeasons(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*SeasonsResponse, error) {
+	out := new(SeasonsResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetSeasons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) SetStandings(ctx context.Context, in *StandingsRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/SetStandings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) GetStandings(ctx context.Context, in *GetStandingsRequest, opts ...grpc.CallOption) (*StandingsResponse, error) {
+	out := new(StandingsResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetStandings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) GetPowerRankings(ctx context.Context, in *PowerRankingsRequest, opts ...grpc.CallOption) (*PowerRankingsResponse, error) {
+	out := new(PowerRankingsResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetPowerRankings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fantasyServiceClient) GetLeagueFranchises(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*GetLeagueFranchisesResponse, error) {
 	out := new(GetLeagueFranchisesResponse)
 	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetLeagueFranchises", in, out, opts...)
@@ -114,6 +227,105 @@ func (c *fantasyServiceClient) GetFranchise(ctx context.Context, in *GetFranchis
 	return out, nil
 }
 
+func (c *fantasyServiceClient) GetFranchiseRoster(ctx context.Context, in *GetFranchiseRequest, opts ...grpc.CallOption) (*FranchiseRosterResponse, error) {
+	out := new(FranchiseRosterResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetFranchiseRoster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) UpdateFranchise(ctx context.Context, in *FranchiseUpdateRequest, opts ...grpc.CallOption) (*FranchiseResponse, error) {
+	out := new(FranchiseResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/UpdateFranchise", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) TransferFranchiseOwnership(ctx context.Context, in *TransferFranchiseRequest, opts ...grpc.CallOption) (*FranchiseResponse, error) {
+	out := new(FranchiseResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/TransferFranchiseOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) DeleteFranchise(ctx context.Context, in *DeleteFranchiseRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/DeleteFranchise", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) GetFranchiseHistory(ctx context.Context, in *GetFranchiseRequest, opts ...grpc.CallOption) (*FranchiseHistoryResponse, error) {
+	out := new(FranchiseHistoryResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetFranchiseHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) AddFranchiseMember(ctx context.Context, in *FranchiseMemberRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/AddFranchiseMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) RemoveFranchiseMember(ctx context.Context, in *FranchiseMemberRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/RemoveFranchiseMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) GetFranchiseMembers(ctx context.Context, in *GetFranchiseRequest, opts ...grpc.CallOption) (*FranchiseMembersResponse, error) {
+	out := new(FranchiseMembersResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetFranchiseMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) InviteFranchise(ctx context.Context, in *InvitationRequest, opts ...grpc.CallOption) (*InvitationResponse, error) {
+	out := new(InvitationResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/InviteFranchise", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/RevokeInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) ClaimFranchise(ctx context.Context, in *ClaimFranchiseRequest, opts ...grpc.CallOption) (*FranchiseResponse, error) {
+	out := new(FranchiseResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/ClaimFranchise", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fantasyServiceClient) CreateProspect(ctx context.Context, in *CreateProspectRequest, opts ...grpc.CallOption) (*CreateProspectResponse, error) {
 	out := new(CreateProspectResponse)
 	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/CreateProspect", in, out, opts...)
@@ -132,6 +344,78 @@ func (c *fantasyServiceClient) CreateProspectsBulk(ctx context.Context, in *Crea
 	return out, nil
 }
 
+func (c *fantasyServiceClient) UpdateProspect(ctx context.Context, in *UpdateProspectRequest, opts ...grpc.CallOption) (*ProspectResponse, error) {
+	out := new(ProspectResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/UpdateProspect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) MergeProspects(ctx context.Context, in *MergeProspectsRequest, opts ...grpc.CallOption) (*ProspectResponse, error) {
+	out := new(ProspectResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/MergeProspects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) GetDuplicateProspects(ctx context.Context, in *DuplicateProspectsRequest, opts ...grpc.CallOption) (*DuplicateProspectsResponse, error) {
+	out := new(DuplicateProspectsResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetDuplicateProspects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) SetProspectStatus(ctx context.Context, in *ProspectStatusRequest, opts ...grpc.CallOption) (*ProspectResponse, error) {
+	out := new(ProspectResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/SetProspectStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) GetProspectStatusHistory(ctx context.Context, in *ProspectStatusHistoryRequest, opts ...grpc.CallOption) (*ProspectStatusHistoryResponse, error) {
+	out := new(ProspectStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetProspectStatusHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) SubmitRanking(ctx context.Context, in *SubmitRankingRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/SubmitRanking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) GetRankings(ctx context.Context, in *GetRankingsRequest, opts ...grpc.CallOption) (*RankingsResponse, error) {
+	out := new(RankingsResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetRankings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) GetDraftBoard(ctx context.Context, in *DraftBoardRequest, opts ...grpc.CallOption) (*DraftBoardResponse, error) {
+	out := new(DraftBoardResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetDraftBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fantasyServiceClient) TextSearchProspects(ctx context.Context, in *TextSearchRequest, opts ...grpc.CallOption) (*ProspectsResponse, error) {
 	out := new(ProspectsResponse)
 	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/TextSearchProspects", in, out, opts...)
@@ -141,6 +425,51 @@ func (c *fantasyServiceClient) TextSearchProspects(ctx context.Context, in *Text
 	return out, nil
 }
 
+func (c *fantasyServiceClient) AddToWatchlist(ctx context.Context, in *WatchlistRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/AddToWatchlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) UpdateWatchlistEntry(ctx context.Context, in *WatchlistRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/UpdateWatchlistEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) RemoveFromWatchlist(ctx context.Context, in *WatchlistRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/RemoveFromWatchlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) GetWatchlist(ctx context.Context, in *GetWatchlistRequest, opts ...grpc.CallOption) (*WatchlistResponse, error) {
+	out := new(WatchlistResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetWatchlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) GetProspectStats(ctx context.Context, in *GetProspectStatsRequest, opts ...grpc.CallOption) (*ProspectStatsResponse, error) {
+	out := new(ProspectStatsResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetProspectStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fantasyServiceClient) GetProspectsByFranchise(ctx context.Context, in *GetFranchiseRequest, opts ...grpc.CallOption) (*ProspectsResponse, error) {
 	out := new(ProspectsResponse)
 	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetProspectsByFranchise", in, out, opts...)
@@ -177,74 +506,205 @@ func (c *fantasyServiceClient) Trade(ctx context.Context, in *TradeRequest, opts
 	return out, nil
 }
 
-func (c *fantasyServiceClient) CreateOrUpdatePicks(ctx context.Context, in *CreateOrUpdatePicksRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
-	out := new(DefaultResponse)
-	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/CreateOrUpdatePicks", in, out, opts...)
+func (c *fantasyServiceClient) EvaluateTrade(ctx context.Context, in *TradeRequest, opts ...grpc.CallOption) (*TradeEvaluationResponse, error) {
+	out := new(TradeEvaluationResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/EvaluateTrade", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *fantasyServiceClient) DraftProspect(ctx context.Context, in *DraftRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+func (c *fantasyServiceClient) AddToTradeBlock(ctx context.Context, in *TradeBlockRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
 	out := new(DefaultResponse)
-	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/DraftProspect", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/AddToTradeBlock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *fantasyServiceClient) UndraftProspect(ctx context.Context, in *DraftRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+func (c *fantasyServiceClient) RemoveFromTradeBlock(ctx context.Context, in *TradeBlockRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
 	out := new(DefaultResponse)
-	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/UndraftProspect", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/RemoveFromTradeBlock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *fantasyServiceClient) GetLeagueFranchisePairs(ctx context.Context, in *GetLeagueFranchisePairsRequest, opts ...grpc.CallOption) (*GetLeagueFranchisePairsResponse, error) {
-	out := new(GetLeagueFranchisePairsResponse)
-	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetLeagueFranchisePairs", in, out, opts...)
+func (c *fantasyServiceClient) ListTradeBlock(ctx context.Context, in *ListTradeBlockRequest, opts ...grpc.CallOption) (*TradeBlockResponse, error) {
+	out := new(TradeBlockResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/ListTradeBlock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// FantasyServiceServer is the server API for FantasyService service.
-// All implementations must embed UnimplementedFantasyServiceServer
-// for forward compatibility
-type FantasyServiceServer interface {
-	CreateLeague(context.Context, *LeagueRequest) (*LeagueResponse, error)
-	GetLeagues(context.Context, *GetLeaguesRequest) (*GetLeaguesResponse, error)
-	GetLeague(context.Context, *GetLeagueRequest) (*GetLeagueResponse, error)
-	UpdateLeague(context.Context, *LeagueUpdateRequest) (*LeagueResponse, error)
-	GetLeagueFranchises(context.Context, *GetLeagueRequest) (*GetLeagueFranchisesResponse, error)
-	CreateFranchise(context.Context, *FranchiseRequest) (*FranchiseResponse, error)
-	GetFranchise(context.Context, *GetFranchiseRequest) (*GetFranchiseResponse, error)
-	CreateProspect(context.Context, *CreateProspectRequest) (*CreateProspectResponse, error)
-	CreateProspectsBulk(context.Context, *CreateProspectsBulkRequest) (*CreateProspectsBulkResponse, error)
-	TextSearchProspects(context.Context, *TextSearchRequest) (*ProspectsResponse, error)
-	GetProspectsByFranchise(context.Context, *GetFranchiseRequest) (*ProspectsResponse, error)
-	GetPicksByFranchise(context.Context, *GetPicksRequest) (*GetPicksResponse, error)
-	GetPicksByYear(context.Context, *GetPicksRequest) (*GetPicksResponse, error)
-	Trade(context.Context, *TradeRequest) (*DefaultResponse, error)
-	CreateOrUpdatePicks(context.Context, *CreateOrUpdatePicksRequest) (*DefaultResponse, error)
-	DraftProspect(context.Context, *DraftRequest) (*DefaultResponse, error)
-	UndraftProspect(context.Context, *DraftRequest) (*DefaultResponse, error)
-	//
-	GetLeagueFranchisePairs(context.Context, *GetLeagueFranchisePairsRequest) (*GetLeagueFranchisePairsResponse, error)
-	mustEmbedUnimplementedFantasyServiceServer()
+func (c *fantasyServiceClient) SetTradeProfile(ctx context.Context, in *TradeProfileRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/SetTradeProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedFantasyServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedFantasyServiceServer struct {
+func (c *fantasyServiceClient) CreateOrUpdatePicks(ctx context.Context, in *CreateOrUpdatePicksRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/CreateOrUpdatePicks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedFantasyServiceServer) CreateLeague(context.Context, *LeagueRequest) (*LeagueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateLeague not implemented")
+func (c *fantasyServiceClient) GetPickConditions(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*PickConditionsResponse, error) {
+	out := new(PickConditionsResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetPickConditions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) GetPickSwaps(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*PickSwapsResponse, error) {
+	out := new(PickSwapsResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetPickSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) ForfeitPick(ctx context.Context, in *ForfeitPickRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/ForfeitPick", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) IssueSupplementalPick(ctx context.Context, in *SupplementalPickRequest, opts ...grpc.CallOption) (*PickResponse, error) {
+	out := new(PickResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/IssueSupplementalPick", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) ListEligibleProspects(ctx context.Context, in *EligibleProspectsRequest, opts ...grpc.CallOption) (*ProspectsResponse, error) {
+	out := new(ProspectsResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/ListEligibleProspects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) DraftProspect(ctx context.Context, in *DraftRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/DraftProspect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) UndraftProspect(ctx context.Context, in *DraftRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/UndraftProspect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fantasyServiceClient) GetLeagueFranchisePairs(ctx context.Context, in *GetLeagueFranchisePairsRequest, opts ...grpc.CallOption) (*GetLeagueFranchisePairsResponse, error) {
+	out := new(GetLeagueFranchisePairsResponse)
+	err := c.cc.Invoke(ctx, "/fantasy.FantasyService/GetLeagueFranchisePairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FantasyServiceServer is the server API for FantasyService service.
+// All implementations must embed UnimplementedFantasyServiceServer
+// for forward compatibility
+type FantasyServiceServer interface {
+	CreateLeague(context.Context, *LeagueRequest) (*LeagueResponse, error)
+	GetLeagues(context.Context, *GetLeaguesRequest) (*GetLeaguesResponse, error)
+	GetLeague(context.Context, *GetLeagueRequest) (*GetLeagueResponse, error)
+	UpdateLeague(context.Context, *LeagueUpdateRequest) (*LeagueResponse, error)
+	ArchiveLeague(context.Context, *LeagueActionRequest) (*LeagueResponse, error)
+	DeleteLeague(context.Context, *LeagueActionRequest) (*DefaultResponse, error)
+	CloneLeagueForSeason(context.Context, *CloneLeagueRequest) (*LeagueResponse, error)
+	RolloverSeason(context.Context, *RolloverSeasonRequest) (*SeasonResponse, error)
+	GetSeasons(context.Context, *GetLeagueRequest) (*SeasonsResponse, error)
+	SetStandings(context.Context, *StandingsRequest) (*DefaultResponse, error)
+	GetStandings(context.Context, *GetStandingsRequest) (*StandingsResponse, error)
+	GetPowerRankings(context.Context, *PowerRankingsRequest) (*PowerRankingsResponse, error)
+	GetLeagueFranchises(context.Context, *GetLeagueRequest) (*GetLeagueFranchisesResponse, error)
+	CreateFranchise(context.Context, *FranchiseRequest) (*FranchiseResponse, error)
+	GetFranchise(context.Context, *GetFranchiseRequest) (*GetFranchiseResponse, error)
+	GetFranchiseRoster(context.Context, *GetFranchiseRequest) (*FranchiseRosterResponse, error)
+	UpdateFranchise(context.Context, *FranchiseUpdateRequest) (*FranchiseResponse, error)
+	TransferFranchiseOwnership(context.Context, *TransferFranchiseRequest) (*FranchiseResponse, error)
+	DeleteFranchise(context.Context, *DeleteFranchiseRequest) (*DefaultResponse, error)
+	GetFranchiseHistory(context.Context, *GetFranchiseRequest) (*FranchiseHistoryResponse, error)
+	AddFranchiseMember(context.Context, *FranchiseMemberRequest) (*DefaultResponse, error)
+	RemoveFranchiseMember(context.Context, *FranchiseMemberRequest) (*DefaultResponse, error)
+	GetFranchiseMembers(context.Context, *GetFranchiseRequest) (*FranchiseMembersResponse, error)
+	InviteFranchise(context.Context, *InvitationRequest) (*InvitationResponse, error)
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*DefaultResponse, error)
+	ClaimFranchise(context.Context, *ClaimFranchiseRequest) (*FranchiseResponse, error)
+	CreateProspect(context.Context, *CreateProspectRequest) (*CreateProspectResponse, error)
+	CreateProspectsBulk(context.Context, *CreateProspectsBulkRequest) (*CreateProspectsBulkResponse, error)
+	UpdateProspect(context.Context, *UpdateProspectRequest) (*ProspectResponse, error)
+	MergeProspects(context.Context, *MergeProspectsRequest) (*ProspectResponse, error)
+	GetDuplicateProspects(context.Context, *DuplicateProspectsRequest) (*DuplicateProspectsResponse, error)
+	SetProspectStatus(context.Context, *ProspectStatusRequest) (*ProspectResponse, error)
+	GetProspectStatusHistory(context.Context, *ProspectStatusHistoryRequest) (*ProspectStatusHistoryResponse, error)
+	SubmitRanking(context.Context, *SubmitRankingRequest) (*DefaultResponse, error)
+	GetRankings(context.Context, *GetRankingsRequest) (*RankingsResponse, error)
+	GetDraftBoard(context.Context, *DraftBoardRequest) (*DraftBoardResponse, error)
+	TextSearchProspects(context.Context, *TextSearchRequest) (*ProspectsResponse, error)
+	AddToWatchlist(context.Context, *WatchlistRequest) (*DefaultResponse, error)
+	UpdateWatchlistEntry(context.Context, *WatchlistRequest) (*DefaultResponse, error)
+	RemoveFromWatchlist(context.Context, *WatchlistRequest) (*DefaultResponse, error)
+	GetWatchlist(context.Context, *GetWatchlistRequest) (*WatchlistResponse, error)
+	GetProspectStats(context.Context, *GetProspectStatsRequest) (*ProspectStatsResponse, error)
+	GetProspectsByFranchise(context.Context, *GetFranchiseRequest) (*ProspectsResponse, error)
+	GetPicksByFranchise(context.Context, *GetPicksRequest) (*GetPicksResponse, error)
+	GetPicksByYear(context.Context, *GetPicksRequest) (*GetPicksResponse, error)
+	Trade(context.Context, *TradeRequest) (*DefaultResponse, error)
+	EvaluateTrade(context.Context, *TradeRequest) (*TradeEvaluationResponse, error)
+	AddToTradeBlock(context.Context, *TradeBlockRequest) (*DefaultResponse, error)
+	RemoveFromTradeBlock(context.Context, *TradeBlockRequest) (*DefaultResponse, error)
+	ListTradeBlock(context.Context, *ListTradeBlockRequest) (*TradeBlockResponse, error)
+	SetTradeProfile(context.Context, *TradeProfileRequest) (*DefaultResponse, error)
+	CreateOrUpdatePicks(context.Context, *CreateOrUpdatePicksRequest) (*DefaultResponse, error)
+	GetPickConditions(context.Context, *GetLeagueRequest) (*PickConditionsResponse, error)
+	GetPickSwaps(context.Context, *GetLeagueRequest) (*PickSwapsResponse, error)
+	ForfeitPick(context.Context, *ForfeitPickRequest) (*DefaultResponse, error)
+	IssueSupplementalPick(context.Context, *SupplementalPickRequest) (*PickResponse, error)
+	ListEligibleProspects(context.Context, *EligibleProspectsRequest) (*ProspectsResponse, error)
+	DraftProspect(context.Context, *DraftRequest) (*DefaultResponse, error)
+	UndraftProspect(context.Context, *DraftRequest) (*DefaultResponse, error)
+	GetLeagueFranchisePairs(context.Context, *GetLeagueFranchisePairsRequest) (*GetLeagueFranchisePairsResponse, error)
+	mustEmbedUnimplementedFantasyServiceServer()
+}
+
+// UnimplementedFantasyServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFantasyServiceServer struct {
+}
+
+func (UnimplementedFantasyServiceServer) CreateLeague(context.Context, *LeagueRequest) (*LeagueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLeague not implemented")
 }
 func (UnimplementedFantasyServiceServer) GetLeagues(context.Context, *GetLeaguesRequest) (*GetLeaguesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLeagues not implemented")
@@ -255,6 +715,30 @@ func (UnimplementedFantasyServiceServer) GetLeague(context.Context, *GetLeagueRe
 func (UnimplementedFantasyServiceServer) UpdateLeague(context.Context, *LeagueUpdateRequest) (*LeagueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLeague not implemented")
 }
+func (UnimplementedFantasyServiceServer) ArchiveLeague(context.Context, *LeagueActionRequest) (*LeagueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveLeague not implemented")
+}
+func (UnimplementedFantasyServiceServer) DeleteLeague(context.Context, *LeagueActionRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLeague not implemented")
+}
+func (UnimplementedFantasyServiceServer) CloneLeagueForSeason(context.Context, *CloneLeagueRequest) (*LeagueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneLeagueForSeason not implemented")
+}
+func (UnimplementedFantasyServiceServer) RolloverSeason(context.Context, *RolloverSeasonRequest) (*SeasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolloverSeason not implemented")
+}
+func (UnimplementedFantasyServiceServer) GetSeasons(context.Context, *GetLeagueRequest) (*SeasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeasons not implemented")
+}
+func (UnimplementedFantasyServiceServer) SetStandings(context.Context, *StandingsRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStandings not implemented")
+}
+func (UnimplementedFantasyServiceServer) GetStandings(context.Context, *GetStandingsRequest) (*StandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStandings not implemented")
+}
+func (UnimplementedFantasyServiceServer) GetPowerRankings(context.Context, *PowerRankingsRequest) (*PowerRankingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPowerRankings not implemented")
+}
 func (UnimplementedFantasyServiceServer) GetLeagueFranchises(context.Context, *GetLeagueRequest) (*GetLeagueFranchisesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLeagueFranchises not implemented")
 }
@@ -264,15 +748,87 @@ func (UnimplementedFantasyServiceServer) CreateFranchise(context.Context, *Franc
 func (UnimplementedFantasyServiceServer) GetFranchise(context.Context, *GetFranchiseRequest) (*GetFranchiseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFranchise not implemented")
 }
+func (UnimplementedFantasyServiceServer) GetFranchiseRoster(context.Context, *GetFranchiseRequest) (*FranchiseRosterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFranchiseRoster not implemented")
+}
+func (UnimplementedFantasyServiceServer) UpdateFranchise(context.Context, *FranchiseUpdateRequest) (*FranchiseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFranchise not implemented")
+}
+func (UnimplementedFantasyServiceServer) TransferFranchiseOwnership(context.Context, *TransferFranchiseRequest) (*FranchiseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferFranchiseOwnership not implemented")
+}
+func (UnimplementedFantasyServiceServer) DeleteFranchise(context.Context, *DeleteFranchiseRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFranchise not implemented")
+}
+func (UnimplementedFantasyServiceServer) GetFranchiseHistory(context.Context, *GetFranchiseRequest) (*FranchiseHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFranchiseHistory not implemented")
+}
+func (UnimplementedFantasyServiceServer) AddFranchiseMember(context.Context, *FranchiseMemberRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFranchiseMember not implemented")
+}
+func (UnimplementedFantasyServiceServer) RemoveFranchiseMember(context.Context, *FranchiseMemberRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFranchiseMember not implemented")
+}
+func (UnimplementedFantasyServiceServer) GetFranchiseMembers(context.Context, *GetFranchiseRequest) (*FranchiseMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFranchiseMembers not implemented")
+}
+func (UnimplementedFantasyServiceServer) InviteFranchise(context.Context, *InvitationRequest) (*InvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteFranchise not implemented")
+}
+func (UnimplementedFantasyServiceServer) RevokeInvitation(context.Context, *RevokeInvitationRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvitation not implemented")
+}
+func (UnimplementedFantasyServiceServer) ClaimFranchise(context.Context, *ClaimFranchiseRequest) (*FranchiseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimFranchise not implemented")
+}
 func (UnimplementedFantasyServiceServer) CreateProspect(context.Context, *CreateProspectRequest) (*CreateProspectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProspect not implemented")
 }
 func (UnimplementedFantasyServiceServer) CreateProspectsBulk(context.Context, *CreateProspectsBulkRequest) (*CreateProspectsBulkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProspectsBulk not implemented")
 }
+func (UnimplementedFantasyServiceServer) UpdateProspect(context.Context, *UpdateProspectRequest) (*ProspectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProspect not implemented")
+}
+func (UnimplementedFantasyServiceServer) MergeProspects(context.Context, *MergeProspectsRequest) (*ProspectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeProspects not implemented")
+}
+func (UnimplementedFantasyServiceServer) GetDuplicateProspects(context.Context, *DuplicateProspectsRequest) (*DuplicateProspectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDuplicateProspects not implemented")
+}
+func (UnimplementedFantasyServiceServer) SetProspectStatus(context.Context, *ProspectStatusRequest) (*ProspectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProspectStatus not implemented")
+}
+func (UnimplementedFantasyServiceServer) GetProspectStatusHistory(context.Context, *ProspectStatusHistoryRequest) (*ProspectStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProspectStatusHistory not implemented")
+}
+func (UnimplementedFantasyServiceServer) SubmitRanking(context.Context, *SubmitRankingRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitRanking not implemented")
+}
+func (UnimplementedFantasyServiceServer) GetRankings(context.Context, *GetRankingsRequest) (*RankingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRankings not implemented")
+}
+func (UnimplementedFantasyServiceServer) GetDraftBoard(context.Context, *DraftBoardRequest) (*DraftBoardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDraftBoard not implemented")
+}
 func (UnimplementedFantasyServiceServer) TextSearchProspects(context.Context, *TextSearchRequest) (*ProspectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TextSearchProspects not implemented")
 }
+func (UnimplementedFantasyServiceServer) AddToWatchlist(context.Context, *WatchlistRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToWatchlist not implemented")
+}
+func (UnimplementedFantasyServiceServer) UpdateWatchlistEntry(context.Context, *WatchlistRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWatchlistEntry not implemented")
+}
+func (UnimplementedFantasyServiceServer) RemoveFromWatchlist(context.Context, *WatchlistRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWatchlist not implemented")
+}
+func (UnimplementedFantasyServiceServer) GetWatchlist(context.Context, *GetWatchlistRequest) (*WatchlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWatchlist not implemented")
+}
+func (UnimplementedFantasyServiceServer) GetProspectStats(context.Context, *GetProspectStatsRequest) (*ProspectStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProspectStats not implemented")
+}
 func (UnimplementedFantasyServiceServer) GetProspectsByFranchise(context.Context, *GetFranchiseRequest) (*ProspectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProspectsByFranchise not implemented")
 }
@@ -285,297 +841,1083 @@ func (UnimplementedFantasyServiceServer) GetPicksByYear(context.Context, *GetPic
 func (UnimplementedFantasyServiceServer) Trade(context.Context, *TradeRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Trade not implemented")
 }
+func (UnimplementedFantasyServiceServer) EvaluateTrade(context.Context, *TradeRequest) (*TradeEvaluationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateTrade not implemented")
+}
+func (UnimplementedFantasyServiceServer) AddToTradeBlock(context.Context, *TradeBlockRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToTradeBlock not implemented")
+}
+func (UnimplementedFantasyServiceServer) RemoveFromTradeBlock(context.Context, *TradeBlockRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromTradeBlock not implemented")
+}
+func (UnimplementedFantasyServiceServer) ListTradeBlock(context.Context, *ListTradeBlockRequest) (*TradeBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTradeBlock not implemented")
+}
+func (UnimplementedFantasyServiceServer) SetTradeProfile(context.Context, *TradeProfileRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTradeProfile not implemented")
+}
 func (UnimplementedFantasyServiceServer) CreateOrUpdatePicks(context.Context, *CreateOrUpdatePicksRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrUpdatePicks not implemented")
 }
+func (UnimplementedFantasyServiceServer) GetPickConditions(context.Context, *GetLeagueRequest) (*PickConditionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPickConditions not implemented")
+}
+func (UnimplementedFantasyServiceServer) GetPickSwaps(context.Context, *GetLeagueRequest) (*PickSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPickSwaps not implemented")
+}
+func (UnimplementedFantasyServiceServer) ForfeitPick(context.Context, *ForfeitPickRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForfeitPick not implemented")
+}
+func (UnimplementedFantasyServiceServer) IssueSupplementalPick(context.Context, *SupplementalPickRequest) (*PickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueSupplementalPick not implemented")
+}
+func (UnimplementedFantasyServiceServer) ListEligibleProspects(context.Context, *EligibleProspectsRequest) (*ProspectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEligibleProspects not implemented")
+}
 func (UnimplementedFantasyServiceServer) DraftProspect(context.Context, *DraftRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DraftProspect not implemented")
 }
-func (UnimplementedFantasyServiceServer) UndraftProspect(context.Context, *DraftRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UndraftProspect not implemented")
+func (UnimplementedFantasyServiceServer) UndraftProspect(context.Context, *DraftRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndraftProspect not implemented")
+}
+func (UnimplementedFantasyServiceServer) GetLeagueFranchisePairs(context.Context, *GetLeagueFranchisePairsRequest) (*GetLeagueFranchisePairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeagueFranchisePairs not implemented")
+}
+func (UnimplementedFantasyServiceServer) mustEmbedUnimplementedFantasyServiceServer() {}
+
+// UnsafeFantasyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FantasyServiceServer will
+// result in compilation errors.
+type UnsafeFantasyServiceServer interface {
+	mustEmbedUnimplementedFantasyServiceServer()
+}
+
+func RegisterFantasyServiceServer(s grpc.ServiceRegistrar, srv FantasyServiceServer) {
+	s.RegisterService(&FantasyService_ServiceDesc, srv)
+}
+
+func _FantasyService_CreateLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeagueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).CreateLeague(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/CreateLeague",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).CreateLeague(ctx, req.(*LeagueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_GetLeagues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaguesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).GetLeagues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/GetLeagues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).GetLeagues(ctx, req.(*GetLeaguesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_GetLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeagueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).GetLeague(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/GetLeague",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).GetLeague(ctx, req.(*GetLeagueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_UpdateLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeagueUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).UpdateLeague(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/UpdateLeague",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).UpdateLeague(ctx, req.(*LeagueUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_ArchiveLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeagueActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).ArchiveLeague(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/ArchiveLeague",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).ArchiveLeague(ctx, req.(*LeagueActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_DeleteLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeagueActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).DeleteLeague(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/DeleteLeague",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).DeleteLeague(ctx, req.(*LeagueActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_CloneLeagueForSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneLeagueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).CloneLeagueForSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/CloneLeagueForSeason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).CloneLeagueForSeason(ctx, req.(*CloneLeagueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_RolloverSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloverSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).RolloverSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/RolloverSeason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).RolloverSeason(ctx, req.(*RolloverSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_GetSeasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeagueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).GetSeasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/GetSeasons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).GetSeasons(ctx, req.(*GetLeagueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_SetStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).SetStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/SetStandings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).SetStandings(ctx, req.(*StandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_GetStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).GetStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/GetStandings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).GetStandings(ctx, req.(*GetStandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_GetPowerRankings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PowerRankingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).GetPowerRankings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/GetPowerRankings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).GetPowerRankings(ctx, req.(*PowerRankingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_GetLeagueFranchises_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeagueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).GetLeagueFranchises(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/GetLeagueFranchises",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).GetLeagueFranchises(ctx, req.(*GetLeagueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_CreateFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FranchiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).CreateFranchise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/CreateFranchise",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).CreateFranchise(ctx, req.(*FranchiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_GetFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFranchiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).GetFranchise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/GetFranchise",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).GetFranchise(ctx, req.(*GetFranchiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_GetFranchiseRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFranchiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).GetFranchiseRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/GetFranchiseRoster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).GetFranchiseRoster(ctx, req.(*GetFranchiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_UpdateFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FranchiseUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).UpdateFranchise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/UpdateFranchise",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).UpdateFranchise(ctx, req.(*FranchiseUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_TransferFranchiseOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferFranchiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).TransferFranchiseOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/TransferFranchiseOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).TransferFranchiseOwnership(ctx, req.(*TransferFranchiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_DeleteFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFranchiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).DeleteFranchise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/DeleteFranchise",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).DeleteFranchise(ctx, req.(*DeleteFranchiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_GetFranchiseHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFranchiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).GetFranchiseHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/GetFranchiseHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).GetFranchiseHistory(ctx, req.(*GetFranchiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_AddFranchiseMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FranchiseMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).AddFranchiseMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/AddFranchiseMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).AddFranchiseMember(ctx, req.(*FranchiseMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_RemoveFranchiseMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FranchiseMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).RemoveFranchiseMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/RemoveFranchiseMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).RemoveFranchiseMember(ctx, req.(*FranchiseMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_GetFranchiseMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFranchiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).GetFranchiseMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/GetFranchiseMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).GetFranchiseMembers(ctx, req.(*GetFranchiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_InviteFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).InviteFranchise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/InviteFranchise",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).InviteFranchise(ctx, req.(*InvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).RevokeInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/RevokeInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_ClaimFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimFranchiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).ClaimFranchise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/ClaimFranchise",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).ClaimFranchise(ctx, req.(*ClaimFranchiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_CreateProspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProspectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).CreateProspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/CreateProspect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).CreateProspect(ctx, req.(*CreateProspectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_CreateProspectsBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProspectsBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).CreateProspectsBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/CreateProspectsBulk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).CreateProspectsBulk(ctx, req.(*CreateProspectsBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_UpdateProspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProspectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).UpdateProspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/UpdateProspect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).UpdateProspect(ctx, req.(*UpdateProspectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_MergeProspects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeProspectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).MergeProspects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/MergeProspects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).MergeProspects(ctx, req.(*MergeProspectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_GetDuplicateProspects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateProspectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).GetDuplicateProspects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/GetDuplicateProspects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).GetDuplicateProspects(ctx, req.(*DuplicateProspectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_SetProspectStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProspectStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).SetProspectStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/SetProspectStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).SetProspectStatus(ctx, req.(*ProspectStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_GetProspectStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProspectStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).GetProspectStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/GetProspectStatusHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).GetProspectStatusHistory(ctx, req.(*ProspectStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_SubmitRanking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitRankingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).SubmitRanking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/SubmitRanking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).SubmitRanking(ctx, req.(*SubmitRankingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_GetRankings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRankingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).GetRankings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/GetRankings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).GetRankings(ctx, req.(*GetRankingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_GetDraftBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DraftBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).GetDraftBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/GetDraftBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).GetDraftBoard(ctx, req.(*DraftBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_TextSearchProspects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TextSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).TextSearchProspects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/TextSearchProspects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).TextSearchProspects(ctx, req.(*TextSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_AddToWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).AddToWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/AddToWatchlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).AddToWatchlist(ctx, req.(*WatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FantasyService_UpdateWatchlistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).UpdateWatchlistEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/UpdateWatchlistEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).UpdateWatchlistEntry(ctx, req.(*WatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFantasyServiceServer) GetLeagueFranchisePairs(context.Context, *GetLeagueFranchisePairsRequest) (*GetLeagueFranchisePairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLeagueFranchisePairs not implemented")
+
+func _FantasyService_RemoveFromWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).RemoveFromWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/RemoveFromWatchlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).RemoveFromWatchlist(ctx, req.(*WatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFantasyServiceServer) mustEmbedUnimplementedFantasyServiceServer() {}
 
-// UnsafeFantasyServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to FantasyServiceServer will
-// result in compilation errors.
-type UnsafeFantasyServiceServer interface {
-	mustEmbedUnimplementedFantasyServiceServer()
+func _FantasyService_GetWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).GetWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/GetWatchlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).GetWatchlist(ctx, req.(*GetWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterFantasyServiceServer(s grpc.ServiceRegistrar, srv FantasyServiceServer) {
-	s.RegisterService(&FantasyService_ServiceDesc, srv)
+func _FantasyService_GetProspectStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProspectStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FantasyServiceServer).GetProspectStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fantasy.FantasyService/GetProspectStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FantasyServiceServer).GetProspectStats(ctx, req.(*GetProspectStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _FantasyService_CreateLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeagueRequest)
+func _FantasyService_GetProspectsByFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFranchiseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FantasyServiceServer).CreateLeague(ctx, in)
+		return srv.(FantasyServiceServer).GetProspectsByFranchise(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fantasy.FantasyService/CreateLeague",
+		FullMethod: "/fantasy.FantasyService/GetProspectsByFranchise",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FantasyServiceServer).CreateLeague(ctx, req.(*LeagueRequest))
+		return srv.(FantasyServiceServer).GetProspectsByFranchise(ctx, req.(*GetFranchiseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FantasyService_GetLeagues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLeaguesRequest)
+func _FantasyService_GetPicksByFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPicksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FantasyServiceServer).GetLeagues(ctx, in)
+		return srv.(FantasyServiceServer).GetPicksByFranchise(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fantasy.FantasyService/GetLeagues",
+		FullMethod: "/fantasy.FantasyService/GetPicksByFranchise",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FantasyServiceServer).GetLeagues(ctx, req.(*GetLeaguesRequest))
+		return srv.(FantasyServiceServer).GetPicksByFranchise(ctx, req.(*GetPicksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FantasyService_GetLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLeagueRequest)
+func _FantasyService_GetPicksByYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPicksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FantasyServiceServer).GetLeague(ctx, in)
+		return srv.(FantasyServiceServer).GetPicksByYear(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fantasy.FantasyService/GetLeague",
+		FullMethod: "/fantasy.FantasyService/GetPicksByYear",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FantasyServiceServer).GetLeague(ctx, req.(*GetLeagueRequest))
+		return srv.(FantasyServiceServer).GetPicksByYear(ctx, req.(*GetPicksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FantasyService_UpdateLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeagueUpdateRequest)
+func _FantasyService_Trade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FantasyServiceServer).UpdateLeague(ctx, in)
+		return srv.(FantasyServiceServer).Trade(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fantasy.FantasyService/UpdateLeague",
+		FullMethod: "/fantasy.FantasyService/Trade",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FantasyServiceServer).UpdateLeague(ctx, req.(*LeagueUpdateRequest))
+		return srv.(FantasyServiceServer).Trade(ctx, req.(*TradeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FantasyService_GetLeagueFranchises_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLeagueRequest)
+func _FantasyService_EvaluateTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FantasyServiceServer).GetLeagueFranchises(ctx, in)
+		return srv.(FantasyServiceServer).EvaluateTrade(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fantasy.FantasyService/GetLeagueFranchises",
+		FullMethod: "/fantasy.FantasyService/EvaluateTrade",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FantasyServiceServer).GetLeagueFranchises(ctx, req.(*GetLeagueRequest))
+		return srv.(FantasyServiceServer).EvaluateTrade(ctx, req.(*TradeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FantasyService_CreateFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FranchiseRequest)
+func _FantasyService_AddToTradeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeBlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FantasyServiceServer).CreateFranchise(ctx, in)
+		return srv.(FantasyServiceServer).AddToTradeBlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fantasy.FantasyService/CreateFranchise",
+		FullMethod: "/fantasy.FantasyService/AddToTradeBlock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FantasyServiceServer).CreateFranchise(ctx, req.(*FranchiseRequest))
+		return srv.(FantasyServiceServer).AddToTradeBlock(ctx, req.(*TradeBlockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FantasyService_GetFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFranchiseRequest)
+func _FantasyService_RemoveFromTradeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeBlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FantasyServiceServer).GetFranchise(ctx, in)
+		return srv.(FantasyServiceServer).RemoveFromTradeBlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fantasy.FantasyService/GetFranchise",
+		FullMethod: "/fantasy.FantasyService/RemoveFromTradeBlock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FantasyServiceServer).GetFranchise(ctx, req.(*GetFranchiseRequest))
+		return srv.(FantasyServiceServer).RemoveFromTradeBlock(ctx, req.(*TradeBlockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FantasyService_CreateProspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProspectRequest)
+func _FantasyService_ListTradeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTradeBlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FantasyServiceServer).CreateProspect(ctx, in)
+		return srv.(FantasyServiceServer).ListTradeBlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fantasy.FantasyService/CreateProspect",
+		FullMethod: "/fantasy.FantasyService/ListTradeBlock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FantasyServiceServer).CreateProspect(ctx, req.(*CreateProspectRequest))
+		return srv.(FantasyServiceServer).ListTradeBlock(ctx, req.(*ListTradeBlockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FantasyService_CreateProspectsBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProspectsBulkRequest)
+func _FantasyService_SetTradeProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FantasyServiceServer).CreateProspectsBulk(ctx, in)
+		return srv.(FantasyServiceServer).SetTradeProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fantasy.FantasyService/CreateProspectsBulk",
+		FullMethod: "/fantasy.FantasyService/SetTradeProfile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FantasyServiceServer).CreateProspectsBulk(ctx, req.(*CreateProspectsBulkRequest))
+		return srv.(FantasyServiceServer).SetTradeProfile(ctx, req.(*TradeProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FantasyService_TextSearchProspects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TextSearchRequest)
+func _FantasyService_CreateOrUpdatePicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrUpdatePicksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FantasyServiceServer).TextSearchProspects(ctx, in)
+		return srv.(FantasyServiceServer).CreateOrUpdatePicks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fantasy.FantasyService/TextSearchProspects",
+		FullMethod: "/fantasy.FantasyService/CreateOrUpdatePicks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FantasyServiceServer).TextSearchProspects(ctx, req.(*TextSearchRequest))
+		return srv.(FantasyServiceServer).CreateOrUpdatePicks(ctx, req.(*CreateOrUpdatePicksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FantasyService_GetProspectsByFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFranchiseRequest)
+func _FantasyService_GetPickConditions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeagueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FantasyServiceServer).GetProspectsByFranchise(ctx, in)
+		return srv.(FantasyServiceServer).GetPickConditions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fantasy.FantasyService/GetProspectsByFranchise",
+		FullMethod: "/fantasy.FantasyService/GetPickConditions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FantasyServiceServer).GetProspectsByFranchise(ctx, req.(*GetFranchiseRequest))
+		return srv.(FantasyServiceServer).GetPickConditions(ctx, req.(*GetLeagueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FantasyService_GetPicksByFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPicksRequest)
+func _FantasyService_GetPickSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeagueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FantasyServiceServer).GetPicksByFranchise(ctx, in)
+		return srv.(FantasyServiceServer).GetPickSwaps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fantasy.FantasyService/GetPicksByFranchise",
+		FullMethod: "/fantasy.FantasyService/GetPickSwaps",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FantasyServiceServer).GetPicksByFranchise(ctx, req.(*GetPicksRequest))
+		return srv.(FantasyServiceServer).GetPickSwaps(ctx, req.(*GetLeagueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FantasyService_GetPicksByYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPicksRequest)
+func _FantasyService_ForfeitPick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForfeitPickRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FantasyServiceServer).GetPicksByYear(ctx, in)
+		return srv.(FantasyServiceServer).ForfeitPick(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fantasy.FantasyService/GetPicksByYear",
+		FullMethod: "/fantasy.FantasyService/ForfeitPick",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FantasyServiceServer).GetPicksByYear(ctx, req.(*GetPicksRequest))
+		return srv.(FantasyServiceServer).ForfeitPick(ctx, req.(*ForfeitPickRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FantasyService_Trade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TradeRequest)
+func _FantasyService_IssueSupplementalPick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplementalPickRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FantasyServiceServer).Trade(ctx, in)
+		return srv.(FantasyServiceServer).IssueSupplementalPick(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fantasy.FantasyService/Trade",
+		FullMethod: "/fantasy.FantasyService/IssueSupplementalPick",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FantasyServiceServer).Trade(ctx, req.(*TradeRequest))
+		return srv.(FantasyServiceServer).IssueSupplementalPick(ctx, req.(*SupplementalPickRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FantasyService_CreateOrUpdatePicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateOrUpdatePicksRequest)
+func _FantasyService_ListEligibleProspects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EligibleProspectsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FantasyServiceServer).CreateOrUpdatePicks(ctx, in)
+		return srv.(FantasyServiceServer).ListEligibleProspects(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fantasy.FantasyService/CreateOrUpdatePicks",
+		FullMethod: "/fantasy.FantasyService/ListEligibleProspects",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FantasyServiceServer).CreateOrUpdatePicks(ctx, req.(*CreateOrUpdatePicksRequest))
+		return srv.(FantasyServiceServer).ListEligibleProspects(ctx, req.(*EligibleProspectsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -657,6 +1999,38 @@ var FantasyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateLeague",
 			Handler:    _FantasyService_UpdateLeague_Handler,
 		},
+		{
+			MethodName: "ArchiveLeague",
+			Handler:    _FantasyService_ArchiveLeague_Handler,
+		},
+		{
+			MethodName: "DeleteLeague",
+			Handler:    _FantasyService_DeleteLeague_Handler,
+		},
+		{
+			MethodName: "CloneLeagueForSeason",
+			Handler:    _FantasyService_CloneLeagueForSeason_Handler,
+		},
+		{
+			MethodName: "RolloverSeason",
+			Handler:    _FantasyService_RolloverSeason_Handler,
+		},
+		{
+			MethodName: "GetSeasons",
+			Handler:    _FantasyService_GetSeasons_Handler,
+		},
+		{
+			MethodName: "SetStandings",
+			Handler:    _FantasyService_SetStandings_Handler,
+		},
+		{
+			MethodName: "GetStandings",
+			Handler:    _FantasyService_GetStandings_Handler,
+		},
+		{
+			MethodName: "GetPowerRankings",
+			Handler:    _FantasyService_GetPowerRankings_Handler,
+		},
 		{
 			MethodName: "GetLeagueFranchises",
 			Handler:    _FantasyService_GetLeagueFranchises_Handler,
@@ -669,6 +2043,50 @@ var FantasyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFranchise",
 			Handler:    _FantasyService_GetFranchise_Handler,
 		},
+		{
+			MethodName: "GetFranchiseRoster",
+			Handler:    _FantasyService_GetFranchiseRoster_Handler,
+		},
+		{
+			MethodName: "UpdateFranchise",
+			Handler:    _FantasyService_UpdateFranchise_Handler,
+		},
+		{
+			MethodName: "TransferFranchiseOwnership",
+			Handler:    _FantasyService_TransferFranchiseOwnership_Handler,
+		},
+		{
+			MethodName: "DeleteFranchise",
+			Handler:    _FantasyService_DeleteFranchise_Handler,
+		},
+		{
+			MethodName: "GetFranchiseHistory",
+			Handler:    _FantasyService_GetFranchiseHistory_Handler,
+		},
+		{
+			MethodName: "AddFranchiseMember",
+			Handler:    _FantasyService_AddFranchiseMember_Handler,
+		},
+		{
+			MethodName: "RemoveFranchiseMember",
+			Handler:    _FantasyService_RemoveFranchiseMember_Handler,
+		},
+		{
+			MethodName: "GetFranchiseMembers",
+			Handler:    _FantasyService_GetFranchiseMembers_Handler,
+		},
+		{
+			MethodName: "InviteFranchise",
+			Handler:    _FantasyService_InviteFranchise_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _FantasyService_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "ClaimFranchise",
+			Handler:    _FantasyService_ClaimFranchise_Handler,
+		},
 		{
 			MethodName: "CreateProspect",
 			Handler:    _FantasyService_CreateProspect_Handler,
@@ -677,10 +2095,62 @@ var FantasyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateProspectsBulk",
 			Handler:    _FantasyService_CreateProspectsBulk_Handler,
 		},
+		{
+			MethodName: "UpdateProspect",
+			Handler:    _FantasyService_UpdateProspect_Handler,
+		},
+		{
+			MethodName: "MergeProspects",
+			Handler:    _FantasyService_MergeProspects_Handler,
+		},
+		{
+			MethodName: "GetDuplicateProspects",
+			Handler:    _FantasyService_GetDuplicateProspects_Handler,
+		},
+		{
+			MethodName: "SetProspectStatus",
+			Handler:    _FantasyService_SetProspectStatus_Handler,
+		},
+		{
+			MethodName: "GetProspectStatusHistory",
+			Handler:    _FantasyService_GetProspectStatusHistory_Handler,
+		},
+		{
+			MethodName: "SubmitRanking",
+			Handler:    _FantasyService_SubmitRanking_Handler,
+		},
+		{
+			MethodName: "GetRankings",
+			Handler:    _FantasyService_GetRankings_Handler,
+		},
+		{
+			MethodName: "GetDraftBoard",
+			Handler:    _FantasyService_GetDraftBoard_Handler,
+		},
 		{
 			MethodName: "TextSearchProspects",
 			Handler:    _FantasyService_TextSearchProspects_Handler,
 		},
+		{
+			MethodName: "AddToWatchlist",
+			Handler:    _FantasyService_AddToWatchlist_Handler,
+		},
+		{
+			MethodName: "UpdateWatchlistEntry",
+			Handler:    _FantasyService_UpdateWatchlistEntry_Handler,
+		},
+		{
+			MethodName: "RemoveFromWatchlist",
+			Handler:    _FantasyService_RemoveFromWatchlist_Handler,
+		},
+		{
+			MethodName: "GetWatchlist",
+			Handler:    _FantasyService_GetWatchlist_Handler,
+		},
+		{
+			MethodName: "GetProspectStats",
+			Handler:    _FantasyService_GetProspectStats_Handler,
+		},
 		{
 			MethodName: "GetProspectsByFranchise",
 			Handler:    _FantasyService_GetProspectsByFranchise_Handler,
@@ -697,10 +2167,50 @@ var FantasyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Trade",
 			Handler:    _FantasyService_Trade_Handler,
 		},
+		{
+			MethodName: "EvaluateTrade",
+			Handler:    _FantasyService_EvaluateTrade_Handler,
+		},
+		{
+			MethodName: "AddToTradeBlock",
+			Handler:    _FantasyService_AddToTradeBlock_Handler,
+		},
+		{
+			MethodName: "RemoveFromTradeBlock",
+			Handler:    _FantasyService_RemoveFromTradeBlock_Handler,
+		},
+		{
+			MethodName: "ListTradeBlock",
+			Handler:    _FantasyService_ListTradeBlock_Handler,
+		},
+		{
+			MethodName: "SetTradeProfile",
+			Handler:    _FantasyService_SetTradeProfile_Handler,
+		},
 		{
 			MethodName: "CreateOrUpdatePicks",
 			Handler:    _FantasyService_CreateOrUpdatePicks_Handler,
 		},
+		{
+			MethodName: "GetPickConditions",
+			Handler:    _FantasyService_GetPickConditions_Handler,
+		},
+		{
+			MethodName: "GetPickSwaps",
+			Handler:    _FantasyService_GetPickSwaps_Handler,
+		},
+		{
+			MethodName: "ForfeitPick",
+			Handler:    _FantasyService_ForfeitPick_Handler,
+		},
+		{
+			MethodName: "IssueSupplementalPick",
+			Handler:    _FantasyService_IssueSupplementalPick_Handler,
+		},
+		{
+			MethodName: "ListEligibleProspects",
+			Handler:    _FantasyService_ListEligibleProspects_Handler,
+		},
 		{
 			MethodName: "DraftProspect",
 			Handler:    _FantasyService_DraftProspect_Handler,
@@ -3,95 +3,156 @@ package service
 import (
 	"context"
 	"fmt"
+	"math"
 	"net/http"
+	"sort"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hiltpold/lakelandcup-fantasy-service/models"
 	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
-	"github.com/sirupsen/logrus"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
 )
 
 func (s *Server) CreateOrUpdatePicks(ctx context.Context, req *pb.CreateOrUpdatePicksRequest) (*pb.DefaultResponse, error) {
-	var nFranchises int64
-
-	var league models.League
 	const leagueName = "Lakelandcup"
 
+	lId, err := uuid.Parse(req.LeagueID)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.LeagueID),
+		}, nil
+	}
+
 	// get league
-	if findLeague := s.R.DB.Where(&models.League{ID: uuid.MustParse(req.LeagueID), Name: leagueName}).First(&league); findLeague.Error != nil {
+	league, err := managedLeague(ctx, s.R, lId, req.UserId)
+	if err == nil && league.Name != leagueName {
+		err = storage.ErrNotFound
+	}
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: authStatus(err, http.StatusConflict),
+			Error:  fmt.Sprintf("League does't exist. Error %v", err),
+		}, nil
+	}
+	if err := writable(league); err != nil {
 		return &pb.DefaultResponse{
 			Status: http.StatusConflict,
-			Error:  fmt.Sprintf("League does't exist. Error %v", findLeague.Error),
+			Error:  err.Error(),
 		}, nil
 	}
 
-	// get franchise count
-	s.R.DB.Model(&models.Franchise{}).Count(&nFranchises)
-
+	// the positions by franchise and year
+	type position struct {
+		franchiseID uuid.UUID
+		name        string
+		year        int
+		pick        *pb.CreateOrUpdatePick
+	}
+	franchises := leagueFranchises(league)
+	positions := []position{}
 	for _, p := range req.Picks {
-		fId := uuid.MustParse(p.FranchiseID)
-		for dr := 1; dr <= league.DraftRounds; dr++ {
-			var pick models.Pick
+		fId, err := uuid.Parse(p.FranchiseID)
+		if err != nil {
+			return &pb.DefaultResponse{
+				Status: http.StatusBadRequest,
+				Error:  fmt.Sprintf("Could not parse uuid for franchise id %q.", p.FranchiseID),
+			}, nil
+		}
+		franchise, ok := franchises[fId]
+		if !ok {
+			return &pb.DefaultResponse{
+				Status: http.StatusConflict,
+				Error:  fmt.Sprintf("Franchise %q is not in league %q.", fId, league.ID),
+			}, nil
+		}
+		year, err := number(p.DraftYear, p.Year, "draft year")
+		if err != nil {
+			return &pb.DefaultResponse{
+				Status: http.StatusBadRequest,
+				Error:  fmt.Sprintf("Invalid pick for franchise %q: %v", fId, err),
+			}, nil
+		}
+		positions = append(positions, position{franchiseID: fId, name: franchise.Name, year: year, pick: p})
+	}
 
-			findPick := s.R.DB.Where("origin_id = ? AND draft_year = ? AND draft_round = ?", fId, p.Year, dr).Find(&pick)
+	// the positions are saved with the draft order and the pending conditions and swaps they decide
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		nFranchises, err := tx.CountFranchises(ctx, league.ID)
+		if err != nil {
+			return fmt.Errorf("counting franchises of league %q failed: %w", league.ID, err)
+		}
 
-			if findPick.Error != nil {
-				return &pb.DefaultResponse{
-					Status: http.StatusForbidden,
-					Error:  fmt.Sprintf("Error while querying for pick with origin_id %q and year %q and draft round %q. Error: %q", fId, p.Year, dr, findPick.Error),
-				}, nil
-			}
+		years := map[int]bool{}
+		for _, pos := range positions {
+			fId, name, year, p := pos.franchiseID, pos.name, pos.year, pos.pick
+			years[year] = true
+			for dr := 1; dr <= league.DraftRounds; dr++ {
+				var pick models.Pick
 
-			if p.LotteryPosition == 0 {
-				pick.DraftPickInRound = nil
-				pick.DraftPickOverall = nil
-
-			} else {
-				// calculate overall pick
-				overallPick := int(p.LotteryPosition) + (dr-1)*int(nFranchises)
-				pInRound := fmt.Sprintf("%v", p.LotteryPosition)
-				pOverall := fmt.Sprintf("%v", overallPick)
-				pick.DraftPickInRound = &pInRound
-				pick.DraftPickOverall = &pOverall
-			}
+				picks, err := tx.FindOriginalPicks(ctx, fId, year, dr)
+				if err != nil {
+					return fmt.Errorf("error while querying for pick with origin_id %q and year %d and draft round %d: %w", fId, year, dr, err)
+				}
+				if len(picks) > 1 {
+					// multiple picks exist, this should not happen
+					return fmt.Errorf("multiple picks for origin_id %q and year %d exist, shoud not happen", fId, year)
+				}
+				if len(picks) > 0 {
+					pick = picks[0]
+				}
+
+				if p.LotteryPosition == 0 {
+					pick.DraftPickInRound = nil
+					pick.DraftPickOverall = nil
+					pick.Slot = nil
+
+				} else {
+					// calculate overall pick
+					pInRound := int(p.LotteryPosition)
+					pOverall := pInRound + (dr-1)*int(nFranchises)
+					pick.DraftPickInRound = &pInRound
+					pick.DraftPickOverall = &pOverall
+					pick.Slot = &pInRound
+				}
+				pick.DraftYear = year
+				pick.DraftRound = dr
+
+				if len(picks) == 1 {
+					// pick exists, update it
+					if err := tx.SavePick(ctx, &pick); err != nil {
+						return fmt.Errorf("updating pick %q failed: %w", pick.ID, err)
+					}
+					continue
+				}
 
-			if findPick.RowsAffected == 0 {
 				// pick does not exisit, create it
-				pick.DraftYear = p.Year
-				pick.DraftRound = fmt.Sprintf("%v", dr)
 				pick.ProspectID = nil
 				pick.OwnerID = &fId
-				pick.OwnerName = p.Franchise
+				pick.OwnerName = name
 				pick.LastOwnerID = &fId
-				pick.LastOwnerName = p.Franchise
+				pick.LastOwnerName = name
 				pick.OriginID = &fId
-				pick.OriginName = p.Franchise
-
-				// create
-				if createPick := s.R.DB.Create(&pick); createPick.Error != nil {
-					return &pb.DefaultResponse{
-						Status: http.StatusForbidden,
-						Error:  fmt.Sprintf("Creating prospects failed %q", createPick.Error),
-					}, nil
+				pick.OriginName = name
+				if err := tx.CreatePick(ctx, &pick); err != nil {
+					return fmt.Errorf("creating pick failed: %w", err)
 				}
-			} else if findPick.RowsAffected == 1 {
-
-				// pick exists, update it
-				pick.DraftYear = p.Year
-				pick.DraftRound = fmt.Sprintf("%v", dr)
-				// update
-				s.R.DB.Save(&pick)
-
-			} else {
-				// multiple picks exist, this should not happen
-				return &pb.DefaultResponse{
-					Status: http.StatusForbidden,
-					Error:  fmt.Sprintf("Multiple picks for origin_id %q and year %q exist, shoud not happen!", fId, p.Year),
-				}, nil
 			}
-
 		}
 
+		for year := range years {
+			if err := draftOrderChanged(ctx, tx, league, year); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Updating the draft order failed %q", err),
+		}, nil
 	}
 
 	return &pb.DefaultResponse{
@@ -99,62 +160,162 @@ func (s *Server) CreateOrUpdatePicks(ctx context.Context, req *pb.CreateOrUpdate
 	}, nil
 }
 
-func (s *Server) GetPicksByYear(ctx context.Context, req *pb.GetPicksRequest) (*pb.GetPicksResponse, error) {
-	var picks []models.Pick
-	picksRes := []*pb.Pick{}
+func (s *Server) ForfeitPick(ctx context.Context, req *pb.ForfeitPickRequest) (*pb.DefaultResponse, error) {
+	pId, err := uuid.Parse(req.PickID)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for pick id %q.", req.PickID),
+		}, nil
+	}
 
-	year := fmt.Sprintf("%v", req.Year)
-	logrus.Info(year)
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		pick, err := tx.FindPick(ctx, pId)
+		if err != nil {
+			return err
+		}
+		owner, err := tx.FindFranchise(ctx, idValue(pick.OwnerID))
+		if err != nil {
+			return err
+		}
+		league, err := managedLeague(ctx, tx, owner.LeagueID, req.UserId)
+		if err != nil {
+			return err
+		}
+		if err := writable(league); err != nil {
+			return err
+		}
+		if pick.Forfeited() {
+			return fmt.Errorf("pick %v is already forfeited", pId)
+		}
+		if pick.ProspectID != nil {
+			return fmt.Errorf("pick %v is already used", pId)
+		}
+		if err := unencumbered(ctx, tx, pId); err != nil {
+			return err
+		}
 
-	if findPicks := s.R.DB.Where("draft_year = ?", year).Find(&picks).Limit(1000); findPicks.Error != nil {
-		return &pb.GetPicksResponse{
-			Status: http.StatusForbidden,
-			Error:  fmt.Sprintf("Could not find any picks %q", findPicks.Error),
+		now := time.Now().Local()
+		pick.ForfeitedAt = &now
+		pick.Reason = req.Reason
+		if err := tx.SavePick(ctx, pick); err != nil {
+			return err
+		}
+		return draftOrderChanged(ctx, tx, league, pick.DraftYear)
+	})
+
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Forfeiting pick (%s) failed: %v", req.PickID, err),
 		}, nil
 	}
 
-	for _, p := range picks {
-		pId := ""
-		if p.ProspectID != nil {
-			pId = p.ProspectID.String()
-		}
+	return &pb.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "pick was successfully forfeited",
+	}, nil
+}
 
-		pInRound := ""
-		if p.DraftPickInRound != nil {
-			pInRound = *p.DraftPickInRound
+func (s *Server) IssueSupplementalPick(ctx context.Context, req *pb.SupplementalPickRequest) (*pb.PickResponse, error) {
+	fId, err := uuid.Parse(req.FranchiseID)
+	if err != nil {
+		return &pb.PickResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for franchise id %q.", req.FranchiseID),
+		}, nil
+	}
+	if req.Year <= 0 || req.Round <= 0 || req.Slot < 0 {
+		return &pb.PickResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Invalid supplemental pick in round %d of %d at slot %d", req.Round, req.Year, req.Slot),
+		}, nil
+	}
+
+	var pick *models.Pick
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		franchise, err := tx.FindFranchise(ctx, fId)
+		if err != nil {
+			return err
+		}
+		league, err := managedLeague(ctx, tx, franchise.LeagueID, req.UserId)
+		if err != nil {
+			return err
+		}
+		if err := writable(league); err != nil {
+			return err
+		}
+		if int(req.Round) > league.DraftRounds {
+			return fmt.Errorf("league %v only drafts %d rounds", league.ID, league.DraftRounds)
 		}
 
-		pOverall := ""
-		if p.DraftPickOverall != nil {
-			pOverall = *p.DraftPickOverall
+		supplemental := originalPick(franchise, int(req.Year), int(req.Round))
+		supplemental.Supplemental = true
+		supplemental.Reason = req.Reason
+		if req.Slot > 0 {
+			slot := int(req.Slot)
+			supplemental.Slot = &slot
+		}
+		if err := tx.CreatePick(ctx, supplemental); err != nil {
+			return err
 		}
+		if err := draftOrderChanged(ctx, tx, league, supplemental.DraftYear); err != nil {
+			return err
+		}
+		pick, err = tx.FindPick(ctx, supplemental.ID)
+		return err
+	})
+
+	if err != nil {
+		return &pb.PickResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Issuing a supplemental pick to franchise (%s) failed: %v", req.FranchiseID, err),
+		}, nil
+	}
+
+	return &pb.PickResponse{
+		Status: http.StatusCreated,
+		Result: pickToPb(*pick),
+	}, nil
+}
+
+func (s *Server) GetPicksByYear(ctx context.Context, req *pb.GetPicksRequest) (*pb.GetPicksResponse, error) {
+	picksRes := []*pb.Pick{}
 
-		picksRes = append(picksRes, &pb.Pick{
-			ID:               p.ID.String(),
-			DraftYear:        p.DraftYear,
-			DraftRound:       p.DraftRound,
-			DraftPickInRound: pInRound,
-			DraftPickOverall: pOverall,
-			ProspectID:       pId,
-			OwnerID:          p.OwnerID.String(),
-			OwnerName:        p.OwnerName,
-			LastOwnerID:      p.LastOwnerID.String(),
-			LastOwnerName:    p.LastOwnerName,
-			OriginID:         p.OriginID.String(),
-			OriginName:       p.OriginName,
-		})
+	year, err := number(req.DraftYear, req.Year, "draft year")
+	if err != nil {
+		return &pb.GetPicksResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Invalid request: %v", err),
+		}, nil
+	}
+
+	page := storage.Page{Size: int(req.PageSize), Token: req.PageToken, OrderBy: req.OrderBy}
+	picks, nextPageToken, err := s.R.ListPicks(ctx, storage.PickFilter{DraftYear: year, Drafted: boolFilter(req.Drafted)}, page)
+	if err != nil {
+		return &pb.GetPicksResponse{
+			Status: listStatus(err, http.StatusForbidden),
+			Error:  fmt.Sprintf("Could not find any picks %q", err),
+		}, nil
+	}
+
+	picksRes, err = picksToPb(ctx, s.R, picks)
+	if err != nil {
+		return &pb.GetPicksResponse{
+			Status: http.StatusForbidden,
+			Error:  fmt.Sprintf("Could not find the conditions of the picks %q", err),
+		}, nil
 	}
 
 	return &pb.GetPicksResponse{
-		Status: http.StatusOK,
-		Picks:  picksRes,
+		Status:        http.StatusOK,
+		Picks:         picksRes,
+		NextPageToken: nextPageToken,
 	}, nil
 
 }
 
 func (s *Server) GetPicksByFranchise(ctx context.Context, req *pb.GetPicksRequest) (*pb.GetPicksResponse, error) {
-	var picks []models.Pick
-
 	fId, err := uuid.Parse(req.FranchiseID)
 	if err != nil {
 		return &pb.GetPicksResponse{
@@ -163,49 +324,140 @@ func (s *Server) GetPicksByFranchise(ctx context.Context, req *pb.GetPicksReques
 
 	}
 
-	if findPicks := s.R.DB.Where(models.Pick{OwnerID: &fId}).Find(&picks).Limit(1000); findPicks.Error != nil {
+	year, err := number(req.DraftYear, req.Year, "draft year")
+	if err != nil {
 		return &pb.GetPicksResponse{
-			Status: http.StatusForbidden,
-			Error:  fmt.Sprintf("Could not fetch picks for franchise %q. Error: %v", fId, findPicks.Error),
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Invalid request: %v", err),
+		}, nil
+	}
+
+	page := storage.Page{Size: int(req.PageSize), Token: req.PageToken, OrderBy: req.OrderBy}
+	picks, nextPageToken, err := s.R.ListPicks(ctx, storage.PickFilter{OwnerID: &fId, DraftYear: year, Drafted: boolFilter(req.Drafted)}, page)
+	if err != nil {
+		return &pb.GetPicksResponse{
+			Status: listStatus(err, http.StatusForbidden),
+			Error:  fmt.Sprintf("Could not fetch picks for franchise %q. Error: %v", fId, err),
 		}, nil
 	}
 
 	picksRes := []*pb.Pick{}
-	for _, p := range picks {
-		pId := ""
-		if p.ProspectID != nil {
-			pId = p.ProspectID.String()
-		}
+	picksRes, err = picksToPb(ctx, s.R, picks)
+	if err != nil {
+		return &pb.GetPicksResponse{
+			Status: http.StatusForbidden,
+			Error:  fmt.Sprintf("Could not find the conditions of the picks %q", err),
+		}, nil
+	}
 
-		pInRound := ""
-		if p.DraftPickInRound != nil {
-			pInRound = *p.DraftPickInRound
-		}
+	return &pb.GetPicksResponse{
+		Status:        http.StatusOK,
+		Picks:         picksRes,
+		NextPageToken: nextPageToken,
+	}, nil
+
+}
 
-		pOverall := ""
-		if p.DraftPickOverall != nil {
-			pOverall = *p.DraftPickOverall
+// allPicks returns every pick matching the filter, page by page
+func allPicks(ctx context.Context, r storage.Repository, filter storage.PickFilter) ([]models.Pick, error) {
+	all := []models.Pick{}
+	page := storage.Page{Size: storage.MaxPageSize}
+	for {
+		picks, next, err := r.ListPicks(ctx, filter, page)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, picks...)
+		if next == "" {
+			return all, nil
 		}
+		page.Token = next
+	}
+}
 
-		picksRes = append(picksRes, &pb.Pick{
-			ID:               p.ID.String(),
-			DraftYear:        p.DraftYear,
-			DraftRound:       p.DraftRound,
-			DraftPickInRound: pInRound,
-			DraftPickOverall: pOverall,
-			ProspectID:       pId,
-			OwnerID:          p.OwnerID.String(),
-			OwnerName:        p.OwnerName,
-			LastOwnerID:      p.LastOwnerID.String(),
-			LastOwnerName:    p.LastOwnerName,
-			OriginID:         p.OriginID.String(),
-			OriginName:       p.OriginName,
-		})
+// draftOrderChanged renumbers the league's draft of the year, the new positions may resolve conditions and swaps
+func draftOrderChanged(ctx context.Context, tx storage.Repository, league *models.League, year int) error {
+	if err := renumberDraft(ctx, tx, league, year); err != nil {
+		return err
 	}
+	if err := resolvePickConditions(ctx, tx, league.ID); err != nil {
+		return err
+	}
+	return resolvePickSwaps(ctx, tx, league.ID)
+}
 
-	return &pb.GetPicksResponse{
-		Status: http.StatusOK,
-		Picks:  picksRes,
-	}, nil
+// renumberDraft numbers the league's picks of the year by round and slot. Supplemental picks go
+// before the pick in their slot, forfeited picks are left out. Rounds without a lottery position
+// stay unnumbered.
+func renumberDraft(ctx context.Context, tx storage.Repository, league *models.League, year int) error {
+	stored, err := tx.FindLeague(ctx, league.ID)
+	if err != nil {
+		return err
+	}
+	rounds := map[int][]models.Pick{}
+	for _, f := range stored.Franchises {
+		picks, err := allPicks(ctx, tx, storage.PickFilter{OwnerID: &f.ID, DraftYear: year})
+		if err != nil {
+			return err
+		}
+		for _, p := range picks {
+			rounds[p.DraftRound] = append(rounds[p.DraftRound], p)
+		}
+	}
+	order := []int{}
+	for round := range rounds {
+		order = append(order, round)
+	}
+	sort.Ints(order)
+
+	overall := 0
+	for _, round := range order {
+		picks := rounds[round]
+		sort.SliceStable(picks, func(i, j int) bool { return draftsBefore(&picks[i], &picks[j]) })
+		positioned := false
+		for _, p := range picks {
+			positioned = positioned || (!p.Supplemental && !p.Forfeited() && p.Slot != nil)
+		}
 
+		inRound := 0
+		for i := range picks {
+			p := &picks[i]
+			var pInRound, pOverall *int
+			if positioned && !p.Forfeited() && (p.Slot != nil || p.Supplemental) {
+				inRound, overall = inRound+1, overall+1
+				n, o := inRound, overall
+				pInRound, pOverall = &n, &o
+			}
+			if optionalNumber(p.DraftPickInRound) == optionalNumber(pInRound) && optionalNumber(p.DraftPickOverall) == optionalNumber(pOverall) {
+				continue
+			}
+			p.DraftPickInRound = pInRound
+			p.DraftPickOverall = pOverall
+			if err := tx.SavePick(ctx, p); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// draftsBefore orders picks of a round by slot, supplemental picks before the pick in their slot
+// and supplemental picks without a slot at the end
+func draftsBefore(a *models.Pick, b *models.Pick) bool {
+	slot := func(p *models.Pick) int {
+		if p.Slot == nil {
+			return math.MaxInt
+		}
+		return *p.Slot
+	}
+	if slot(a) != slot(b) {
+		return slot(a) < slot(b)
+	}
+	if a.Supplemental != b.Supplemental {
+		return a.Supplemental
+	}
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.Before(b.CreatedAt)
+	}
+	return a.ID.String() < b.ID.String()
 }
@@ -4,17 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/hiltpold/lakelandcup-fantasy-service/models"
 	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
 	"github.com/sirupsen/logrus"
-	"gorm.io/gorm"
 )
 
 func (s *Server) GetProspectsByFranchise(ctx context.Context, req *pb.GetFranchiseRequest) (*pb.ProspectsResponse, error) {
-	var prospects []models.Prospect
-
 	fId, err := uuid.Parse(req.FranchiseID)
 	if err != nil {
 		return &pb.ProspectsResponse{
@@ -23,72 +22,39 @@ func (s *Server) GetProspectsByFranchise(ctx context.Context, req *pb.GetFranchi
 
 	}
 
-	findProspects := s.R.DB.Preload("Pick").Where(models.Prospect{FranchiseID: &fId}).Find(&prospects).Limit(1000)
+	filter, err := prospectFilter(req.Filter)
+	if err != nil {
+		return &pb.ProspectsResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Invalid filter: %v", err),
+		}, nil
+	}
+	filter.FranchiseID = &fId
+	page := storage.Page{Size: int(req.PageSize), Token: req.PageToken, OrderBy: req.OrderBy}
+	prospects, nextPageToken, err := s.R.ListProspects(ctx, filter, page)
 
-	if findProspects.Error != nil {
+	if err != nil {
 		return &pb.ProspectsResponse{
-			Status: http.StatusForbidden,
-			Error:  fmt.Sprintf("Could not fetch picks for franchise %q. Error: %v", fId, findProspects.Error),
+			Status: listStatus(err, http.StatusForbidden),
+			Error:  fmt.Sprintf("Could not fetch prospects for franchise %q. Error: %v", fId, err),
 		}, nil
 	}
-	logrus.Info(fmt.Sprintf("-> %+v", prospects))
 
 	prospectsRes := []*pb.Prospect{}
-	if findProspects.RowsAffected > 0 {
-		for _, p := range prospects {
-			pickId := ""
-			if p.Pick != nil {
-				pickId = p.Pick.ID.String()
-			}
-			prospectId := ""
-			if p.FranchiseID != nil {
-				prospectId = p.FranchiseID.String()
-			}
-			protected := ""
-			if p.Protected {
-				protected = "yes"
-			} else {
-				protected = "no"
-			}
-
-			pInRound := ""
-			if p.Pick.DraftPickInRound != nil {
-				pInRound = *p.Pick.DraftPickInRound
-			}
-
-			pOverall := ""
-			if p.Pick.DraftPickOverall != nil {
-				pOverall = *p.Pick.DraftPickOverall
-			}
-
-			prospectsRes = append(prospectsRes, &pb.Prospect{
-				ID:          p.ID.String(),
-				FullName:    p.FullName,
-				FirstName:   p.FirstName,
-				LastName:    p.LastName,
-				NhlTeam:     p.NhlTeam,
-				FranchiseID: prospectId,
-				Pick:        &pb.Pick{ID: pickId, DraftYear: p.Pick.DraftYear, DraftRound: p.Pick.DraftRound, DraftPickInRound: pInRound, DraftPickOverall: pOverall},
-				Protected:   protected,
-				Birthdate:   p.Birthdate,
-			})
-		}
-
-		logrus.Info(fmt.Sprintf("-> %+v", prospectsRes))
-
+	for _, p := range prospects {
+		prospectsRes = append(prospectsRes, prospectToPb(p))
 	}
 
 	return &pb.ProspectsResponse{
-		Status:    http.StatusOK,
-		Prospects: prospectsRes,
+		Status:        http.StatusOK,
+		Prospects:     prospectsRes,
+		NextPageToken: nextPageToken,
 	}, nil
 
 }
 
 func (s *Server) UndraftProspect(ctx context.Context, req *pb.DraftRequest) (*pb.DefaultResponse, error) {
-	var pick models.Pick
-	var prospect models.Prospect
-	var transaction = s.R.DB.Transaction(func(tx *gorm.DB) error {
+	var transaction = s.R.Transaction(ctx, func(tx storage.Repository) error {
 
 		// parse id to uuid
 		pickId, err := uuid.Parse(req.PickID)
@@ -102,14 +68,10 @@ func (s *Server) UndraftProspect(ctx context.Context, req *pb.DraftRequest) (*pb
 		}
 
 		// pick
-		findPick := tx.Model(&pick).Where(&models.Pick{ID: pickId}).First(&pick)
+		pick, err := tx.FindPick(ctx, pickId)
 		logrus.Info(fmt.Sprintf("%v", pick))
-		if findPick.Error != nil {
-			return findPick.Error
-		}
-
-		if findPick.RowsAffected == 0 {
-			return fmt.Errorf("could not find any pick with ID %v", req.PickID)
+		if err != nil {
+			return err
 		}
 
 		if pick.ProspectID == nil {
@@ -122,11 +84,11 @@ func (s *Server) UndraftProspect(ctx context.Context, req *pb.DraftRequest) (*pb
 		}
 
 		// prospect
-		findProspect := tx.Model(&prospect).Preload("Pick").Where(&models.Prospect{ID: prospectId}).First(&prospect)
+		prospect, err := tx.FindProspect(ctx, prospectId)
 		logrus.Info(fmt.Sprintf("%+v", prospect))
 
-		if findProspect.Error != nil {
-			return findProspect.Error
+		if err != nil {
+			return err
 		}
 
 		if prospect.Pick == nil {
@@ -137,16 +99,33 @@ func (s *Server) UndraftProspect(ctx context.Context, req *pb.DraftRequest) (*pb
 
 		}
 
+		if prospect.FranchiseID != nil {
+			franchise, err := tx.FindFranchise(ctx, *prospect.FranchiseID)
+			if err != nil {
+				return err
+			}
+			if err := authorize(ctx, tx, franchise, req.UserId, models.Draft); err != nil {
+				return err
+			}
+		}
+
 		prospect.LeagueID = nil
 		prospect.FranchiseID = nil
 		prospect.Pick = nil
 		prospect.Protected = false
+		if err := delist(ctx, tx, prospect.ID); err != nil {
+			return err
+		}
 
-		tx.Save(&prospect)
+		if err := tx.SaveProspect(ctx, prospect); err != nil {
+			return err
+		}
 
 		pick.ProspectID = nil
 
-		tx.Save(&pick)
+		if err := tx.SavePick(ctx, pick); err != nil {
+			return err
+		}
 
 		// return nil will commit the whole transaction
 		return nil
@@ -154,7 +133,7 @@ func (s *Server) UndraftProspect(ctx context.Context, req *pb.DraftRequest) (*pb
 
 	if transaction != nil {
 		return &pb.DefaultResponse{
-			Status: http.StatusConflict,
+			Status: authStatus(transaction, http.StatusConflict),
 			Error:  transaction.Error(),
 		}, nil
 
@@ -167,9 +146,7 @@ func (s *Server) UndraftProspect(ctx context.Context, req *pb.DraftRequest) (*pb
 }
 
 func (s *Server) DraftProspect(ctx context.Context, req *pb.DraftRequest) (*pb.DefaultResponse, error) {
-	var pick models.Pick
-	var prospect models.Prospect
-	var transaction = s.R.DB.Transaction(func(tx *gorm.DB) error {
+	var transaction = s.R.Transaction(ctx, func(tx storage.Repository) error {
 
 		// parse id to uuid
 		pickId, err := uuid.Parse(req.PickID)
@@ -192,21 +169,37 @@ func (s *Server) DraftProspect(ctx context.Context, req *pb.DraftRequest) (*pb.D
 			return fmt.Errorf("could not parse LeaguetID%v", req.LeagueID)
 		}
 
+		franchise, err := tx.FindFranchise(ctx, franchiseId)
+		if err != nil {
+			return err
+		}
+		if err := authorize(ctx, tx, franchise, req.UserId, models.Draft); err != nil {
+			return err
+		}
+		if franchise.LeagueID != leagueId {
+			return fmt.Errorf("franchise %v is not in league %v", franchiseId, leagueId)
+		}
+
 		// pick
-		findPick := tx.Model(&pick).Where(&models.Pick{ID: pickId}).First(&pick)
+		pick, err := tx.FindPick(ctx, pickId)
 
 		logrus.Info(fmt.Sprintf("%v", pick))
 
-		if findPick.Error != nil {
-			return findPick.Error
+		if err != nil {
+			return err
 		}
 
-		if pick.DraftPickInRound == nil || pick.DraftPickOverall == nil {
-			return fmt.Errorf("could not draft prospect. PickInRound and PickOverall must be set")
+		// only the franchise's own picks draft onto its roster
+		if pick.OwnerID == nil || *pick.OwnerID != franchiseId {
+			return fmt.Errorf("%w: pick %v is not owned by franchise %v", errForbidden, pickId, franchiseId)
 		}
 
-		if findPick.RowsAffected == 0 {
-			return fmt.Errorf("could not find any pick with ID %v", req.PickID)
+		if pick.Forfeited() {
+			return fmt.Errorf("could not draft prospect. Pick %v is forfeited", pickId)
+		}
+
+		if pick.DraftPickInRound == nil || pick.DraftPickOverall == nil {
+			return fmt.Errorf("could not draft prospect. PickInRound and PickOverall must be set")
 		}
 
 		if pick.ProspectID != nil {
@@ -214,26 +207,40 @@ func (s *Server) DraftProspect(ctx context.Context, req *pb.DraftRequest) (*pb.D
 		}
 
 		// prospect
-		findProspect := tx.Model(&prospect).Preload("Pick").Where(&models.Prospect{ID: prospectId}).First(&prospect)
+		prospect, err := tx.FindProspect(ctx, prospectId)
 		logrus.Info(fmt.Sprintf("%+v", prospect))
-		if findProspect.Error != nil {
-			return findProspect.Error
+		if err != nil {
+			return err
 		}
 
 		if prospect.Pick != nil {
 			return fmt.Errorf("prospect with ID %v is already assigned to pick %v", prospectId, prospect.Pick.ID)
 		}
 
+		league, err := tx.FindLeague(ctx, leagueId)
+		if err != nil {
+			return err
+		}
+		if err := league.Eligibility.Check(*prospect, pick.DraftYear); err != nil {
+			return fmt.Errorf("could not draft prospect. %v", err)
+		}
+
 		// update both
 
-		updateProspect := tx.Model(&prospect).Where(&models.Prospect{ID: prospectId}).Updates(models.Prospect{LeagueID: &leagueId, FranchiseID: &franchiseId, Protected: true})
-		if updateProspect.Error != nil {
-			return updateProspect.Error
+		prospect.LeagueID = &leagueId
+		prospect.FranchiseID = &franchiseId
+		prospect.Protected = true
+		if err := tx.SaveProspect(ctx, prospect); err != nil {
+			return err
 		}
 
-		updatePick := tx.Model(&pick).Where(&models.Pick{ID: pickId}).Update("prospect_id", prospectId)
-		if updatePick.Error != nil {
-			return updatePick.Error
+		pick.ProspectID = &prospectId
+		if err := tx.SavePick(ctx, pick); err != nil {
+			return err
+		}
+		// the used pick is no longer available
+		if err := delist(ctx, tx, pick.ID); err != nil {
+			return err
 		}
 
 		// return nil will commit the whole transaction
@@ -242,7 +249,7 @@ func (s *Server) DraftProspect(ctx context.Context, req *pb.DraftRequest) (*pb.D
 
 	if transaction != nil {
 		return &pb.DefaultResponse{
-			Status: http.StatusConflict,
+			Status: authStatus(transaction, http.StatusConflict),
 			Error:  transaction.Error(),
 		}, nil
 
@@ -260,121 +267,102 @@ func (s *Server) CreateProspectsBulk(ctx context.Context, req *pb.CreateProspect
 	prospects := []models.Prospect{}
 
 	for _, pReq := range req.Prospects {
-		var prospect models.Prospect
-		if findProspect := s.R.DB.Where(&models.Prospect{FullName: pReq.FullName, Birthdate: pReq.Birthdate, NhlDraftYear: pReq.DraftYear, NhlDraftPickOverall: pReq.NhlDraftPickOverall}).First(&prospect); findProspect.Error != nil {
-			prospect.FullName = pReq.FullName
-			prospect.FirstName = pReq.FirstName
-			prospect.LastName = pReq.LastName
-			prospect.NhlTeam = pReq.NhlTeam
-			prospect.Birthdate = pReq.Birthdate
-			prospect.Height = pReq.Height
-			prospect.Weight = pReq.Weight
-			prospect.NhlDraftYear = pReq.DraftYear
-			prospect.NhlDraftRound = pReq.NhlDraftRound
-			prospect.NhlDraftPickInRound = pReq.NhlDraftPickInRound
-			prospect.NhlDraftPickOverall = pReq.NhlDraftPickOverall
-			prospect.PositionCode = pReq.PositionCode
+		prospect, err := prospectFromPb(pReq)
+		if err != nil {
+			return &pb.CreateProspectsBulkResponse{
+				Status: http.StatusBadRequest,
+				Error:  fmt.Sprintf("Invalid prospect %q: %v", pReq.FullName, err),
+			}, nil
+		}
+		if _, err := s.R.FindProspectByIdentity(ctx, storage.ProspectIdentity{FullName: prospect.FullName, Birthdate: prospect.Birthdate, NhlDraftYear: prospect.NhlDraftYear, NhlDraftPickOverall: prospect.NhlDraftPickOverall}); err != nil {
 			prospects = append(prospects, prospect)
 		}
 	}
 
 	if len(prospects) > 0 {
 		logrus.Info(fmt.Sprintf("Prospects that will be batch inserted: %v", len(prospects)))
-		if createProspects := s.R.DB.Create(&prospects); createProspects.Error != nil {
+		if err := s.R.CreateProspects(ctx, prospects); err != nil {
 			return &pb.CreateProspectsBulkResponse{
 				Status: http.StatusForbidden,
-				Error:  fmt.Sprintf("Creating prospects failed %q", createProspects.Error),
+				Error:  fmt.Sprintf("Creating prospects failed %q", err),
 			}, nil
 		}
 	} else {
 		logrus.Info(fmt.Sprintf("Prospects that will be batch inserted: %v", len(prospects)))
 	}
 
+	ids := []string{}
+	for _, p := range prospects {
+		ids = append(ids, p.ID.String())
+	}
 	return &pb.CreateProspectsBulkResponse{
 		Status:      http.StatusCreated,
-		ProspectIds: []string{},
+		ProspectIds: ids,
 	}, nil
 }
 
 func (s *Server) TextSearchProspects(ctx context.Context, req *pb.TextSearchRequest) (*pb.ProspectsResponse, error) {
+	text := strings.TrimSpace(req.Text)
+	if text == "" {
+		return &pb.ProspectsResponse{
+			Status: http.StatusBadRequest,
+			Error:  "Search text must not be empty",
+		}, nil
+	}
 
-	rows, err := s.R.DB.Model(&models.Prospect{}).Preload("Picks").Raw(fmt.Sprintf("SELECT * FROM fantasy.prospects WHERE to_tsvector(full_name) @@ to_tsquery('%q')", req.Text)).Rows()
+	filter, err := prospectFilter(req.Filter)
 	if err != nil {
 		return &pb.ProspectsResponse{
-			Status: http.StatusConflict,
-			Error:  fmt.Sprintf("Creating prospects failed %q", err),
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Invalid filter: %v", err),
 		}, nil
 	}
-	defer rows.Close()
 
-	prospectsRes := []*pb.Prospect{}
-
-	for rows.Next() {
-		p := models.Prospect{}
-		s.R.DB.ScanRows(rows, &p)
-		// TODO: avoid second query, better text search in first query
-		s.R.DB.Model(&p).Preload("Pick").Where(&models.Prospect{ID: p.ID}).First(&p)
-		pick := pb.Pick{}
-		if p.Pick != nil {
-
-			pInRound := ""
-			if p.Pick.DraftPickInRound != nil {
-				pInRound = *p.Pick.DraftPickInRound
-			}
+	search := storage.ProspectSearch{Text: text, Filter: filter, Limit: int(req.PageSize)}
 
-			pOverall := ""
-			if p.Pick.DraftPickOverall != nil {
-				pOverall = *p.Pick.DraftPickOverall
-			}
-
-			pick = pb.Pick{
-				ID:               p.Pick.ID.String(),
-				DraftYear:        p.Pick.DraftYear,
-				DraftRound:       p.Pick.DraftRound,
-				DraftPickInRound: pInRound,
-				DraftPickOverall: pOverall,
-				ProspectID:       p.Pick.ProspectID.String(),
-			}
+	if req.AvailableInLeagueID != "" {
+		lId, err := uuid.Parse(req.AvailableInLeagueID)
+		if err != nil {
+			return &pb.ProspectsResponse{
+				Status: http.StatusBadRequest,
+				Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.AvailableInLeagueID),
+			}, nil
 		}
+		search.AvailableInLeague = &lId
+	}
 
-		lId := ""
-		fId := ""
-
-		if p.LeagueID != nil {
-			lId = p.LeagueID.String()
+	var watcher *uuid.UUID
+	if req.WatchlistUserId != "" {
+		uId, err := uuid.Parse(req.WatchlistUserId)
+		if err != nil {
+			return &pb.ProspectsResponse{
+				Status: http.StatusBadRequest,
+				Error:  fmt.Sprintf("Could not parse uuid for user id %q.", req.WatchlistUserId),
+			}, nil
 		}
+		watcher = &uId
+	}
 
-		if p.FranchiseID != nil {
-			fId = p.FranchiseID.String()
-		}
+	prospects, err := s.R.SearchProspects(ctx, search)
+	if err != nil {
+		return &pb.ProspectsResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Searching prospects failed %q", err),
+		}, nil
+	}
 
-		protected := ""
-		if p.Protected {
-			protected = "yes"
-		} else {
-			protected = "no"
-		}
+	prospectsRes := []*pb.Prospect{}
+	for _, p := range prospects {
+		prospectsRes = append(prospectsRes, prospectToPb(p))
+	}
 
-		pp := &pb.Prospect{
-			ID:                  p.ID.String(),
-			FullName:            p.FullName,
-			FirstName:           p.FirstName,
-			LastName:            p.LastName,
-			NhlTeam:             p.NhlTeam,
-			Birthdate:           p.Birthdate,
-			Height:              p.Height,
-			Weight:              p.Weight,
-			PositionCode:        p.PositionCode,
-			NhlDraftYear:        p.NhlDraftYear,
-			NhlDraftRound:       p.NhlDraftRound,
-			NhlPickInRound:      p.NhlDraftPickInRound,
-			NhlDraftPickOverall: p.NhlDraftPickOverall,
-			LeagueID:            lId,
-			FranchiseID:         fId,
-			Pick:                &pick,
-			Protected:           protected,
+	if watcher != nil {
+		if err := annotateWatchlist(ctx, s.R, *watcher, prospectsRes); err != nil {
+			return &pb.ProspectsResponse{
+				Status: http.StatusConflict,
+				Error:  fmt.Sprintf("Fetching the watchlist failed %q", err),
+			}, nil
 		}
-		prospectsRes = append(prospectsRes, pp)
 	}
 
 	return &pb.ProspectsResponse{
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+func (s *Server) SubmitRanking(ctx context.Context, req *pb.SubmitRankingRequest) (*pb.DefaultResponse, error) {
+	fId, err := uuid.Parse(req.FranchiseID)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for franchise id %q.", req.FranchiseID),
+		}, nil
+	}
+	rankings := []models.ProspectRanking{}
+	ranked := map[uuid.UUID]bool{}
+	for i, id := range req.ProspectIds {
+		pId, err := uuid.Parse(id)
+		if err != nil {
+			return &pb.DefaultResponse{
+				Status: http.StatusBadRequest,
+				Error:  fmt.Sprintf("Could not parse uuid for prospect id %q.", id),
+			}, nil
+		}
+		if ranked[pId] {
+			return &pb.DefaultResponse{
+				Status: http.StatusBadRequest,
+				Error:  fmt.Sprintf("Prospect %q is ranked twice", id),
+			}, nil
+		}
+		ranked[pId] = true
+		rankings = append(rankings, models.ProspectRanking{ProspectID: pId, Rank: i + 1})
+	}
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		franchise, err := tx.FindFranchise(ctx, fId)
+		if err != nil {
+			return err
+		}
+		if err := authorize(ctx, tx, franchise, req.UserId, models.Draft); err != nil {
+			return err
+		}
+		league, err := tx.FindLeague(ctx, franchise.LeagueID)
+		if err != nil {
+			return err
+		}
+		if err := writable(league); err != nil {
+			return err
+		}
+		for _, r := range rankings {
+			if _, err := tx.FindProspect(ctx, r.ProspectID); err != nil {
+				return err
+			}
+		}
+		return tx.ReplaceRanking(ctx, draftYear(league, req.Year), "", &franchise.ID, rankings)
+	})
+
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Submitting the ranking of franchise (%s) failed: %v", req.FranchiseID, err),
+		}, nil
+	}
+
+	return &pb.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "ranking was submitted",
+	}, nil
+}
+
+func (s *Server) GetRankings(ctx context.Context, req *pb.GetRankingsRequest) (*pb.RankingsResponse, error) {
+	if req.Year <= 0 {
+		return &pb.RankingsResponse{
+			Status: http.StatusBadRequest,
+			Error:  "A draft year must be given",
+		}, nil
+	}
+	filter := storage.RankingFilter{Year: int(req.Year), Source: req.Source}
+	if req.FranchiseID != "" {
+		fId, err := uuid.Parse(req.FranchiseID)
+		if err != nil {
+			return &pb.RankingsResponse{
+				Status: http.StatusBadRequest,
+				Error:  fmt.Sprintf("Could not parse uuid for franchise id %q.", req.FranchiseID),
+			}, nil
+		}
+		filter.FranchiseID = &fId
+	}
+
+	rankings, err := s.R.ListRankings(ctx, filter)
+	if err != nil {
+		return &pb.RankingsResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Could not fetch the rankings of %d. Error: %v", req.Year, err),
+		}, nil
+	}
+
+	result := []*pb.Ranking{}
+	var current *pb.Ranking
+	for _, r := range rankings {
+		if current == nil || current.Source != r.Source || current.FranchiseID != idString(r.FranchiseID) {
+			current = &pb.Ranking{Source: r.Source, FranchiseID: idString(r.FranchiseID), Prospects: []*pb.RankedProspect{}}
+			if r.FranchiseID != nil {
+				franchise, err := s.R.FindFranchise(ctx, *r.FranchiseID)
+				if err != nil {
+					return &pb.RankingsResponse{
+						Status: http.StatusConflict,
+						Error:  fmt.Sprintf("Could not fetch franchise %q. Error: %v", r.FranchiseID, err),
+					}, nil
+				}
+				current.FranchiseName = franchise.Name
+			}
+			result = append(result, current)
+		}
+		prospect, err := s.R.FindProspect(ctx, r.ProspectID)
+		if err != nil {
+			return &pb.RankingsResponse{
+				Status: http.StatusConflict,
+				Error:  fmt.Sprintf("Could not fetch ranked prospect %q. Error: %v", r.ProspectID, err),
+			}, nil
+		}
+		current.Prospects = append(current.Prospects, &pb.RankedProspect{Rank: int32(r.Rank), Prospect: prospectToPb(*prospect)})
+	}
+
+	return &pb.RankingsResponse{
+		Status: http.StatusOK,
+		Result: result,
+	}, nil
+}
+
+func (s *Server) GetDraftBoard(ctx context.Context, req *pb.DraftBoardRequest) (*pb.DraftBoardResponse, error) {
+	lId, err := uuid.Parse(req.LeagueID)
+	if err != nil {
+		return &pb.DraftBoardResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.LeagueID),
+		}, nil
+	}
+	league, err := s.R.FindLeague(ctx, lId)
+	if err != nil {
+		return &pb.DraftBoardResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("League (%s) not found: %v", req.LeagueID, err),
+		}, nil
+	}
+	year := draftYear(league, req.Year)
+	if year <= 0 {
+		return &pb.DraftBoardResponse{
+			Status: http.StatusBadRequest,
+			Error:  "A draft year must be given for leagues without a season",
+		}, nil
+	}
+
+	rankings, err := s.R.ListRankings(ctx, storage.RankingFilter{Year: year})
+	if err != nil {
+		return &pb.DraftBoardResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Could not fetch the rankings of %d. Error: %v", year, err),
+		}, nil
+	}
+	// imported rankings and the rankings of the league's franchises count
+	franchises := map[uuid.UUID]bool{}
+	for _, f := range league.Franchises {
+		franchises[f.ID] = true
+	}
+	considered := []models.ProspectRanking{}
+	sources := map[string]bool{}
+	for _, r := range rankings {
+		if r.FranchiseID != nil && !franchises[*r.FranchiseID] {
+			continue
+		}
+		considered = append(considered, r)
+		sources[r.Source+idString(r.FranchiseID)] = true
+	}
+
+	result := []*pb.DraftBoardEntry{}
+	for _, e := range consensus(considered, req.Method) {
+		prospect, err := s.R.FindProspect(ctx, e.prospectID)
+		if err != nil {
+			return &pb.DraftBoardResponse{
+				Status: http.StatusConflict,
+				Error:  fmt.Sprintf("Could not fetch ranked prospect %q. Error: %v", e.prospectID, err),
+			}, nil
+		}
+		if prospect.LeagueID != nil && *prospect.LeagueID == league.ID {
+			continue
+		}
+		result = append(result, &pb.DraftBoardEntry{
+			Rank:      int32(len(result) + 1),
+			Score:     round(e.score),
+			Rankings:  int32(len(e.ranks)),
+			BestRank:  int32(e.ranks[0]),
+			WorstRank: int32(e.ranks[len(e.ranks)-1]),
+			Prospect:  prospectToPb(*prospect),
+		})
+	}
+
+	return &pb.DraftBoardResponse{
+		Status:   http.StatusOK,
+		Result:   result,
+		Rankings: int32(len(sources)),
+	}, nil
+}
+
+// draftYear is the requested year, the league's season if unset
+func draftYear(league *models.League, year int32) int {
+	if year != 0 {
+		return int(year)
+	}
+	return league.Season
+}
+
+// consensusEntry is a prospect on the consensus board with its ranks, best first
+type consensusEntry struct {
+	prospectID uuid.UUID
+	score      float64
+	ranks      []int
+}
+
+// consensus combines the rankings to the average or median rank of each prospect over the rankings
+// listing it. Ties are broken by the number of rankings, then the best rank.
+func consensus(rankings []models.ProspectRanking, method pb.ConsensusMethod) []consensusEntry {
+	ranks := map[uuid.UUID][]int{}
+	for _, r := range rankings {
+		ranks[r.ProspectID] = append(ranks[r.ProspectID], r.Rank)
+	}
+
+	entries := []consensusEntry{}
+	for id, rs := range ranks {
+		sort.Ints(rs)
+		e := consensusEntry{prospectID: id, ranks: rs}
+		switch method {
+		case pb.ConsensusMethod_CONSENSUS_METHOD_MEDIAN:
+			if n := len(rs); n%2 == 1 {
+				e.score = float64(rs[n/2])
+			} else {
+				e.score = float64(rs[n/2-1]+rs[n/2]) / 2
+			}
+		default:
+			sum := 0
+			for _, r := range rs {
+				sum += r
+			}
+			e.score = float64(sum) / float64(len(rs))
+		}
+		entries = append(entries, e)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.score != b.score {
+			return a.score < b.score
+		}
+		if len(a.ranks) != len(b.ranks) {
+			return len(a.ranks) > len(b.ranks)
+		}
+		if a.ranks[0] != b.ranks[0] {
+			return a.ranks[0] < b.ranks[0]
+		}
+		return a.prospectID.String() < b.prospectID.String()
+	})
+	return entries
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+)
+
+func TestConsensus(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	names := map[uuid.UUID]string{a: "a", b: "b", c: "c", d: "d"}
+	ranking := func(ids ...uuid.UUID) []models.ProspectRanking {
+		rankings := []models.ProspectRanking{}
+		for i, id := range ids {
+			rankings = append(rankings, models.ProspectRanking{ProspectID: id, Rank: i + 1})
+		}
+		return rankings
+	}
+	var rankings []models.ProspectRanking
+	rankings = append(rankings, ranking(a, b, c)...)
+	rankings = append(rankings, ranking(b, a, c)...)
+	rankings = append(rankings, ranking(c, a, b, d)...)
+	rankings = append(rankings, ranking(b)...)
+
+	var tests = []struct {
+		method   pb.ConsensusMethod
+		expected string
+		scores   []float64
+	}{
+		// a and b average 1.67 and 1.75, c 2.33, d 4
+		{pb.ConsensusMethod_CONSENSUS_METHOD_AVERAGE, "abcd", []float64{5.0 / 3, 1.75, 7.0 / 3, 4}},
+		// b's median is 1.5, a's 2
+		{pb.ConsensusMethod_CONSENSUS_METHOD_MEDIAN, "bacd", []float64{1.5, 2, 3, 4}},
+	}
+	for _, test := range tests {
+		order := ""
+		entries := consensus(rankings, test.method)
+		for i, e := range entries {
+			order += names[e.prospectID]
+			if e.score != test.scores[i] {
+				t.Errorf("Method %v: score %v of %s not equal to expected %v", test.method, e.score, names[e.prospectID], test.scores[i])
+			}
+		}
+		if order != test.expected {
+			t.Errorf("Method %v: order %q not equal to expected %q", test.method, order, test.expected)
+		}
+	}
+
+	// on equal scores the prospect ranked more often is first
+	var ties []models.ProspectRanking
+	for _, r := range [][]uuid.UUID{{a, b}, {b, a}, {b, c}, {c, b}} {
+		ties = append(ties, ranking(r...)...)
+	}
+	if entries := consensus(ties, pb.ConsensusMethod_CONSENSUS_METHOD_AVERAGE); entries[0].prospectID != b || entries[0].score != entries[1].score {
+		t.Errorf("Tie of %s not broken by the number of rankings", names[entries[0].prospectID])
+	}
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+// rosterPositions is the order of the position breakdown
+var rosterPositions = []models.Position{models.Center, models.LeftWing, models.RightWing, models.Defenseman, models.Goalie}
+
+func (s *Server) GetFranchiseRoster(ctx context.Context, req *pb.GetFranchiseRequest) (*pb.FranchiseRosterResponse, error) {
+	fId, err := uuid.Parse(req.FranchiseID)
+	if err != nil {
+		return &pb.FranchiseRosterResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for franchise id %q.", req.FranchiseID),
+		}, nil
+	}
+
+	var roster *pb.FranchiseRoster
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		franchise, err := tx.FindFranchise(ctx, fId)
+		if err != nil {
+			return err
+		}
+		league, err := tx.FindLeague(ctx, franchise.LeagueID)
+		if err != nil {
+			return err
+		}
+		prospects, err := allProspects(ctx, tx, storage.ProspectFilter{FranchiseID: &fId})
+		if err != nil {
+			return err
+		}
+		picks, err := allPicks(ctx, tx, storage.PickFilter{OwnerID: &fId})
+		if err != nil {
+			return err
+		}
+		roster = rosterToPb(league, franchise, prospects)
+		roster.Picks, err = picksByYear(ctx, tx, picks)
+		return err
+	})
+
+	if err != nil {
+		return &pb.FranchiseRosterResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Getting the roster of franchise (%s) failed: %v", req.FranchiseID, err),
+		}, nil
+	}
+
+	return &pb.FranchiseRosterResponse{
+		Status: http.StatusOK,
+		Result: roster,
+	}, nil
+}
+
+// rosterToPb summarizes the prospects of the franchise against the league's limits
+func rosterToPb(league *models.League, franchise *models.Franchise, prospects []models.Prospect) *pb.FranchiseRoster {
+	roster := &pb.FranchiseRoster{
+		Franchise: franchiseToPb(*franchise),
+		Prospects: []*pb.RosterProspect{},
+		Positions: []*pb.PositionCount{},
+		Usage: &pb.RosterUsage{
+			MaxProspects:      int32(league.MaxProspects),
+			DraftRightsGoalie: int32(league.DraftRightsGoalie),
+			DraftRightsSkater: int32(league.DraftRightsSkater),
+		},
+	}
+	roster.Franchise.Prospects = nil
+
+	counts := map[models.Position]*pb.PositionCount{}
+	for _, p := range append(rosterPositions, "") {
+		counts[p] = &pb.PositionCount{Position: positions[p]}
+	}
+	for _, p := range prospects {
+		entry := &pb.RosterProspect{Prospect: prospectToPb(p), RightsUntil: int32(rightsUntil(league, p))}
+		entry.RightsExpired = entry.RightsUntil != 0 && league.Season > int(entry.RightsUntil)
+		roster.Prospects = append(roster.Prospects, entry)
+
+		count, ok := counts[p.PositionCode]
+		if !ok {
+			count = counts[""]
+		}
+		count.Prospects++
+		roster.Usage.Prospects++
+		if p.Protected {
+			count.Protected++
+			roster.Usage.Protected++
+		}
+		if p.PositionCode == models.Goalie {
+			roster.Usage.Goalies++
+		} else {
+			roster.Usage.Skaters++
+		}
+		if entry.RightsExpired {
+			roster.Usage.RightsExpired++
+		}
+	}
+	for _, p := range rosterPositions {
+		roster.Positions = append(roster.Positions, counts[p])
+	}
+	if unknown := counts[""]; unknown.Prospects > 0 {
+		roster.Positions = append(roster.Positions, unknown)
+	}
+	return roster
+}
+
+// rightsUntil is the last draft year the franchise holds the rights of the drafted prospect, 0 if unknown
+func rightsUntil(league *models.League, p models.Prospect) int {
+	years := league.DraftRightsSkater
+	if p.PositionCode == models.Goalie {
+		years = league.DraftRightsGoalie
+	}
+	if p.Pick == nil || years <= 0 {
+		return 0
+	}
+	return p.Pick.DraftYear + years - 1
+}
+
+// picksByYear groups the picks by draft year in draft order
+func picksByYear(ctx context.Context, r storage.Repository, picks []models.Pick) ([]*pb.PicksOfYear, error) {
+	sort.SliceStable(picks, func(i, j int) bool {
+		a, b := picks[i], picks[j]
+		if a.DraftYear != b.DraftYear {
+			return a.DraftYear < b.DraftYear
+		}
+		if a.DraftRound != b.DraftRound {
+			return a.DraftRound < b.DraftRound
+		}
+		return optionalNumber(a.DraftPickOverall) < optionalNumber(b.DraftPickOverall)
+	})
+	converted, err := picksToPb(ctx, r, picks)
+	if err != nil {
+		return nil, err
+	}
+	result := []*pb.PicksOfYear{}
+	for i, p := range picks {
+		if len(result) == 0 || result[len(result)-1].Year != int32(p.DraftYear) {
+			result = append(result, &pb.PicksOfYear{Year: int32(p.DraftYear)})
+		}
+		year := result[len(result)-1]
+		year.Picks = append(year.Picks, converted[i])
+	}
+	return result, nil
+}
+
+// allProspects returns every prospect matching the filter, page by page
+func allProspects(ctx context.Context, r storage.Repository, filter storage.ProspectFilter) ([]models.Prospect, error) {
+	all := []models.Prospect{}
+	page := storage.Page{Size: storage.MaxPageSize}
+	for {
+		prospects, next, err := r.ListProspects(ctx, filter, page)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, prospects...)
+		if next == "" {
+			return all, nil
+		}
+		page.Token = next
+	}
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) RolloverSeason(ctx context.Context, req *pb.RolloverSeasonRequest) (*pb.SeasonResponse, error) {
+	lId, err := uuid.Parse(req.LeagueID)
+	if err != nil {
+		return &pb.SeasonResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.LeagueID),
+		}, nil
+	}
+
+	var season *models.Season
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		league, err := managedLeague(ctx, tx, lId, req.UserId)
+		if err != nil {
+			return err
+		}
+		if err := writable(league); err != nil {
+			return err
+		}
+
+		now := time.Now().Local()
+		current, err := tx.FindActiveSeason(ctx, league.ID)
+		if err == nil {
+			current.Status = models.SeasonClosed
+			current.ClosedAt = &now
+			if err := tx.SaveSeason(ctx, current); err != nil {
+				return err
+			}
+		} else if !errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+
+		// leagues from before seasons start with the next draft
+		year := league.Season + 1
+		if league.Season == 0 {
+			year = nextDraftYear(now)
+		}
+		if season, err = startSeason(ctx, tx, league, year, req.StartDate, req.EndDate); err != nil {
+			return err
+		}
+
+		league.Season = year
+		if err := tx.SaveLeague(ctx, league); err != nil {
+			return err
+		}
+		return reconcileLeaguePicks(ctx, tx, league)
+	})
+
+	if err != nil {
+		return &pb.SeasonResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Rolling over the season of league (%s) failed: %v", req.LeagueID, err),
+		}, nil
+	}
+
+	return &pb.SeasonResponse{
+		Status: http.StatusCreated,
+		Result: seasonToPb(*season),
+	}, nil
+}
+
+func (s *Server) GetSeasons(ctx context.Context, req *pb.GetLeagueRequest) (*pb.SeasonsResponse, error) {
+	lId, err := uuid.Parse(req.LeagueId)
+	if err != nil {
+		return &pb.SeasonsResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.LeagueId),
+		}, nil
+	}
+
+	seasons, err := s.R.ListSeasons(ctx, lId)
+	if err != nil {
+		return &pb.SeasonsResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Getting seasons of league (%s) failed: %v", req.LeagueId, err),
+		}, nil
+	}
+
+	seasonsRes := []*pb.Season{}
+	for _, season := range seasons {
+		seasonsRes = append(seasonsRes, seasonToPb(season))
+	}
+
+	return &pb.SeasonsResponse{
+		Status: http.StatusOK,
+		Result: seasonsRes,
+	}, nil
+}
+
+// startSeason creates the league's active season, unset dates run from july to the draft in june
+func startSeason(ctx context.Context, tx storage.Repository, league *models.League, year int, start *timestamppb.Timestamp, end *timestamppb.Timestamp) (*models.Season, error) {
+	season := &models.Season{
+		LeagueID:  league.ID,
+		Year:      year,
+		StartDate: time.Date(year-1, time.July, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(year, time.June, 30, 0, 0, 0, 0, time.UTC),
+		Status:    models.SeasonActive,
+	}
+	if start != nil {
+		season.StartDate = start.AsTime().UTC().Truncate(24 * time.Hour)
+	}
+	if end != nil {
+		season.EndDate = end.AsTime().UTC().Truncate(24 * time.Hour)
+	}
+	if !season.StartDate.Before(season.EndDate) {
+		return nil, fmt.Errorf("season %d starts after it ends", year)
+	}
+	return season, tx.CreateSeason(ctx, season)
+}
+
+// reconcileFuturePicks makes the picks of the franchises match the drafts in the league's horizon,
+// the season's and the FuturePickYears after it. Missing picks are created, picks of rounds the
+// league no longer drafts are deleted unless they are positioned or used. Traded picks of those
+// rounds and picks of pending conditions or swaps fail the reconciliation. It is idempotent.
+func reconcileFuturePicks(ctx context.Context, tx storage.Repository, league *models.League, franchises []models.Franchise) error {
+	first, last := pickHorizon(league, time.Now().Local())
+	for _, f := range franchises {
+		if f.Orphan {
+			continue
+		}
+		for year := first; year <= last; year++ {
+			for round := 1; round <= league.DraftRounds; round++ {
+				picks, err := tx.FindOriginalPicks(ctx, f.ID, year, round)
+				if err != nil {
+					return err
+				}
+				if len(picks) > 0 {
+					continue
+				}
+				if err := tx.CreatePick(ctx, originalPick(&f, year, round)); err != nil {
+					return err
+				}
+			}
+		}
+
+		unused, err := allPicks(ctx, tx, storage.PickFilter{OriginID: &f.ID, Drafted: new(bool)})
+		if err != nil {
+			return err
+		}
+		for _, p := range unused {
+			if p.DraftYear < first || p.DraftYear > last || p.DraftRound <= league.DraftRounds || p.DraftPickOverall != nil || p.Supplemental || p.Forfeited() {
+				continue
+			}
+			if p.OwnerID == nil || *p.OwnerID != f.ID {
+				return fmt.Errorf("round %d of %d can't be dropped, pick %v of %s was traded to %s", p.DraftRound, p.DraftYear, p.ID, f.Name, p.OwnerName)
+			}
+			if err := unencumbered(ctx, tx, p.ID); err != nil {
+				return fmt.Errorf("round %d of %d can't be dropped: %w", p.DraftRound, p.DraftYear, err)
+			}
+			if err := tx.DeletePick(ctx, p.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// reconcileLeaguePicks reconciles the future picks of all franchises of the league
+func reconcileLeaguePicks(ctx context.Context, tx storage.Repository, league *models.League) error {
+	stored, err := tx.FindLeague(ctx, league.ID)
+	if err != nil {
+		return err
+	}
+	return reconcileFuturePicks(ctx, tx, league, stored.Franchises)
+}
+
+// pickHorizon returns the first and last draft picks exist for, leagues from before seasons start with the next draft
+func pickHorizon(league *models.League, now time.Time) (int, int) {
+	first := league.Season
+	if first == 0 {
+		first = nextDraftYear(now)
+	}
+	return first, first + league.FuturePickYears
+}
+
+// originalPick is an unpositioned pick held by the franchise it originates from
+func originalPick(f *models.Franchise, year int, round int) *models.Pick {
+	return &models.Pick{
+		DraftYear:     year,
+		DraftRound:    round,
+		OwnerID:       &f.ID,
+		OwnerName:     f.Name,
+		LastOwnerID:   &f.ID,
+		LastOwnerName: f.Name,
+		OriginID:      &f.ID,
+		OriginName:    f.Name,
+	}
+}
+
+// nextDraftYear is the year of the first draft after t, drafts are held in june
+func nextDraftYear(t time.Time) int {
+	if t.Month() > time.June {
+		return t.Year() + 1
+	}
+	return t.Year()
+}
+
+func seasonToPb(s models.Season) *pb.Season {
+	season := &pb.Season{
+		ID:        s.ID.String(),
+		LeagueID:  s.LeagueID.String(),
+		Year:      int32(s.Year),
+		StartDate: timestamppb.New(s.StartDate),
+		EndDate:   timestamppb.New(s.EndDate),
+		Status:    string(s.Status),
+	}
+	if s.ClosedAt != nil {
+		season.ClosedAt = timestamppb.New(*s.ClosedAt)
+	}
+	return season
+}
@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/importer"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+// defaultStandingsWeight weighs the standings and the pipeline equally
+const defaultStandingsWeight = 0.5
+
+func (s *Server) SetStandings(ctx context.Context, req *pb.StandingsRequest) (*pb.DefaultResponse, error) {
+	lId, err := uuid.Parse(req.LeagueID)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.LeagueID),
+		}, nil
+	}
+	standings := []models.Standing{}
+	for _, st := range req.Standings {
+		fId, err := uuid.Parse(st.FranchiseID)
+		if err != nil {
+			return &pb.DefaultResponse{
+				Status: http.StatusBadRequest,
+				Error:  fmt.Sprintf("Could not parse uuid for franchise id %q.", st.FranchiseID),
+			}, nil
+		}
+		standings = append(standings, models.Standing{FranchiseID: fId, Wins: int(st.Wins), Losses: int(st.Losses), Points: int(st.Points), Rank: int(st.Rank)})
+	}
+	if err := importer.RankStandings(standings); err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Invalid standings: %v", err),
+		}, nil
+	}
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		league, err := managedLeague(ctx, tx, lId, req.UserId)
+		if err != nil {
+			return err
+		}
+		if err := writable(league); err != nil {
+			return err
+		}
+		year := draftYear(league, req.Year)
+		if year <= 0 {
+			return fmt.Errorf("a year must be given for leagues without a season")
+		}
+		franchises := leagueFranchises(league)
+		for _, st := range standings {
+			f, ok := franchises[st.FranchiseID]
+			if !ok {
+				return fmt.Errorf("franchise %v is not in the league: %w", st.FranchiseID, storage.ErrNotFound)
+			}
+			if f.Orphan {
+				return fmt.Errorf("the orphaned picks have no standing")
+			}
+		}
+		return tx.ReplaceStandings(ctx, league.ID, year, standings)
+	})
+
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Setting the standings of league (%s) failed: %v", req.LeagueID, err),
+		}, nil
+	}
+
+	return &pb.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "standings were set",
+	}, nil
+}
+
+func (s *Server) GetStandings(ctx context.Context, req *pb.GetStandingsRequest) (*pb.StandingsResponse, error) {
+	lId, err := uuid.Parse(req.LeagueID)
+	if err != nil {
+		return &pb.StandingsResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.LeagueID),
+		}, nil
+	}
+	league, year, err := s.seasonOf(ctx, lId, req.Year)
+	if err != nil {
+		return &pb.StandingsResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Getting the standings of league (%s) failed: %v", req.LeagueID, err),
+		}, nil
+	}
+	standings, err := s.R.ListStandings(ctx, league.ID, year)
+	if err != nil {
+		return &pb.StandingsResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Could not fetch the standings of %d. Error: %v", year, err),
+		}, nil
+	}
+
+	franchises := leagueFranchises(league)
+	result := []*pb.Standing{}
+	for _, st := range standings {
+		result = append(result, &pb.Standing{
+			FranchiseID:   st.FranchiseID.String(),
+			FranchiseName: franchises[st.FranchiseID].Name,
+			Wins:          int32(st.Wins),
+			Losses:        int32(st.Losses),
+			Points:        int32(st.Points),
+			Rank:          int32(st.Rank),
+		})
+	}
+	return &pb.StandingsResponse{
+		Status: http.StatusOK,
+		Year:   int32(year),
+		Result: result,
+	}, nil
+}
+
+func (s *Server) GetPowerRankings(ctx context.Context, req *pb.PowerRankingsRequest) (*pb.PowerRankingsResponse, error) {
+	weight := req.StandingsWeight
+	if weight == 0 {
+		weight = defaultStandingsWeight
+	}
+	if weight < 0 || weight > 1 {
+		return &pb.PowerRankingsResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("The standings weight %v must be between 0 and 1", req.StandingsWeight),
+		}, nil
+	}
+	lId, err := uuid.Parse(req.LeagueID)
+	if err != nil {
+		return &pb.PowerRankingsResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.LeagueID),
+		}, nil
+	}
+	league, year, err := s.seasonOf(ctx, lId, req.Year)
+	if err != nil {
+		return &pb.PowerRankingsResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Getting the power rankings of league (%s) failed: %v", req.LeagueID, err),
+		}, nil
+	}
+
+	standings, err := s.R.ListStandings(ctx, league.ID, year)
+	if err != nil {
+		return &pb.PowerRankingsResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Could not fetch the standings of %d. Error: %v", year, err),
+		}, nil
+	}
+	chart := s.Values
+	if chart == nil {
+		chart = DefaultValueChart()
+	}
+	now := time.Now().Local()
+	pipelines := map[uuid.UUID]float64{}
+	franchises := []models.Franchise{}
+	for _, f := range league.Franchises {
+		if f.Orphan {
+			continue
+		}
+		franchises = append(franchises, f)
+		prospects, err := allProspects(ctx, s.R, storage.ProspectFilter{FranchiseID: &f.ID})
+		if err != nil {
+			return &pb.PowerRankingsResponse{
+				Status: http.StatusConflict,
+				Error:  fmt.Sprintf("Could not fetch the prospects of franchise %q. Error: %v", f.ID, err),
+			}, nil
+		}
+		for i := range prospects {
+			pipelines[f.ID] += chart.prospectValue(&prospects[i], now)
+		}
+	}
+
+	return &pb.PowerRankingsResponse{
+		Status: http.StatusOK,
+		Year:   int32(year),
+		Result: powerRankings(franchises, standings, pipelines, weight),
+	}, nil
+}
+
+// powerRankings scores the franchises by their points and the value of their prospects relative to the
+// league's best, weighted by the standings weight. Ties go to the better standing, then the better pipeline.
+func powerRankings(franchises []models.Franchise, standings []models.Standing, pipelines map[uuid.UUID]float64, weight float64) []*pb.PowerRanking {
+	byFranchise := map[uuid.UUID]models.Standing{}
+	maxPoints, maxPipeline := 0, 0.0
+	for _, st := range standings {
+		byFranchise[st.FranchiseID] = st
+		if st.Points > maxPoints {
+			maxPoints = st.Points
+		}
+	}
+	for _, v := range pipelines {
+		if v > maxPipeline {
+			maxPipeline = v
+		}
+	}
+
+	type entry struct {
+		ranking  *pb.PowerRanking
+		score    float64
+		standing int
+		pipeline float64
+	}
+	entries := []entry{}
+	for _, f := range franchises {
+		e := entry{ranking: &pb.PowerRanking{FranchiseID: f.ID.String(), FranchiseName: f.Name}, pipeline: pipelines[f.ID]}
+		standingsScore, pipelineScore := 0.0, 0.0
+		if st, ok := byFranchise[f.ID]; ok {
+			e.standing = st.Rank
+			if maxPoints > 0 {
+				standingsScore = 100 * float64(st.Points) / float64(maxPoints)
+			}
+		}
+		if maxPipeline > 0 {
+			pipelineScore = 100 * e.pipeline / maxPipeline
+		}
+		e.score = weight*standingsScore + (1-weight)*pipelineScore
+		e.ranking.Score = round(e.score)
+		e.ranking.StandingsScore = round(standingsScore)
+		e.ranking.PipelineScore = round(pipelineScore)
+		e.ranking.PipelineValue = round(e.pipeline)
+		e.ranking.StandingsRank = int32(e.standing)
+		entries = append(entries, e)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		if a.standing != b.standing {
+			return b.standing == 0 || a.standing != 0 && a.standing < b.standing
+		}
+		return a.pipeline > b.pipeline
+	})
+	result := []*pb.PowerRanking{}
+	for i, e := range entries {
+		e.ranking.Rank = int32(i + 1)
+		result = append(result, e.ranking)
+	}
+	return result
+}
+
+// seasonOf returns the league and the year of the requested season, the league's season if unset
+func (s *Server) seasonOf(ctx context.Context, leagueID uuid.UUID, year int32) (*models.League, int, error) {
+	league, err := s.R.FindLeague(ctx, leagueID)
+	if err != nil {
+		return nil, 0, err
+	}
+	y := draftYear(league, year)
+	if y <= 0 {
+		return nil, 0, fmt.Errorf("a year must be given for leagues without a season")
+	}
+	return league, y, nil
+}
+
+// leagueFranchises maps the ids of the league's franchises to them
+func leagueFranchises(league *models.League) map[uuid.UUID]models.Franchise {
+	franchises := map[uuid.UUID]models.Franchise{}
+	for _, f := range league.Franchises {
+		franchises[f.ID] = f
+	}
+	return franchises
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func TestPowerRankings(t *testing.T) {
+	franchises := []models.Franchise{{ID: uuid.New(), Name: "a"}, {ID: uuid.New(), Name: "b"}, {ID: uuid.New(), Name: "c"}, {ID: uuid.New(), Name: "d"}}
+	a, b, c, d := franchises[0].ID, franchises[1].ID, franchises[2].ID, franchises[3].ID
+	standings := []models.Standing{{FranchiseID: b, Points: 40, Rank: 1}, {FranchiseID: a, Points: 20, Rank: 2}, {FranchiseID: c, Points: 20, Rank: 3}}
+	pipelines := map[uuid.UUID]float64{a: 50, b: 25, c: 75, d: 100}
+
+	var tests = []struct {
+		weight   float64
+		expected string
+		scores   []float64
+	}{
+		// b and c tie on 62.5, a and d on 50, ties go to the better standing
+		{0.5, "bcad", []float64{62.5, 62.5, 50, 50}},
+		// a and c tie on points, the better standing wins, d has none
+		{1, "bacd", []float64{100, 50, 50, 0}},
+		// without standings the pipeline decides
+		{0, "dcab", []float64{100, 75, 50, 25}},
+	}
+	for _, test := range tests {
+		order := ""
+		for i, r := range powerRankings(franchises, standings, pipelines, test.weight) {
+			order += r.FranchiseName
+			if r.Rank != int32(i+1) || r.Score != test.scores[i] {
+				t.Errorf("Power ranking %v with weight %v not as expected", r, test.weight)
+			}
+		}
+		if order != test.expected {
+			t.Errorf("Order %q with weight %v not equal to expected %q", order, test.weight, test.expected)
+		}
+	}
+}
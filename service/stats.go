@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/importer"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+func (s *Server) GetProspectStats(ctx context.Context, req *pb.GetProspectStatsRequest) (*pb.ProspectStatsResponse, error) {
+	pId, err := uuid.Parse(req.ProspectID)
+	if err != nil {
+		return &pb.ProspectStatsResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for prospect id %q.", req.ProspectID),
+		}, nil
+	}
+
+	prospect, err := s.R.FindProspect(ctx, pId)
+	if err != nil {
+		return &pb.ProspectStatsResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Prospect (%s) not found: %v", req.ProspectID, err),
+		}, nil
+	}
+
+	stats, err := s.R.ListProspectStats(ctx, storage.StatsFilter{ProspectIDs: []uuid.UUID{pId}, Season: int(req.Season)})
+	if err != nil {
+		return &pb.ProspectStatsResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Could not fetch the stats of prospect %q. Error: %v", pId, err),
+		}, nil
+	}
+
+	result := []*pb.ProspectSeasonStats{}
+	for _, st := range stats {
+		result = append(result, statsToPb(st))
+	}
+	return &pb.ProspectStatsResponse{
+		Status:   http.StatusOK,
+		Prospect: prospectToPb(*prospect),
+		Result:   result,
+	}, nil
+}
+
+func statsToPb(s models.ProspectSeasonStats) *pb.ProspectSeasonStats {
+	stats := &pb.ProspectSeasonStats{
+		ID:             s.ID.String(),
+		Season:         int32(s.Season),
+		SeasonLabel:    importer.SeasonLabel(s.Season),
+		Level:          s.Level,
+		Team:           s.Team,
+		GamesPlayed:    int32(s.GamesPlayed),
+		Goals:          int32(s.Goals),
+		Assists:        int32(s.Assists),
+		Points:         int32(s.Points),
+		PenaltyMinutes: int32(s.PenaltyMinutes),
+	}
+	if s.SavePercentage != nil {
+		stats.SavePercentage = *s.SavePercentage
+	}
+	if s.GoalsAgainstAverage != nil {
+		stats.GoalsAgainstAverage = *s.GoalsAgainstAverage
+	}
+	return stats
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/importer"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var statuses = map[models.ProspectStatus]pb.ProspectStatus{
+	models.Unsigned:      pb.ProspectStatus_PROSPECT_STATUS_UNSIGNED,
+	models.SignedELC:     pb.ProspectStatus_PROSPECT_STATUS_SIGNED_ELC,
+	models.AHL:           pb.ProspectStatus_PROSPECT_STATUS_AHL,
+	models.NhlGraduated:  pb.ProspectStatus_PROSPECT_STATUS_NHL_GRADUATED,
+	models.RightsExpired: pb.ProspectStatus_PROSPECT_STATUS_RIGHTS_EXPIRED,
+	models.Retired:       pb.ProspectStatus_PROSPECT_STATUS_RETIRED,
+}
+
+var statusActions = map[models.StatusAction]pb.StatusAction{
+	models.Keep:      pb.StatusAction_STATUS_ACTION_KEEP,
+	models.Release:   pb.StatusAction_STATUS_ACTION_RELEASE,
+	models.Unprotect: pb.StatusAction_STATUS_ACTION_UNPROTECT,
+}
+
+func (s *Server) SetProspectStatus(ctx context.Context, req *pb.ProspectStatusRequest) (*pb.ProspectResponse, error) {
+	pId, err := uuid.Parse(req.ProspectID)
+	if err != nil {
+		return &pb.ProspectResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for prospect id %q.", req.ProspectID),
+		}, nil
+	}
+	status, err := prospectStatus(req.Status)
+	if err != nil {
+		return &pb.ProspectResponse{
+			Status: http.StatusBadRequest,
+			Error:  err.Error(),
+		}, nil
+	}
+	effective := time.Now()
+	if req.EffectiveDate != nil {
+		effective = req.EffectiveDate.AsTime()
+	}
+	if effective.After(time.Now()) {
+		return &pb.ProspectResponse{
+			Status: http.StatusBadRequest,
+			Error:  "The effective date must not lie in the future",
+		}, nil
+	}
+
+	var prospect *models.Prospect
+	var changes []string
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		if prospect, err = tx.FindProspect(ctx, pId); err != nil {
+			return err
+		}
+		if changes, err = importer.ChangeStatus(ctx, tx, prospect, status, effective); err != nil || len(changes) == 0 {
+			return err
+		}
+		pick := prospect.Pick
+		prospect.Pick = nil
+		if err := tx.SaveProspect(ctx, prospect); err != nil {
+			return err
+		}
+		prospect.Pick = pick
+		return nil
+	})
+
+	if err != nil {
+		return &pb.ProspectResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Changing the status of prospect (%s) failed: %v", req.ProspectID, err),
+		}, nil
+	}
+
+	return &pb.ProspectResponse{
+		Status:   http.StatusOK,
+		Prospect: prospectToPb(*prospect),
+		Changes:  changes,
+	}, nil
+}
+
+func (s *Server) GetProspectStatusHistory(ctx context.Context, req *pb.ProspectStatusHistoryRequest) (*pb.ProspectStatusHistoryResponse, error) {
+	pId, err := uuid.Parse(req.ProspectID)
+	if err != nil {
+		return &pb.ProspectStatusHistoryResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for prospect id %q.", req.ProspectID),
+		}, nil
+	}
+
+	prospect, err := s.R.FindProspect(ctx, pId)
+	if err != nil {
+		return &pb.ProspectStatusHistoryResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Prospect (%s) not found: %v", req.ProspectID, err),
+		}, nil
+	}
+
+	history, err := s.R.ListProspectStatuses(ctx, pId)
+	if err != nil {
+		return &pb.ProspectStatusHistoryResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Could not fetch the status history of prospect %q. Error: %v", pId, err),
+		}, nil
+	}
+
+	result := []*pb.ProspectStatusChange{}
+	for _, c := range history {
+		result = append(result, &pb.ProspectStatusChange{
+			Status:        statuses[c.Status],
+			EffectiveDate: timestamppb.New(c.EffectiveDate),
+			RecordedAt:    timestamppb.New(c.CreatedAt),
+		})
+	}
+	return &pb.ProspectStatusHistoryResponse{
+		Status:   http.StatusOK,
+		Prospect: prospectToPb(*prospect),
+		Result:   result,
+	}, nil
+}
+
+// prospectStatus returns the status of the enum
+func prospectStatus(typed pb.ProspectStatus) (models.ProspectStatus, error) {
+	for s, v := range statuses {
+		if v == typed {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("invalid status %v", typed)
+}
+
+// statusRulesFromPb returns the rules with the given ones replacing the rules of their statuses
+func statusRulesFromPb(rules models.StatusRules, req []*pb.StatusRule) (models.StatusRules, error) {
+	result := models.StatusRules{}
+	for s, a := range rules {
+		result[s] = a
+	}
+	for _, r := range req {
+		status, err := prospectStatus(r.Status)
+		if err != nil {
+			return nil, err
+		}
+		action := models.Keep
+		for a, v := range statusActions {
+			if v == r.Action {
+				action = a
+			}
+		}
+		if action == models.Keep {
+			delete(result, status)
+			continue
+		}
+		result[status] = action
+	}
+	return result, nil
+}
+
+func statusRulesToPb(rules models.StatusRules) []*pb.StatusRule {
+	result := []*pb.StatusRule{}
+	for _, r := range []models.ProspectStatus{models.Unsigned, models.SignedELC, models.AHL, models.NhlGraduated, models.RightsExpired, models.Retired} {
+		if action, ok := rules[r]; ok && action != models.Keep {
+			result = append(result, &pb.StatusRule{Status: statuses[r], Action: statusActions[action]})
+		}
+	}
+	return result
+}
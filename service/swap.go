@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetPickSwaps returns the league's swaps, the resolved ones are its ledger of exchanged picks
+func (s *Server) GetPickSwaps(ctx context.Context, req *pb.GetLeagueRequest) (*pb.PickSwapsResponse, error) {
+	lId, err := uuid.Parse(req.LeagueId)
+	if err != nil {
+		return &pb.PickSwapsResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.LeagueId),
+		}, nil
+	}
+
+	swaps, err := s.R.ListPickSwaps(ctx, storage.SwapFilter{LeagueID: &lId})
+	if err != nil {
+		return &pb.PickSwapsResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Could not fetch pick swaps of league %q. Error: %v", lId, err),
+		}, nil
+	}
+
+	result := []*pb.PickSwap{}
+	for _, sw := range swaps {
+		result = append(result, swapToPb(sw))
+	}
+	return &pb.PickSwapsResponse{
+		Status: http.StatusOK,
+		Result: result,
+	}, nil
+}
+
+// tradeSwapRight grants the holder the right to swap its pick with the grantor's pick,
+// both picks stay with their franchises until the swap is resolved
+func tradeSwapRight(ctx context.Context, tx storage.Repository, req *pb.SwapRight, grantor *models.Franchise, holder *models.Franchise) error {
+	grantorPick, err := ownPick(ctx, tx, req.PickID, grantor)
+	if err != nil {
+		return err
+	}
+	holderPick, err := ownPick(ctx, tx, req.SwapPickID, holder)
+	if err != nil {
+		return err
+	}
+	// only picks of the same draft and round are swapped
+	if holderPick.DraftYear != grantorPick.DraftYear || holderPick.DraftRound != grantorPick.DraftRound {
+		return fmt.Errorf("pick %v of round %d in %d cannot be swapped with pick %v of round %d in %d", holderPick.ID, holderPick.DraftRound, holderPick.DraftYear, grantorPick.ID, grantorPick.DraftRound, grantorPick.DraftYear)
+	}
+	swap := models.PickSwap{
+		LeagueID:      grantor.LeagueID,
+		HolderID:      holder.ID,
+		HolderName:    holder.Name,
+		HolderPickID:  holderPick.ID,
+		GrantorID:     grantor.ID,
+		GrantorName:   grantor.Name,
+		GrantorPickID: grantorPick.ID,
+		Status:        models.SwapPending,
+	}
+	if err := tx.CreatePickSwap(ctx, &swap); err != nil {
+		return err
+	}
+	// the positions may already be set
+	return resolveSwap(ctx, tx, &swap, time.Now().Local())
+}
+
+// resolvePickSwaps resolves the league's pending swaps whose picks both have a position
+func resolvePickSwaps(ctx context.Context, tx storage.Repository, leagueID uuid.UUID) error {
+	pending, err := tx.ListPickSwaps(ctx, storage.SwapFilter{LeagueID: &leagueID, Status: models.SwapPending})
+	if err != nil {
+		return err
+	}
+	now := time.Now().Local()
+	for i := range pending {
+		if err := resolveSwap(ctx, tx, &pending[i], now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveSwap exchanges the picks if the grantor's pick is the better one
+func resolveSwap(ctx context.Context, tx storage.Repository, swap *models.PickSwap, now time.Time) error {
+	holderPick, err := tx.FindPick(ctx, swap.HolderPickID)
+	if err != nil {
+		return err
+	}
+	grantorPick, err := tx.FindPick(ctx, swap.GrantorPickID)
+	if err != nil {
+		return err
+	}
+	if holderPick.DraftPickOverall == nil || grantorPick.DraftPickOverall == nil {
+		return nil
+	}
+
+	swap.Status = models.SwapDeclined
+	if *grantorPick.DraftPickOverall < *holderPick.DraftPickOverall {
+		if err := movePick(ctx, tx, grantorPick, swap.HolderID, swap.HolderName); err != nil {
+			return err
+		}
+		if err := movePick(ctx, tx, holderPick, swap.GrantorID, swap.GrantorName); err != nil {
+			return err
+		}
+		swap.Status = models.SwapExercised
+	}
+	swap.ResolvedAt = &now
+	return tx.SavePickSwap(ctx, swap)
+}
+
+// movePick moves the pick from its owner to the franchise
+func movePick(ctx context.Context, tx storage.Repository, pick *models.Pick, toID uuid.UUID, toName string) error {
+	pick.LastOwnerID = pick.OwnerID
+	pick.LastOwnerName = pick.OwnerName
+	pick.OwnerID = &toID
+	pick.OwnerName = toName
+	if err := delist(ctx, tx, pick.ID); err != nil {
+		return err
+	}
+	return tx.SavePick(ctx, pick)
+}
+
+func swapToPb(s models.PickSwap) *pb.PickSwap {
+	swap := &pb.PickSwap{
+		ID:                   s.ID.String(),
+		HolderFranchiseID:    s.HolderID.String(),
+		HolderFranchiseName:  s.HolderName,
+		HolderPickID:         s.HolderPickID.String(),
+		GrantorFranchiseID:   s.GrantorID.String(),
+		GrantorFranchiseName: s.GrantorName,
+		GrantorPickID:        s.GrantorPickID.String(),
+		Status:               string(s.Status),
+	}
+	if s.ResolvedAt != nil {
+		swap.ResolvedAt = timestamppb.New(*s.ResolvedAt)
+	}
+	return swap
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) AddToTradeBlock(ctx context.Context, req *pb.TradeBlockRequest) (*pb.DefaultResponse, error) {
+	fId, assetID, err := tradeBlockAsset(req)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		franchise, err := tx.FindFranchise(ctx, fId)
+		if err != nil {
+			return err
+		}
+		if err := authorize(ctx, tx, franchise, req.UserId, models.Trade); err != nil {
+			return err
+		}
+
+		entry := models.TradeBlockEntry{LeagueID: franchise.LeagueID, FranchiseID: franchise.ID, Note: req.Note}
+		if req.ProspectID != "" {
+			prospect, err := tx.FindProspect(ctx, assetID)
+			if err != nil {
+				return err
+			}
+			if prospect.FranchiseID == nil || *prospect.FranchiseID != franchise.ID {
+				return fmt.Errorf("prospect %v is not on the roster of franchise %v", assetID, franchise.ID)
+			}
+			entry.ProspectID = &prospect.ID
+		} else {
+			pick, err := ownPick(ctx, tx, req.PickID, franchise)
+			if err != nil {
+				return err
+			}
+			entry.PickID = &pick.ID
+		}
+		return tx.SaveTradeBlockEntry(ctx, &entry)
+	})
+
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Adding to the trade block of franchise (%s) failed: %v", req.FranchiseID, err),
+		}, nil
+	}
+
+	return &pb.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "asset was added to the trade block",
+	}, nil
+}
+
+func (s *Server) RemoveFromTradeBlock(ctx context.Context, req *pb.TradeBlockRequest) (*pb.DefaultResponse, error) {
+	fId, assetID, err := tradeBlockAsset(req)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		franchise, err := tx.FindFranchise(ctx, fId)
+		if err != nil {
+			return err
+		}
+		if err := authorize(ctx, tx, franchise, req.UserId, models.Trade); err != nil {
+			return err
+		}
+		return tx.RemoveTradeBlockEntries(ctx, storage.TradeBlockFilter{FranchiseID: &franchise.ID, AssetID: &assetID})
+	})
+
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Removing from the trade block of franchise (%s) failed: %v", req.FranchiseID, err),
+		}, nil
+	}
+
+	return &pb.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "asset was removed from the trade block",
+	}, nil
+}
+
+func (s *Server) SetTradeProfile(ctx context.Context, req *pb.TradeProfileRequest) (*pb.DefaultResponse, error) {
+	fId, err := uuid.Parse(req.FranchiseID)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for franchise id %q.", req.FranchiseID),
+		}, nil
+	}
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		franchise, err := tx.FindFranchise(ctx, fId)
+		if err != nil {
+			return err
+		}
+		if err := authorize(ctx, tx, franchise, req.UserId, models.Trade); err != nil {
+			return err
+		}
+		franchise.LookingFor = req.LookingFor
+		return tx.SaveFranchise(ctx, franchise)
+	})
+
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Setting the trade profile of franchise (%s) failed: %v", req.FranchiseID, err),
+		}, nil
+	}
+
+	return &pb.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "trade profile was updated",
+	}, nil
+}
+
+func (s *Server) ListTradeBlock(ctx context.Context, req *pb.ListTradeBlockRequest) (*pb.TradeBlockResponse, error) {
+	lId, err := uuid.Parse(req.LeagueID)
+	if err != nil {
+		return &pb.TradeBlockResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for league id %q.", req.LeagueID),
+		}, nil
+	}
+	filter := storage.TradeBlockFilter{LeagueID: &lId}
+	if req.FranchiseID != "" {
+		fId, err := uuid.Parse(req.FranchiseID)
+		if err != nil {
+			return &pb.TradeBlockResponse{
+				Status: http.StatusBadRequest,
+				Error:  fmt.Sprintf("Could not parse uuid for franchise id %q.", req.FranchiseID),
+			}, nil
+		}
+		filter.FranchiseID = &fId
+	}
+	positionCode, err := position(req.Position, "")
+	if err != nil {
+		return &pb.TradeBlockResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Invalid filter: %v", err),
+		}, nil
+	}
+
+	league, err := s.R.FindLeague(ctx, lId)
+	if err != nil {
+		return &pb.TradeBlockResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("League (%s) not found: %v", req.LeagueID, err),
+		}, nil
+	}
+	names := map[uuid.UUID]string{}
+	profiles := []*pb.TradeProfile{}
+	for _, f := range league.Franchises {
+		names[f.ID] = f.Name
+		if f.LookingFor != "" && (filter.FranchiseID == nil || *filter.FranchiseID == f.ID) {
+			profiles = append(profiles, &pb.TradeProfile{FranchiseID: f.ID.String(), FranchiseName: f.Name, LookingFor: f.LookingFor})
+		}
+	}
+
+	entries, err := s.R.ListTradeBlock(ctx, filter)
+	if err != nil {
+		return &pb.TradeBlockResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Could not fetch the trade block of league %q. Error: %v", lId, err),
+		}, nil
+	}
+
+	result := []*pb.TradeBlockEntry{}
+	for _, e := range entries {
+		entry := &pb.TradeBlockEntry{
+			ID:            e.ID.String(),
+			FranchiseID:   e.FranchiseID.String(),
+			FranchiseName: names[e.FranchiseID],
+			Note:          e.Note,
+			ListedAt:      timestamppb.New(e.CreatedAt),
+		}
+		if e.ProspectID != nil {
+			if req.DraftYear != 0 || req.DraftRound != 0 {
+				continue
+			}
+			prospect, err := s.R.FindProspect(ctx, *e.ProspectID)
+			if err != nil {
+				return &pb.TradeBlockResponse{
+					Status: http.StatusConflict,
+					Error:  fmt.Sprintf("Could not fetch listed prospect %q. Error: %v", e.ProspectID, err),
+				}, nil
+			}
+			if positionCode != "" && prospect.PositionCode != positionCode {
+				continue
+			}
+			entry.Prospect = prospectToPb(*prospect)
+		} else {
+			if positionCode != "" {
+				continue
+			}
+			pick, err := s.R.FindPick(ctx, idValue(e.PickID))
+			if err != nil {
+				return &pb.TradeBlockResponse{
+					Status: http.StatusConflict,
+					Error:  fmt.Sprintf("Could not fetch listed pick %q. Error: %v", e.PickID, err),
+				}, nil
+			}
+			if (req.DraftYear != 0 && pick.DraftYear != int(req.DraftYear)) || (req.DraftRound != 0 && pick.DraftRound != int(req.DraftRound)) {
+				continue
+			}
+			entry.Pick = pickToPb(*pick)
+		}
+		result = append(result, entry)
+	}
+
+	return &pb.TradeBlockResponse{
+		Status:   http.StatusOK,
+		Result:   result,
+		Profiles: profiles,
+	}, nil
+}
+
+// tradeBlockAsset parses the franchise and the listed prospect or pick of the request
+func tradeBlockAsset(req *pb.TradeBlockRequest) (uuid.UUID, uuid.UUID, error) {
+	fId, err := uuid.Parse(req.FranchiseID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("Could not parse uuid for franchise id %q.", req.FranchiseID)
+	}
+	if (req.ProspectID == "") == (req.PickID == "") {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("Either a prospect or a pick must be given")
+	}
+	id := req.ProspectID + req.PickID
+	assetID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("Could not parse uuid for asset id %q.", id)
+	}
+	return fId, assetID, nil
+}
+
+// delist removes the listings of the prospect or pick, it moved to another franchise or was used
+func delist(ctx context.Context, tx storage.Repository, assetID uuid.UUID) error {
+	return tx.RemoveTradeBlockEntries(ctx, storage.TradeBlockFilter{AssetID: &assetID})
+}
@@ -0,0 +1,307 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+)
+
+// ValueChart values picks by their overall position and prospects by their nhl draft, age and position.
+type ValueChart struct {
+	// Picks are the values of the overall picks from the first on, later picks keep the last value
+	Picks []float64
+	// FutureDecay discounts a pick per year its draft lies after the league's season
+	FutureDecay float64
+	// UnknownDiscount discounts picks without a position, they are valued at the average of their round
+	UnknownDiscount float64
+	// NhlFirst is the value of the first nhl pick, it falls by NhlDecay per slot. Undrafted prospects are worth NhlUndrafted.
+	NhlFirst     float64
+	NhlDecay     float64
+	NhlUndrafted float64
+	// AgeFactor changes a prospect's value per year it is younger or older than PrimeAge
+	PrimeAge  float64
+	AgeFactor float64
+	// Positions weigh prospects by their position, missing positions weigh 1
+	Positions map[models.Position]float64
+}
+
+// DefaultValueChart is used if the server has no chart.
+func DefaultValueChart() *ValueChart {
+	picks := make([]float64, 64)
+	for i := range picks {
+		picks[i] = math.Round(1000 * math.Pow(0.93, float64(i)))
+	}
+	return &ValueChart{
+		Picks:           picks,
+		FutureDecay:     0.85,
+		UnknownDiscount: 0.9,
+		NhlFirst:        1000,
+		NhlDecay:        0.985,
+		NhlUndrafted:    50,
+		PrimeAge:        21,
+		AgeFactor:       0.1,
+		Positions:       map[models.Position]float64{models.Defenseman: 0.95, models.Goalie: 0.8},
+	}
+}
+
+// pickValue is the value of the overall pick
+func (c *ValueChart) pickValue(overall int) float64 {
+	if len(c.Picks) == 0 || overall < 1 {
+		return 0
+	}
+	if overall > len(c.Picks) {
+		return c.Picks[len(c.Picks)-1]
+	}
+	return c.Picks[overall-1]
+}
+
+// prospectValue is the value of the prospect at now
+func (c *ValueChart) prospectValue(p *models.Prospect, now time.Time) float64 {
+	value := c.NhlUndrafted
+	if p.NhlDraftPickOverall > 0 {
+		value = c.NhlFirst * math.Pow(c.NhlDecay, float64(p.NhlDraftPickOverall-1))
+	}
+	if p.Birthdate != nil {
+		age := now.Sub(*p.Birthdate).Hours() / 24 / 365.25
+		value *= math.Max(0, 1+c.AgeFactor*(c.PrimeAge-age))
+	}
+	if weight, ok := c.Positions[p.PositionCode]; ok {
+		value *= weight
+	}
+	return value
+}
+
+func (s *Server) EvaluateTrade(ctx context.Context, req *pb.TradeRequest) (*pb.TradeEvaluationResponse, error) {
+	if req.First == nil || req.Second == nil {
+		return &pb.TradeEvaluationResponse{
+			Status: http.StatusBadRequest,
+			Error:  "A trade needs two sides",
+		}, nil
+	}
+
+	first, second, err := s.evaluateTrade(ctx, req)
+	if err != nil {
+		return &pb.TradeEvaluationResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Evaluating the trade failed: %v", err),
+		}, nil
+	}
+
+	first.Received, second.Received = second.Given, first.Given
+	first.Delta = round(first.Received - first.Given)
+	second.Delta = round(second.Received - second.Given)
+	return &pb.TradeEvaluationResponse{
+		Status: http.StatusOK,
+		First:  first,
+		Second: second,
+	}, nil
+}
+
+// evaluateTrade values the assets each side gives without changing anything
+func (s *Server) evaluateTrade(ctx context.Context, req *pb.TradeRequest) (*pb.TradeSideValue, *pb.TradeSideValue, error) {
+	firstFranchise, err := findFranchise(ctx, s.R, req.First.FranchiseID)
+	if err != nil {
+		return nil, nil, err
+	}
+	secondFranchise, err := findFranchise(ctx, s.R, req.Second.FranchiseID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if firstFranchise.LeagueID != secondFranchise.LeagueID {
+		return nil, nil, errors.New("the franchises play in different leagues")
+	}
+	league, err := s.R.FindLeague(ctx, firstFranchise.LeagueID)
+	if err != nil {
+		return nil, nil, err
+	}
+	teams, err := s.R.CountFranchises(ctx, league.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chart := s.Values
+	if chart == nil {
+		chart = DefaultValueChart()
+	}
+	now := time.Now().Local()
+	v := &valuation{chart: chart, season: league.Season, teams: int(teams), now: now}
+	if v.season == 0 {
+		v.season = nextDraftYear(now)
+	}
+	if v.teams < 1 {
+		v.teams = 1
+	}
+
+	first, err := v.side(ctx, s.R, req.First, firstFranchise, secondFranchise)
+	if err != nil {
+		return nil, nil, err
+	}
+	second, err := v.side(ctx, s.R, req.Second, secondFranchise, firstFranchise)
+	if err != nil {
+		return nil, nil, err
+	}
+	return first, second, nil
+}
+
+// findFranchise parses the id and returns the franchise
+func findFranchise(ctx context.Context, r storage.Repository, id string) (*models.Franchise, error) {
+	fId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse FranchiseID %v", id)
+	}
+	return r.FindFranchise(ctx, fId)
+}
+
+// valuation values the assets of a league's trade
+type valuation struct {
+	chart  *ValueChart
+	season int
+	teams  int
+	now    time.Time
+}
+
+// slot is a position a pick may end up at
+type slot struct {
+	inRound int
+	overall int
+}
+
+// side values the assets the franchise gives to the other one
+func (v *valuation) side(ctx context.Context, r storage.Repository, payload *pb.TradePayload, from *models.Franchise, to *models.Franchise) (*pb.TradeSideValue, error) {
+	side := &pb.TradeSideValue{FranchiseID: from.ID.String(), Assets: []*pb.AssetValue{}}
+	add := func(id uuid.UUID, kind string, description string, value float64) {
+		value = round(value)
+		side.Assets = append(side.Assets, &pb.AssetValue{ID: id.String(), Kind: kind, Description: description, Value: value})
+		side.Given = round(side.Given + value)
+	}
+
+	for _, id := range payload.Picks {
+		pick, err := ownPick(ctx, r, id, from)
+		if err != nil {
+			return nil, err
+		}
+		add(pick.ID, "pick", pickDescription(pick), v.pick(pick))
+	}
+	for _, c := range payload.ConditionalPicks {
+		pick, err := ownPick(ctx, r, c.PickID, from)
+		if err != nil {
+			return nil, err
+		}
+		var substitute *models.Pick
+		if c.SubstitutePickID != "" {
+			if substitute, err = ownPick(ctx, r, c.SubstitutePickID, from); err != nil {
+				return nil, err
+			}
+		}
+		description := fmt.Sprintf("%s, top-%d protected", pickDescription(pick), c.ProtectedTop)
+		add(pick.ID, "conditional pick", description, v.conditional(pick, int(c.ProtectedTop), substitute))
+	}
+	for _, sr := range payload.SwapRights {
+		pick, err := ownPick(ctx, r, sr.PickID, from)
+		if err != nil {
+			return nil, err
+		}
+		holderPick, err := ownPick(ctx, r, sr.SwapPickID, to)
+		if err != nil {
+			return nil, err
+		}
+		description := fmt.Sprintf("right to swap %s", pickDescription(pick))
+		add(pick.ID, "swap right", description, v.swap(pick, holderPick))
+	}
+	for _, id := range payload.Prospects {
+		pId, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse ProspectID %v", id)
+		}
+		prospect, err := r.FindProspect(ctx, pId)
+		if err != nil {
+			return nil, err
+		}
+		if prospect.FranchiseID == nil || *prospect.FranchiseID != from.ID {
+			return nil, fmt.Errorf("prospect %v is not on the roster of franchise %v", pId, from.ID)
+		}
+		add(prospect.ID, "prospect", prospect.FullName, v.chart.prospectValue(prospect, v.now))
+	}
+	return side, nil
+}
+
+// slots are the positions of the pick, every position of its round if it has none
+func (v *valuation) slots(p *models.Pick) []slot {
+	if p.DraftPickInRound != nil && p.DraftPickOverall != nil {
+		return []slot{{*p.DraftPickInRound, *p.DraftPickOverall}}
+	}
+	slots := make([]slot, v.teams)
+	for i := range slots {
+		slots[i] = slot{i + 1, (p.DraftRound-1)*v.teams + i + 1}
+	}
+	return slots
+}
+
+// at is the value of the pick at the overall position
+func (v *valuation) at(p *models.Pick, overall int) float64 {
+	if p.Forfeited() || p.ProspectID != nil {
+		return 0
+	}
+	value := v.chart.pickValue(overall)
+	if years := p.DraftYear - v.season; years > 0 {
+		value *= math.Pow(v.chart.FutureDecay, float64(years))
+	}
+	if p.DraftPickOverall == nil {
+		value *= v.chart.UnknownDiscount
+	}
+	return value
+}
+
+// pick is the expected value of the pick over its possible positions
+func (v *valuation) pick(p *models.Pick) float64 {
+	slots := v.slots(p)
+	sum := 0.0
+	for _, s := range slots {
+		sum += v.at(p, s.overall)
+	}
+	return sum / float64(len(slots))
+}
+
+// conditional is the expected value of a protected pick, the substitute replaces it in the protected positions
+func (v *valuation) conditional(p *models.Pick, protectedTop int, substitute *models.Pick) float64 {
+	slots := v.slots(p)
+	sum := 0.0
+	for _, s := range slots {
+		switch {
+		case s.inRound > protectedTop:
+			sum += v.at(p, s.overall)
+		case substitute != nil:
+			sum += v.pick(substitute)
+		}
+	}
+	return sum / float64(len(slots))
+}
+
+// swap is the expected gain of the holder of the right to swap its pick for the grantor's pick
+func (v *valuation) swap(grantor *models.Pick, holder *models.Pick) float64 {
+	grantorSlots, holderSlots := v.slots(grantor), v.slots(holder)
+	sum := 0.0
+	for _, g := range grantorSlots {
+		for _, h := range holderSlots {
+			sum += math.Max(0, v.at(grantor, g.overall)-v.at(holder, h.overall))
+		}
+	}
+	return sum / float64(len(grantorSlots)*len(holderSlots))
+}
+
+func pickDescription(p *models.Pick) string {
+	return fmt.Sprintf("%d round %d pick of %s", p.DraftYear, p.DraftRound, p.OriginName)
+}
+
+// round rounds a value to one decimal
+func round(value float64) float64 {
+	return math.Round(value*10) / 10
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func TestPickValues(t *testing.T) {
+	chart := &ValueChart{Picks: []float64{100, 80, 60, 40}, FutureDecay: 0.5, UnknownDiscount: 0.5}
+	v := &valuation{chart: chart, season: 2024, teams: 2}
+	at := func(inRound int, overall int) (*int, *int) {
+		return &inRound, &overall
+	}
+	positioned := func(year int, round int, inRound int, overall int) *models.Pick {
+		p := &models.Pick{DraftYear: year, DraftRound: round}
+		p.DraftPickInRound, p.DraftPickOverall = at(inRound, overall)
+		return p
+	}
+
+	var tests = []struct {
+		name     string
+		actual   float64
+		expected float64
+	}{
+		{"positioned", v.pick(positioned(2024, 1, 2, 2)), 80},
+		{"after the chart", v.pick(positioned(2024, 4, 2, 8)), 40},
+		{"next year", v.pick(positioned(2025, 1, 1, 1)), 50},
+		{"unknown position", v.pick(&models.Pick{DraftYear: 2024, DraftRound: 2}), 25},
+		{"forfeited", v.pick(&models.Pick{DraftYear: 2024, DraftRound: 1, ForfeitedAt: &time.Time{}}), 0},
+		{"protected", v.conditional(positioned(2024, 1, 1, 1), 1, positioned(2024, 2, 2, 4)), 40},
+		{"not protected", v.conditional(positioned(2024, 1, 2, 2), 1, nil), 80},
+		{"unknown protected", v.conditional(&models.Pick{DraftYear: 2024, DraftRound: 1}, 1, nil), 20},
+		{"swap exercised", v.swap(positioned(2024, 1, 1, 1), positioned(2024, 1, 2, 2)), 20},
+		{"swap declined", v.swap(positioned(2024, 1, 2, 2), positioned(2024, 1, 1, 1)), 0},
+		{"unknown swap", v.swap(&models.Pick{DraftYear: 2024, DraftRound: 1}, &models.Pick{DraftYear: 2024, DraftRound: 1}), 2.5},
+	}
+	for _, test := range tests {
+		if math.Abs(test.actual-test.expected) > 1e-9 {
+			t.Errorf("Value %v of %s not equal to expected %v", test.actual, test.name, test.expected)
+		}
+	}
+}
+
+func TestProspectValues(t *testing.T) {
+	chart := &ValueChart{NhlFirst: 100, NhlDecay: 0.5, NhlUndrafted: 10, PrimeAge: 20, AgeFactor: 0.1, Positions: map[models.Position]float64{models.Goalie: 0.5}}
+	now := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	born := func(year int) *time.Time {
+		b := time.Date(year, 7, 1, 0, 0, 0, 0, time.UTC)
+		return &b
+	}
+
+	var tests = []struct {
+		prospect models.Prospect
+		expected float64
+	}{
+		{models.Prospect{NhlDraftPickOverall: 1, PositionCode: models.Center}, 100},
+		{models.Prospect{NhlDraftPickOverall: 2, PositionCode: models.Center}, 50},
+		{models.Prospect{PositionCode: models.Center}, 10},
+		{models.Prospect{NhlDraftPickOverall: 1, PositionCode: models.Goalie}, 50},
+		{models.Prospect{NhlDraftPickOverall: 1, PositionCode: models.Center, Birthdate: born(2006)}, 120},
+		{models.Prospect{NhlDraftPickOverall: 1, PositionCode: models.Center, Birthdate: born(2002)}, 80},
+	}
+	for _, test := range tests {
+		actual := chart.prospectValue(&test.prospect, now)
+		if math.Abs(actual-test.expected) > 0.1 {
+			t.Errorf("Value %v of %+v not equal to expected %v", actual, test.prospect, test.expected)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
+	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) AddToWatchlist(ctx context.Context, req *pb.WatchlistRequest) (*pb.DefaultResponse, error) {
+	uId, pId, err := watchlistIDs(req)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		if _, err := tx.FindProspect(ctx, pId); err != nil {
+			return err
+		}
+		if _, err := tx.FindWatchlistEntry(ctx, uId, pId); err == nil {
+			return fmt.Errorf("prospect %v is already on the watchlist", pId)
+		} else if !errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+		entry := models.WatchlistEntry{UserID: uId, ProspectID: pId}
+		watchlistFromPb(&entry, req)
+		return tx.CreateWatchlistEntry(ctx, &entry)
+	})
+
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Adding prospect (%s) to the watchlist failed: %v", req.ProspectID, err),
+		}, nil
+	}
+
+	return &pb.DefaultResponse{
+		Status:  http.StatusCreated,
+		Message: "prospect was added to the watchlist",
+	}, nil
+}
+
+func (s *Server) UpdateWatchlistEntry(ctx context.Context, req *pb.WatchlistRequest) (*pb.DefaultResponse, error) {
+	uId, pId, err := watchlistIDs(req)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		entry, err := tx.FindWatchlistEntry(ctx, uId, pId)
+		if err != nil {
+			return err
+		}
+		watchlistFromPb(entry, req)
+		return tx.SaveWatchlistEntry(ctx, entry)
+	})
+
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Updating prospect (%s) on the watchlist failed: %v", req.ProspectID, err),
+		}, nil
+	}
+
+	return &pb.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "watchlist entry was updated",
+	}, nil
+}
+
+func (s *Server) RemoveFromWatchlist(ctx context.Context, req *pb.WatchlistRequest) (*pb.DefaultResponse, error) {
+	uId, pId, err := watchlistIDs(req)
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: http.StatusBadRequest,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	err = s.R.Transaction(ctx, func(tx storage.Repository) error {
+		entry, err := tx.FindWatchlistEntry(ctx, uId, pId)
+		if err != nil {
+			return err
+		}
+		return tx.DeleteWatchlistEntry(ctx, entry.ID)
+	})
+
+	if err != nil {
+		return &pb.DefaultResponse{
+			Status: franchiseStatus(err),
+			Error:  fmt.Sprintf("Removing prospect (%s) from the watchlist failed: %v", req.ProspectID, err),
+		}, nil
+	}
+
+	return &pb.DefaultResponse{
+		Status:  http.StatusOK,
+		Message: "prospect was removed from the watchlist",
+	}, nil
+}
+
+func (s *Server) GetWatchlist(ctx context.Context, req *pb.GetWatchlistRequest) (*pb.WatchlistResponse, error) {
+	uId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.WatchlistResponse{
+			Status: http.StatusBadRequest,
+			Error:  fmt.Sprintf("Could not parse uuid for user id %q.", req.UserId),
+		}, nil
+	}
+
+	entries, err := s.R.ListWatchlist(ctx, storage.WatchlistFilter{UserID: uId, Tag: strings.TrimSpace(req.Tag)})
+	if err != nil {
+		return &pb.WatchlistResponse{
+			Status: http.StatusConflict,
+			Error:  fmt.Sprintf("Could not fetch the watchlist of user %q. Error: %v", uId, err),
+		}, nil
+	}
+
+	result := []*pb.WatchlistEntry{}
+	for _, e := range entries {
+		prospect, err := s.R.FindProspect(ctx, e.ProspectID)
+		if err != nil {
+			return &pb.WatchlistResponse{
+				Status: http.StatusConflict,
+				Error:  fmt.Sprintf("Could not fetch watched prospect %q. Error: %v", e.ProspectID, err),
+			}, nil
+		}
+		entry := watchlistToPb(e)
+		entry.Prospect = prospectToPb(*prospect)
+		result = append(result, entry)
+	}
+
+	return &pb.WatchlistResponse{
+		Status: http.StatusOK,
+		Result: result,
+	}, nil
+}
+
+// annotateWatchlist sets the user's watchlist entries on the prospects
+func annotateWatchlist(ctx context.Context, r storage.Repository, userID uuid.UUID, prospects []*pb.Prospect) error {
+	ids := make([]uuid.UUID, 0, len(prospects))
+	for _, p := range prospects {
+		ids = append(ids, uuid.MustParse(p.ID))
+	}
+	entries, err := r.ListWatchlist(ctx, storage.WatchlistFilter{UserID: userID, ProspectIDs: ids})
+	if err != nil {
+		return err
+	}
+	watched := map[string]*pb.WatchlistEntry{}
+	for _, e := range entries {
+		watched[e.ProspectID.String()] = watchlistToPb(e)
+	}
+	for _, p := range prospects {
+		p.Watchlist = watched[p.ID]
+	}
+	return nil
+}
+
+// watchlistIDs parses the user and the prospect of the request
+func watchlistIDs(req *pb.WatchlistRequest) (uuid.UUID, uuid.UUID, error) {
+	uId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("Could not parse uuid for user id %q.", req.UserId)
+	}
+	pId, err := uuid.Parse(req.ProspectID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("Could not parse uuid for prospect id %q.", req.ProspectID)
+	}
+	if req.Rank < 0 {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("Rank %d must not be negative", req.Rank)
+	}
+	return uId, pId, nil
+}
+
+// watchlistFromPb replaces the note, tags and rank of the entry, tags are trimmed and deduplicated
+func watchlistFromPb(entry *models.WatchlistEntry, req *pb.WatchlistRequest) {
+	entry.Note = req.Note
+	entry.Tags = models.Tags{}
+	for _, t := range req.Tags {
+		if t = strings.TrimSpace(t); t != "" && !entry.Tags.Has(t) {
+			entry.Tags = append(entry.Tags, t)
+		}
+	}
+	entry.Rank = nil
+	if req.Rank > 0 {
+		rank := int(req.Rank)
+		entry.Rank = &rank
+	}
+}
+
+func watchlistToPb(e models.WatchlistEntry) *pb.WatchlistEntry {
+	entry := &pb.WatchlistEntry{
+		ID:         e.ID.String(),
+		ProspectID: e.ProspectID.String(),
+		Note:       e.Note,
+		Tags:       append([]string{}, e.Tags...),
+		AddedAt:    timestamppb.New(e.CreatedAt),
+	}
+	if e.Rank != nil {
+		entry.Rank = int32(*e.Rank)
+	}
+	return entry
+}
@@ -1,19 +1,15 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hiltpold/lakelandcup-fantasy-service/conf"
-	"github.com/hiltpold/lakelandcup-fantasy-service/models"
 	"github.com/sirupsen/logrus"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
-type Repository struct {
-	DB *gorm.DB
-}
-
 func openDb(c *conf.PostgresConfiguration, gormConfig *gorm.Config, database string) *gorm.DB {
 	connectionString := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?search_path=%s", c.User, c.Password, c.Host, c.Port, database, c.AppDatabaseSchema)
 	db, err := gorm.Open(postgres.Open(connectionString), gormConfig)
@@ -24,7 +20,8 @@ func openDb(c *conf.PostgresConfiguration, gormConfig *gorm.Config, database str
 	return db
 }
 
-func Dial(c *conf.PostgresConfiguration) Repository {
+// Open connects to postgres and creates database and schema if necessary.
+func Open(c *conf.PostgresConfiguration) *gorm.DB {
 	gormDefaultConfig := &gorm.Config{}
 	gormAppConfig := &gorm.Config{}
 
@@ -34,8 +31,7 @@ func Dial(c *conf.PostgresConfiguration) Repository {
 
 	// check if database exists and create it if necessary
 	var dbexists bool
-	dbSQL := fmt.Sprintf("SELECT EXISTS (SELECT FROM pg_database WHERE datname = '%s') AS dbexists;", c.AppDatabase)
-	defaultDb.Raw(dbSQL).Row().Scan(&dbexists)
+	defaultDb.Raw("SELECT EXISTS (SELECT FROM pg_database WHERE datname = ?) AS dbexists;", c.AppDatabase).Row().Scan(&dbexists)
 	if !dbexists {
 		logrus.Info(fmt.Sprintf("Created database %s", c.AppDatabase))
 		db := defaultDb.Exec(fmt.Sprintf("CREATE DATABASE %s;", c.AppDatabase))
@@ -49,8 +45,7 @@ func Dial(c *conf.PostgresConfiguration) Repository {
 
 	// check if schema exists and create it if necessary
 	var schemaexists bool
-	schemaSQL := fmt.Sprintf("SELECT EXISTS(SELECT FROM pg_namespace WHERE nspname = '%s') AS schemaexisits;", c.AppDatabaseSchema)
-	appDb.Raw(schemaSQL).Row().Scan(&schemaexists)
+	appDb.Raw("SELECT EXISTS(SELECT FROM pg_namespace WHERE nspname = ?) AS schemaexisits;", c.AppDatabaseSchema).Row().Scan(&schemaexists)
 	// create app specfic database, if not already existing
 	if !schemaexists {
 		// create service specific schema
@@ -61,8 +56,24 @@ func Dial(c *conf.PostgresConfiguration) Repository {
 		logrus.Info(fmt.Sprintf("Created database schema %s", c.AppDatabaseSchema))
 	}
 
-	// migrate table
-	appDb.AutoMigrate(&models.League{}, &models.Franchise{}, &models.Prospect{}, &models.Pick{})
+	return appDb
+}
+
+// Dial opens the app database, applies pending migrations and returns the postgres repository.
+func Dial(c *conf.PostgresConfiguration) Repository {
+	appDb := Open(c)
+
+	sqlDb, err := appDb.DB()
+	if err != nil {
+		logrus.Fatal("Unable to get database connection: ", err)
+	}
+	migrator, err := NewMigrator(sqlDb)
+	if err != nil {
+		logrus.Fatal("Unable to load migrations: ", err)
+	}
+	if err := migrator.Up(context.Background()); err != nil {
+		logrus.Fatal("Unable to migrate database: ", err)
+	}
 
-	return Repository{appDb}
+	return &postgresRepository{db: appDb}
 }
@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+type memoryData struct {
+	leagues     map[uuid.UUID]models.League
+	franchises  map[uuid.UUID]models.Franchise
+	prospects   map[uuid.UUID]models.Prospect
+	picks       map[uuid.UUID]models.Pick
+	histories   map[uuid.UUID]models.FranchiseHistory
+	members     map[uuid.UUID]models.FranchiseMember
+	invitations map[uuid.UUID]models.Invitation
+	seasons     map[uuid.UUID]models.Season
+	conditions  map[uuid.UUID]models.PickCondition
+	swaps       map[uuid.UUID]models.PickSwap
+	tradeBlock  map[uuid.UUID]models.TradeBlockEntry
+	watchlists  map[uuid.UUID]models.WatchlistEntry
+	stats       map[uuid.UUID]models.ProspectSeasonStats
+	statuses    map[uuid.UUID]models.ProspectStatusChange
+	rankings    map[uuid.UUID]models.ProspectRanking
+	standings   map[uuid.UUID]models.Standing
+}
+
+// memoryRepository keeps all records in maps. Records are stored without
+// their associations and copied on the way in and out, the finders attach
+// associations like the preloads of the postgres repository do.
+type memoryRepository struct {
+	mu   *sync.Mutex
+	data *memoryData
+}
+
+// NewMemoryRepository returns an empty repository that lives in memory, it is meant for tests.
+func NewMemoryRepository() Repository {
+	return &memoryRepository{
+		mu: &sync.Mutex{},
+		data: &memoryData{
+			leagues:     map[uuid.UUID]models.League{},
+			franchises:  map[uuid.UUID]models.Franchise{},
+			prospects:   map[uuid.UUID]models.Prospect{},
+			picks:       map[uuid.UUID]models.Pick{},
+			histories:   map[uuid.UUID]models.FranchiseHistory{},
+			members:     map[uuid.UUID]models.FranchiseMember{},
+			invitations: map[uuid.UUID]models.Invitation{},
+			seasons:     map[uuid.UUID]models.Season{},
+			conditions:  map[uuid.UUID]models.PickCondition{},
+			swaps:       map[uuid.UUID]models.PickSwap{},
+			tradeBlock:  map[uuid.UUID]models.TradeBlockEntry{},
+			watchlists:  map[uuid.UUID]models.WatchlistEntry{},
+			stats:       map[uuid.UUID]models.ProspectSeasonStats{},
+			statuses:    map[uuid.UUID]models.ProspectStatusChange{},
+			rankings:    map[uuid.UUID]models.ProspectRanking{},
+			standings:   map[uuid.UUID]models.Standing{},
+		},
+	}
+}
+
+// Transaction runs fn on a copy of the data, which replaces the data if fn succeeds.
+// The repository stays locked meanwhile, so transactions are serialized.
+func (r *memoryRepository) Transaction(ctx context.Context, fn func(tx Repository) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tx := &memoryRepository{mu: &sync.Mutex{}, data: r.data.clone()}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	*r.data = *tx.data
+	return nil
+}
+
+func (d *memoryData) clone() *memoryData {
+	return &memoryData{
+		leagues:     cloneMap(d.leagues),
+		franchises:  cloneMap(d.franchises),
+		prospects:   cloneMap(d.prospects),
+		picks:       cloneMap(d.picks),
+		histories:   cloneMap(d.histories),
+		members:     cloneMap(d.members),
+		invitations: cloneMap(d.invitations),
+		seasons:     cloneMap(d.seasons),
+		conditions:  cloneMap(d.conditions),
+		swaps:       cloneMap(d.swaps),
+		tradeBlock:  cloneMap(d.tradeBlock),
+		watchlists:  cloneMap(d.watchlists),
+		stats:       cloneMap(d.stats),
+		statuses:    cloneMap(d.statuses),
+		rankings:    cloneMap(d.rankings),
+		standings:   cloneMap(d.standings),
+	}
+}
+
+func cloneMap[K comparable, V any](m map[K]V) map[K]V {
+	c := make(map[K]V, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+// clonePtr copies the value behind p, so stored records never share memory with callers.
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+// values returns the records of m in creation order.
+func values[V any](m map[uuid.UUID]V, created func(V) (string, string)) []V {
+	vs := make([]V, 0, len(m))
+	for _, v := range m {
+		vs = append(vs, v)
+	}
+	sort.Slice(vs, func(i, j int) bool {
+		ti, idi := created(vs[i])
+		tj, idj := created(vs[j])
+		if ti != tj {
+			return ti < tj
+		}
+		return strings.Compare(idi, idj) < 0
+	})
+	return vs
+}
+
+// trigrams returns the trigram set of s the way pg_trgm builds it.
+func trigrams(s string) map[string]bool {
+	set := map[string]bool{}
+	for _, word := range searchTerms(s) {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			set[string(padded[i:i+3])] = true
+		}
+	}
+	return set
+}
+
+// Similarity mirrors pg_trgm's similarity function.
+func Similarity(a, b string) float64 {
+	ta, tb := trigrams(a), trigrams(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+	shared := 0
+	for t := range ta {
+		if tb[t] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(ta)+len(tb)-shared)
+}
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func copyCondition(c models.PickCondition) models.PickCondition {
+	c.SubstitutePickID = clonePtr(c.SubstitutePickID)
+	c.ResolvedAt = clonePtr(c.ResolvedAt)
+	return c
+}
+
+func createdCondition(c models.PickCondition) (string, string) {
+	return formatCursorTime(c.CreatedAt), c.ID.String()
+}
+
+func (r *memoryRepository) CreatePickCondition(ctx context.Context, condition *models.PickCondition) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	condition.BeforeCreate(nil)
+	r.data.conditions[condition.ID] = copyCondition(*condition)
+	return nil
+}
+
+func (r *memoryRepository) SavePickCondition(ctx context.Context, condition *models.PickCondition) error {
+	if condition.ID == uuid.Nil {
+		return r.CreatePickCondition(ctx, condition)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	condition.BeforeUpdate(nil)
+	r.data.conditions[condition.ID] = copyCondition(*condition)
+	return nil
+}
+
+func (r *memoryRepository) ListPickConditions(ctx context.Context, filter ConditionFilter) ([]models.PickCondition, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	picks := map[uuid.UUID]bool{}
+	for _, id := range filter.PickIDs {
+		picks[id] = true
+	}
+	conditions := []models.PickCondition{}
+	for _, c := range values(r.data.conditions, createdCondition) {
+		if filter.LeagueID != nil && c.LeagueID != *filter.LeagueID {
+			continue
+		}
+		if filter.PickIDs != nil && !picks[c.PickID] && (c.SubstitutePickID == nil || !picks[*c.SubstitutePickID]) {
+			continue
+		}
+		if filter.Status != "" && c.Status != filter.Status {
+			continue
+		}
+		conditions = append(conditions, copyCondition(c))
+	}
+	return conditions, nil
+}
@@ -0,0 +1,154 @@
+package storage
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"gorm.io/gorm"
+)
+
+func storedFranchise(f models.Franchise) models.Franchise {
+	f.Prospects = nil
+	f.Picks = nil
+	return f
+}
+
+func createdFranchise(f models.Franchise) (string, string) {
+	return formatCursorTime(f.CreatedAt), f.ID.String()
+}
+
+func (r *memoryRepository) CreateFranchise(ctx context.Context, franchise *models.Franchise) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	franchise.BeforeCreate(nil)
+	r.data.franchises[franchise.ID] = storedFranchise(*franchise)
+	return nil
+}
+
+func (r *memoryRepository) FindFranchise(ctx context.Context, id uuid.UUID) (*models.Franchise, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	franchise, ok := r.data.franchises[id]
+	if !ok || franchise.DeletedAt.Valid {
+		return nil, ErrNotFound
+	}
+	franchise.Prospects = r.data.franchiseProspects(id)
+	return &franchise, nil
+}
+
+func (r *memoryRepository) FindFranchiseByName(ctx context.Context, leagueID uuid.UUID, name string) (*models.Franchise, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, franchise := range r.data.leagueFranchises(leagueID) {
+		if franchise.Name == name {
+			return &franchise, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r *memoryRepository) CountFranchises(ctx context.Context, leagueID uuid.UUID) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var n int64
+	for _, franchise := range r.data.leagueFranchises(leagueID) {
+		if !franchise.Orphan {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r *memoryRepository) ListFranchises(ctx context.Context, leagueID uuid.UUID, page Page) ([]models.Franchise, string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	franchises, next, err := pageSlice(franchiseOrdering, page, r.data.leagueFranchises(leagueID), franchiseKey)
+	if err != nil {
+		return nil, "", err
+	}
+	for i := range franchises {
+		franchises[i].Prospects = r.data.franchiseProspects(franchises[i].ID)
+	}
+	return franchises, next, nil
+}
+
+func (r *memoryRepository) SaveFranchise(ctx context.Context, franchise *models.Franchise) error {
+	if franchise.ID == uuid.Nil {
+		return r.CreateFranchise(ctx, franchise)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	franchise.BeforeUpdate(nil)
+	r.data.franchises[franchise.ID] = storedFranchise(*franchise)
+	return nil
+}
+
+func (r *memoryRepository) DeleteFranchise(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	franchise, ok := r.data.franchises[id]
+	if !ok || franchise.DeletedAt.Valid {
+		return nil
+	}
+	franchise.DeletedAt = gorm.DeletedAt{Time: time.Now().Local(), Valid: true}
+	r.data.franchises[id] = franchise
+	return nil
+}
+
+func (r *memoryRepository) FindOrphanFranchise(ctx context.Context, leagueID uuid.UUID) (*models.Franchise, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, franchise := range r.data.leagueFranchises(leagueID) {
+		if franchise.Orphan {
+			return &franchise, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r *memoryRepository) AddFranchiseHistory(ctx context.Context, history *models.FranchiseHistory) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	history.BeforeCreate(nil)
+	r.data.histories[history.ID] = *history
+	return nil
+}
+
+func (r *memoryRepository) ListFranchiseHistory(ctx context.Context, franchiseID uuid.UUID) ([]models.FranchiseHistory, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	history := []models.FranchiseHistory{}
+	for _, h := range values(r.data.histories, createdHistory) {
+		if h.FranchiseID == franchiseID {
+			history = append(history, h)
+		}
+	}
+	return history, nil
+}
+
+func createdHistory(h models.FranchiseHistory) (string, string) {
+	return formatCursorTime(h.CreatedAt), h.ID.String()
+}
+
+func (d *memoryData) franchiseProspects(franchiseID uuid.UUID) []models.Prospect {
+	prospects := []models.Prospect{}
+	for _, p := range values(d.prospects, createdProspect) {
+		if p.FranchiseID != nil && *p.FranchiseID == franchiseID {
+			prospects = append(prospects, p)
+		}
+	}
+	sort.SliceStable(prospects, func(i, j int) bool {
+		a, b := prospects[i], prospects[j]
+		if a.LastName != b.LastName {
+			return a.LastName < b.LastName
+		}
+		if a.FirstName != b.FirstName {
+			return a.FirstName < b.FirstName
+		}
+		return a.ID.String() < b.ID.String()
+	})
+	return prospects
+}
@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func (r *memoryRepository) CreateInvitation(ctx context.Context, invitation *models.Invitation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	invitation.BeforeCreate(nil)
+	r.data.invitations[invitation.ID] = *invitation
+	return nil
+}
+
+func (r *memoryRepository) SaveInvitation(ctx context.Context, invitation *models.Invitation) error {
+	if invitation.ID == uuid.Nil {
+		return r.CreateInvitation(ctx, invitation)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	invitation.BeforeUpdate(nil)
+	r.data.invitations[invitation.ID] = *invitation
+	return nil
+}
+
+func (r *memoryRepository) FindInvitation(ctx context.Context, id uuid.UUID) (*models.Invitation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	invitation, ok := r.data.invitations[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &invitation, nil
+}
+
+func (r *memoryRepository) CountOpenInvitations(ctx context.Context, leagueID uuid.UUID, now time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var n int64
+	for _, invitation := range r.data.invitations {
+		if invitation.LeagueID == leagueID && invitation.Open(now) {
+			n++
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,175 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+// column defaults of leagues
+const (
+	defaultDraftRounds     = 2
+	defaultFuturePickYears = 3
+)
+
+func storedLeague(l models.League) models.League {
+	l.Franchises = nil
+	l.Prospects = nil
+	l.StatusRules = cloneMap(l.StatusRules)
+	l.Eligibility.DraftYears = clonePtr(l.Eligibility.DraftYears)
+	l.Eligibility.Positions = append([]models.Position(nil), l.Eligibility.Positions...)
+	return l
+}
+
+func createdLeague(l models.League) (string, string) {
+	return formatCursorTime(l.CreatedAt), l.ID.String()
+}
+
+func (r *memoryRepository) CreateLeague(ctx context.Context, league *models.League) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	league.BeforeCreate(nil)
+	// zero values get the column default like they do in postgres
+	if league.DraftRounds == 0 {
+		league.DraftRounds = defaultDraftRounds
+	}
+	if league.FuturePickYears == 0 {
+		league.FuturePickYears = defaultFuturePickYears
+	}
+	r.data.leagues[league.ID] = storedLeague(*league)
+	return nil
+}
+
+func (r *memoryRepository) SaveLeague(ctx context.Context, league *models.League) error {
+	if league.ID == uuid.Nil {
+		return r.CreateLeague(ctx, league)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	league.BeforeUpdate(nil)
+	r.data.leagues[league.ID] = storedLeague(*league)
+	return nil
+}
+
+func (r *memoryRepository) FindLeague(ctx context.Context, id uuid.UUID) (*models.League, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	league, ok := r.data.leagues[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	league.Franchises = r.data.leagueFranchises(id)
+	return &league, nil
+}
+
+func (r *memoryRepository) FindLeagueByName(ctx context.Context, name string) (*models.League, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, league := range values(r.data.leagues, createdLeague) {
+		if league.Name == name && league.ArchivedAt == nil {
+			return &league, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r *memoryRepository) ListLeagues(ctx context.Context, page Page) ([]models.League, string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	leagues, next, err := pageSlice(leagueOrdering, page, values(r.data.leagues, createdLeague), leagueKey)
+	if err != nil {
+		return nil, "", err
+	}
+	for i := range leagues {
+		leagues[i].Franchises = r.data.leagueFranchises(leagues[i].ID)
+	}
+	return leagues, next, nil
+}
+
+func (r *memoryRepository) DeleteLeague(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	franchises := map[uuid.UUID]bool{}
+	for fId, f := range r.data.franchises {
+		if f.LeagueID == id {
+			franchises[fId] = true
+			delete(r.data.franchises, fId)
+		}
+	}
+	inLeague := func(id *uuid.UUID) bool {
+		return id != nil && franchises[*id]
+	}
+
+	for pId, p := range r.data.picks {
+		if inLeague(p.OwnerID) {
+			delete(r.data.picks, pId)
+		}
+	}
+	for pId, p := range r.data.prospects {
+		if (p.LeagueID != nil && *p.LeagueID == id) || inLeague(p.FranchiseID) {
+			p.LeagueID = nil
+			p.FranchiseID = nil
+			p.Protected = false
+			r.data.prospects[pId] = p
+		}
+	}
+	for mId, m := range r.data.members {
+		if franchises[m.FranchiseID] {
+			delete(r.data.members, mId)
+		}
+	}
+	for hId, h := range r.data.histories {
+		if franchises[h.FranchiseID] {
+			delete(r.data.histories, hId)
+		}
+	}
+	for iId, i := range r.data.invitations {
+		if i.LeagueID == id {
+			delete(r.data.invitations, iId)
+		}
+	}
+	for sId, s := range r.data.seasons {
+		if s.LeagueID == id {
+			delete(r.data.seasons, sId)
+		}
+	}
+	for cId, c := range r.data.conditions {
+		if c.LeagueID == id {
+			delete(r.data.conditions, cId)
+		}
+	}
+	for sId, s := range r.data.swaps {
+		if s.LeagueID == id {
+			delete(r.data.swaps, sId)
+		}
+	}
+	for eId, e := range r.data.tradeBlock {
+		if e.LeagueID == id {
+			delete(r.data.tradeBlock, eId)
+		}
+	}
+	for sId, s := range r.data.standings {
+		if s.LeagueID == id {
+			delete(r.data.standings, sId)
+		}
+	}
+	for lId, l := range r.data.leagues {
+		if l.ClonedFromID != nil && *l.ClonedFromID == id {
+			l.ClonedFromID = nil
+			r.data.leagues[lId] = l
+		}
+	}
+	delete(r.data.leagues, id)
+	return nil
+}
+
+func (d *memoryData) leagueFranchises(leagueID uuid.UUID) []models.Franchise {
+	franchises := []models.Franchise{}
+	for _, f := range values(d.franchises, createdFranchise) {
+		if f.LeagueID == leagueID && !f.DeletedAt.Valid {
+			franchises = append(franchises, f)
+		}
+	}
+	return franchises
+}
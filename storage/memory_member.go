@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func createdMember(m models.FranchiseMember) (string, string) {
+	return formatCursorTime(m.CreatedAt), m.ID.String()
+}
+
+func (r *memoryRepository) SaveFranchiseMember(ctx context.Context, member *models.FranchiseMember) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if existing, ok := r.data.member(member.FranchiseID, member.UserID); ok {
+		member.ID = existing.ID
+		member.CreatedAt = existing.CreatedAt
+		member.BeforeUpdate(nil)
+	} else {
+		member.BeforeCreate(nil)
+	}
+	r.data.members[member.ID] = *member
+	return nil
+}
+
+func (r *memoryRepository) RemoveFranchiseMember(ctx context.Context, franchiseID uuid.UUID, userID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if member, ok := r.data.member(franchiseID, userID); ok {
+		delete(r.data.members, member.ID)
+	}
+	return nil
+}
+
+func (r *memoryRepository) FindFranchiseMember(ctx context.Context, franchiseID uuid.UUID, userID uuid.UUID) (*models.FranchiseMember, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	member, ok := r.data.member(franchiseID, userID)
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &member, nil
+}
+
+func (r *memoryRepository) ListFranchiseMembers(ctx context.Context, franchiseID uuid.UUID) ([]models.FranchiseMember, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	members := []models.FranchiseMember{}
+	for _, m := range values(r.data.members, createdMember) {
+		if m.FranchiseID == franchiseID {
+			members = append(members, m)
+		}
+	}
+	return members, nil
+}
+
+func (r *memoryRepository) ListMemberFranchises(ctx context.Context, userID uuid.UUID) ([]models.Franchise, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	franchises := []models.Franchise{}
+	for _, f := range values(r.data.franchises, createdFranchise) {
+		if _, ok := r.data.member(f.ID, userID); ok && !f.DeletedAt.Valid {
+			franchises = append(franchises, f)
+		}
+	}
+	return franchises, nil
+}
+
+func (d *memoryData) member(franchiseID uuid.UUID, userID uuid.UUID) (models.FranchiseMember, bool) {
+	for _, m := range d.members {
+		if m.FranchiseID == franchiseID && m.UserID == userID {
+			return m, true
+		}
+	}
+	return models.FranchiseMember{}, false
+}
@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func copyPick(p models.Pick) models.Pick {
+	p.DraftPickOverall = clonePtr(p.DraftPickOverall)
+	p.DraftPickInRound = clonePtr(p.DraftPickInRound)
+	p.ProspectID = clonePtr(p.ProspectID)
+	p.OwnerID = clonePtr(p.OwnerID)
+	p.LastOwnerID = clonePtr(p.LastOwnerID)
+	p.OriginID = clonePtr(p.OriginID)
+	p.Slot = clonePtr(p.Slot)
+	p.ForfeitedAt = clonePtr(p.ForfeitedAt)
+	return p
+}
+
+func createdPick(p models.Pick) (string, string) {
+	return formatCursorTime(p.CreatedAt), p.ID.String()
+}
+
+func (r *memoryRepository) CreatePick(ctx context.Context, pick *models.Pick) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pick.BeforeCreate(nil)
+	r.data.picks[pick.ID] = copyPick(*pick)
+	return nil
+}
+
+func (r *memoryRepository) SavePick(ctx context.Context, pick *models.Pick) error {
+	if pick.ID == uuid.Nil {
+		return r.CreatePick(ctx, pick)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pick.BeforeUpdate(nil)
+	r.data.picks[pick.ID] = copyPick(*pick)
+	return nil
+}
+
+func (r *memoryRepository) DeletePick(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.data.picks, id)
+	return nil
+}
+
+func (r *memoryRepository) FindPick(ctx context.Context, id uuid.UUID) (*models.Pick, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pick, ok := r.data.picks[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	pick = copyPick(pick)
+	return &pick, nil
+}
+
+func (r *memoryRepository) FindOriginalPicks(ctx context.Context, originID uuid.UUID, year int, round int) ([]models.Pick, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	picks := []models.Pick{}
+	for _, p := range values(r.data.picks, createdPick) {
+		if p.OriginID != nil && *p.OriginID == originID && p.DraftYear == year && p.DraftRound == round && !p.Supplemental {
+			picks = append(picks, copyPick(p))
+		}
+	}
+	return picks, nil
+}
+
+func (r *memoryRepository) ListPicks(ctx context.Context, filter PickFilter, page Page) ([]models.Pick, string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	picks := []models.Pick{}
+	for _, p := range values(r.data.picks, createdPick) {
+		if filter.OwnerID != nil && (p.OwnerID == nil || *p.OwnerID != *filter.OwnerID) {
+			continue
+		}
+		if filter.OriginID != nil && (p.OriginID == nil || *p.OriginID != *filter.OriginID) {
+			continue
+		}
+		if filter.DraftYear != 0 && p.DraftYear != filter.DraftYear {
+			continue
+		}
+		if filter.Drafted != nil && (p.ProspectID != nil) != *filter.Drafted {
+			continue
+		}
+		picks = append(picks, copyPick(p))
+	}
+	return pageSlice(pickOrdering, page, picks, pickKey)
+}
+
+func (r *memoryRepository) TransferPicks(ctx context.Context, from *models.Franchise, to *models.Franchise) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, p := range values(r.data.picks, createdPick) {
+		if p.OwnerID == nil || *p.OwnerID != from.ID {
+			continue
+		}
+		p = copyPick(p)
+		p.LastOwnerID = clonePtr(&from.ID)
+		p.LastOwnerName = from.Name
+		p.OwnerID = clonePtr(&to.ID)
+		p.OwnerName = to.Name
+		p.BeforeUpdate(nil)
+		r.data.picks[p.ID] = p
+	}
+	return nil
+}
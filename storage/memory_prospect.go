@@ -0,0 +1,371 @@
+package storage
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+// minSimilarity is pg_trgm's default similarity threshold of the % operator
+const minSimilarity = 0.3
+
+// copyProspect copies a prospect without its pick
+func copyProspect(p models.Prospect) models.Prospect {
+	p.LeagueID = clonePtr(p.LeagueID)
+	p.FranchiseID = clonePtr(p.FranchiseID)
+	p.Birthdate = clonePtr(p.Birthdate)
+	p.StatusSince = clonePtr(p.StatusSince)
+	p.Pick = nil
+	return p
+}
+
+// sameDate reports whether both dates are unset or the same
+func sameDate(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func createdProspect(p models.Prospect) (string, string) {
+	return formatCursorTime(p.CreatedAt), p.ID.String()
+}
+
+func (r *memoryRepository) CreateProspects(ctx context.Context, prospects []models.Prospect) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range prospects {
+		prospects[i].BeforeCreate(nil)
+		r.data.prospects[prospects[i].ID] = copyProspect(prospects[i])
+	}
+	return nil
+}
+
+func (r *memoryRepository) SaveProspect(ctx context.Context, prospect *models.Prospect) error {
+	if prospect.ID == uuid.Nil {
+		return r.CreateProspects(ctx, []models.Prospect{*prospect})
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	prospect.BeforeUpdate(nil)
+	r.data.prospects[prospect.ID] = copyProspect(*prospect)
+	return nil
+}
+
+func (r *memoryRepository) FindProspect(ctx context.Context, id uuid.UUID) (*models.Prospect, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	prospect, ok := r.data.prospects[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	prospect = r.data.withPick(prospect)
+	return &prospect, nil
+}
+
+func (r *memoryRepository) FindProspectByIdentity(ctx context.Context, identity ProspectIdentity) (*models.Prospect, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, p := range values(r.data.prospects, createdProspect) {
+		if p.FullName == identity.FullName && sameDate(p.Birthdate, identity.Birthdate) && p.NhlDraftYear == identity.NhlDraftYear && p.NhlDraftPickOverall == identity.NhlDraftPickOverall {
+			p = copyProspect(p)
+			return &p, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r *memoryRepository) ListProspects(ctx context.Context, filter ProspectFilter, page Page) ([]models.Prospect, string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	prospects, next, err := pageSlice(prospectOrdering, page, r.data.filterProspects(filter), prospectKey)
+	if err != nil {
+		return nil, "", err
+	}
+	for i := range prospects {
+		prospects[i] = r.data.withPick(prospects[i])
+	}
+	return prospects, next, nil
+}
+
+func (r *memoryRepository) SearchProspects(ctx context.Context, search ProspectSearch) ([]models.Prospect, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	type match struct {
+		prospect models.Prospect
+		rank     float64
+	}
+	var matches []match
+	terms := searchTerms(search.Text)
+	for _, p := range r.data.filterProspects(search.Filter) {
+		if search.AvailableInLeague != nil && p.LeagueID != nil && *p.LeagueID == *search.AvailableInLeague {
+			continue
+		}
+		rank := Similarity(p.FullName, search.Text)
+		prefix := len(terms) > 0 && prefixMatches(searchTerms(strings.Join([]string{p.FullName, p.NhlTeam, string(p.PositionCode)}, " ")), terms)
+		if !prefix && rank < minSimilarity {
+			continue
+		}
+		if prefix {
+			rank += 1
+		}
+		matches = append(matches, match{prospect: p, rank: rank})
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].rank != matches[j].rank {
+			return matches[i].rank > matches[j].rank
+		}
+		return strings.Compare(matches[i].prospect.ID.String(), matches[j].prospect.ID.String()) < 0
+	})
+
+	prospects := []models.Prospect{}
+	for _, m := range matches {
+		if len(prospects) == (Page{Size: search.Limit}).Limit() {
+			break
+		}
+		prospects = append(prospects, r.data.withPick(m.prospect))
+	}
+	return prospects, nil
+}
+
+// prefixMatches reports whether every term is the prefix of a word
+func prefixMatches(words []string, terms []string) bool {
+	for _, t := range terms {
+		found := false
+		for _, w := range words {
+			if strings.HasPrefix(w, t) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *memoryData) filterProspects(f ProspectFilter) []models.Prospect {
+	prospects := []models.Prospect{}
+	for _, p := range values(d.prospects, createdProspect) {
+		if f.FranchiseID != nil && (p.FranchiseID == nil || *p.FranchiseID != *f.FranchiseID) {
+			continue
+		}
+		if f.PositionCode != "" && p.PositionCode != f.PositionCode {
+			continue
+		}
+		if f.NhlTeam != "" && p.NhlTeam != f.NhlTeam {
+			continue
+		}
+		if f.NhlDraftYear != 0 && p.NhlDraftYear != f.NhlDraftYear {
+			continue
+		}
+		pick := d.prospectPick(p.ID)
+		if f.DraftYear != 0 && (pick == nil || pick.DraftYear != f.DraftYear) {
+			continue
+		}
+		if f.Drafted != nil && (pick != nil) != *f.Drafted {
+			continue
+		}
+		if f.Protected != nil && p.Protected != *f.Protected {
+			continue
+		}
+		if r := f.NhlDraftYears; r != nil && (p.NhlDraftYear == 0 && !r.Undrafted || p.NhlDraftYear != 0 && (p.NhlDraftYear < r.From || p.NhlDraftYear > r.To)) {
+			continue
+		}
+		if len(f.Positions) > 0 && !containsPosition(f.Positions, p.PositionCode) {
+			continue
+		}
+		if f.BornAfter != nil && p.Birthdate != nil {
+			y, m, d := p.Birthdate.Date()
+			if !time.Date(y, m, d, 0, 0, 0, 0, time.UTC).After(*f.BornAfter) {
+				continue
+			}
+		}
+		prospects = append(prospects, copyProspect(p))
+	}
+	return prospects
+}
+
+func containsPosition(positions []models.Position, position models.Position) bool {
+	for _, p := range positions {
+		if p == position {
+			return true
+		}
+	}
+	return false
+}
+
+// withPick returns a copy of the prospect with the pick it was drafted with
+func (d *memoryData) withPick(p models.Prospect) models.Prospect {
+	p = copyProspect(p)
+	p.Pick = d.prospectPick(p.ID)
+	return p
+}
+
+func (d *memoryData) prospectPick(prospectID uuid.UUID) *models.Pick {
+	for _, pick := range values(d.picks, createdPick) {
+		if pick.ProspectID != nil && *pick.ProspectID == prospectID {
+			pick = copyPick(pick)
+			return &pick
+		}
+	}
+	return nil
+}
+
+func (r *memoryRepository) ReleaseProspects(ctx context.Context, franchiseID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, p := range r.data.franchiseProspects(franchiseID) {
+		if pick := r.data.prospectPick(p.ID); pick != nil {
+			pick.ProspectID = nil
+			pick.BeforeUpdate(nil)
+			r.data.picks[pick.ID] = *pick
+		}
+		p.LeagueID = nil
+		p.FranchiseID = nil
+		p.Protected = false
+		p.BeforeUpdate(nil)
+		r.data.prospects[p.ID] = copyProspect(p)
+	}
+	return nil
+}
+
+func (r *memoryRepository) ReassignProspect(ctx context.Context, fromID uuid.UUID, toID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, pick := range r.data.picks {
+		if pick.ProspectID != nil && *pick.ProspectID == fromID {
+			pick.ProspectID = &toID
+			pick.BeforeUpdate(nil)
+			r.data.picks[id] = pick
+		}
+	}
+
+	for id, s := range r.data.stats {
+		if s.ProspectID != fromID {
+			continue
+		}
+		duplicate := false
+		for _, other := range r.data.stats {
+			duplicate = duplicate || other.ProspectID == toID && other.Season == s.Season && strings.EqualFold(other.Level, s.Level) && strings.EqualFold(other.Team, s.Team)
+		}
+		if duplicate {
+			delete(r.data.stats, id)
+			continue
+		}
+		s.ProspectID = toID
+		r.data.stats[id] = s
+	}
+
+	for id, e := range r.data.watchlists {
+		if e.ProspectID != fromID {
+			continue
+		}
+		duplicate := false
+		for _, other := range r.data.watchlists {
+			duplicate = duplicate || other.ProspectID == toID && other.UserID == e.UserID
+		}
+		if duplicate {
+			delete(r.data.watchlists, id)
+			continue
+		}
+		e.ProspectID = toID
+		r.data.watchlists[id] = e
+	}
+
+	for id, e := range r.data.tradeBlock {
+		if e.ProspectID == nil || *e.ProspectID != fromID {
+			continue
+		}
+		duplicate := false
+		for _, other := range r.data.tradeBlock {
+			duplicate = duplicate || other.ProspectID != nil && *other.ProspectID == toID
+		}
+		if duplicate {
+			delete(r.data.tradeBlock, id)
+			continue
+		}
+		e.ProspectID = &toID
+		r.data.tradeBlock[id] = e
+	}
+
+	for id, ranking := range r.data.rankings {
+		if ranking.ProspectID != fromID {
+			continue
+		}
+		duplicate := false
+		for _, other := range r.data.rankings {
+			duplicate = duplicate || other.ProspectID == toID && other.Year == ranking.Year && other.Source == ranking.Source && sameID(other.FranchiseID, ranking.FranchiseID)
+		}
+		if duplicate {
+			delete(r.data.rankings, id)
+			continue
+		}
+		ranking.ProspectID = toID
+		r.data.rankings[id] = ranking
+	}
+
+	for id, c := range r.data.statuses {
+		if c.ProspectID != fromID {
+			continue
+		}
+		duplicate := false
+		for _, other := range r.data.statuses {
+			duplicate = duplicate || other.ProspectID == toID && other.Status == c.Status && other.EffectiveDate.Equal(c.EffectiveDate)
+		}
+		if duplicate {
+			delete(r.data.statuses, id)
+			continue
+		}
+		c.ProspectID = toID
+		r.data.statuses[id] = c
+	}
+	return nil
+}
+
+func (r *memoryRepository) DeleteProspect(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.data.prospects[id]; !ok {
+		return ErrNotFound
+	}
+	for pId, pick := range r.data.picks {
+		if pick.ProspectID != nil && *pick.ProspectID == id {
+			pick.ProspectID = nil
+			r.data.picks[pId] = pick
+		}
+	}
+	for sId, s := range r.data.stats {
+		if s.ProspectID == id {
+			delete(r.data.stats, sId)
+		}
+	}
+	for eId, e := range r.data.watchlists {
+		if e.ProspectID == id {
+			delete(r.data.watchlists, eId)
+		}
+	}
+	for eId, e := range r.data.tradeBlock {
+		if e.ProspectID != nil && *e.ProspectID == id {
+			delete(r.data.tradeBlock, eId)
+		}
+	}
+	for cId, c := range r.data.statuses {
+		if c.ProspectID == id {
+			delete(r.data.statuses, cId)
+		}
+	}
+	for rId, ranking := range r.data.rankings {
+		if ranking.ProspectID == id {
+			delete(r.data.rankings, rId)
+		}
+	}
+	delete(r.data.prospects, id)
+	return nil
+}
@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func copyRanking(r models.ProspectRanking) models.ProspectRanking {
+	r.FranchiseID = clonePtr(r.FranchiseID)
+	return r
+}
+
+// sameID reports whether both ids are unset or the same
+func sameID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func createdRanking(r models.ProspectRanking) (string, string) {
+	return formatCursorTime(r.CreatedAt), r.ID.String()
+}
+
+func (r *memoryRepository) ReplaceRanking(ctx context.Context, year int, source string, franchiseID *uuid.UUID, rankings []models.ProspectRanking) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, ranking := range r.data.rankings {
+		if ranking.Year == year && ranking.Source == source && sameID(ranking.FranchiseID, franchiseID) {
+			delete(r.data.rankings, id)
+		}
+	}
+	for i := range rankings {
+		rankings[i].Year, rankings[i].Source, rankings[i].FranchiseID = year, source, clonePtr(franchiseID)
+		rankings[i].BeforeCreate(nil)
+		r.data.rankings[rankings[i].ID] = copyRanking(rankings[i])
+	}
+	return nil
+}
+
+func (r *memoryRepository) ListRankings(ctx context.Context, filter RankingFilter) ([]models.ProspectRanking, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rankings := []models.ProspectRanking{}
+	for _, ranking := range values(r.data.rankings, createdRanking) {
+		if filter.Year != 0 && ranking.Year != filter.Year {
+			continue
+		}
+		if filter.Source != "" && ranking.Source != filter.Source {
+			continue
+		}
+		if filter.FranchiseID != nil && !sameID(ranking.FranchiseID, filter.FranchiseID) {
+			continue
+		}
+		rankings = append(rankings, copyRanking(ranking))
+	}
+	sort.SliceStable(rankings, func(i, j int) bool {
+		a, b := rankings[i], rankings[j]
+		if a.Source != b.Source {
+			return a.Source < b.Source
+		}
+		if !sameID(a.FranchiseID, b.FranchiseID) {
+			return a.FranchiseID == nil || b.FranchiseID != nil && a.FranchiseID.String() < b.FranchiseID.String()
+		}
+		return a.Rank < b.Rank
+	})
+	return rankings, nil
+}
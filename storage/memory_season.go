@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func createdSeason(s models.Season) (string, string) {
+	return formatCursorTime(s.CreatedAt), s.ID.String()
+}
+
+func (r *memoryRepository) CreateSeason(ctx context.Context, season *models.Season) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	season.BeforeCreate(nil)
+	r.data.seasons[season.ID] = *season
+	return nil
+}
+
+func (r *memoryRepository) SaveSeason(ctx context.Context, season *models.Season) error {
+	if season.ID == uuid.Nil {
+		return r.CreateSeason(ctx, season)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	season.BeforeUpdate(nil)
+	r.data.seasons[season.ID] = *season
+	return nil
+}
+
+func (r *memoryRepository) FindActiveSeason(ctx context.Context, leagueID uuid.UUID) (*models.Season, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.data.seasons {
+		if s.LeagueID == leagueID && s.Status == models.SeasonActive {
+			return &s, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r *memoryRepository) ListSeasons(ctx context.Context, leagueID uuid.UUID) ([]models.Season, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	seasons := []models.Season{}
+	for _, s := range values(r.data.seasons, createdSeason) {
+		if s.LeagueID == leagueID {
+			seasons = append(seasons, s)
+		}
+	}
+	sort.SliceStable(seasons, func(i, j int) bool { return seasons[i].Year < seasons[j].Year })
+	return seasons, nil
+}
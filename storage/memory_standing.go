@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func createdStanding(s models.Standing) (string, string) {
+	return formatCursorTime(s.CreatedAt), s.ID.String()
+}
+
+func (r *memoryRepository) ReplaceStandings(ctx context.Context, leagueID uuid.UUID, year int, standings []models.Standing) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, s := range r.data.standings {
+		if s.LeagueID == leagueID && s.Year == year {
+			delete(r.data.standings, id)
+		}
+	}
+	for i := range standings {
+		standings[i].LeagueID, standings[i].Year = leagueID, year
+		standings[i].BeforeCreate(nil)
+		r.data.standings[standings[i].ID] = standings[i]
+	}
+	return nil
+}
+
+func (r *memoryRepository) ListStandings(ctx context.Context, leagueID uuid.UUID, year int) ([]models.Standing, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	standings := []models.Standing{}
+	for _, s := range values(r.data.standings, createdStanding) {
+		if s.LeagueID == leagueID && s.Year == year {
+			standings = append(standings, s)
+		}
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		return standings[i].Rank < standings[j].Rank
+	})
+	return standings, nil
+}
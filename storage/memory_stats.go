@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func copyStats(s models.ProspectSeasonStats) models.ProspectSeasonStats {
+	s.SavePercentage = clonePtr(s.SavePercentage)
+	s.GoalsAgainstAverage = clonePtr(s.GoalsAgainstAverage)
+	return s
+}
+
+func createdStats(s models.ProspectSeasonStats) (string, string) {
+	return formatCursorTime(s.CreatedAt), s.ID.String()
+}
+
+func (r *memoryRepository) CreateProspectStats(ctx context.Context, stats *models.ProspectSeasonStats) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats.BeforeCreate(nil)
+	r.data.stats[stats.ID] = copyStats(*stats)
+	return nil
+}
+
+func (r *memoryRepository) SaveProspectStats(ctx context.Context, stats *models.ProspectSeasonStats) error {
+	if stats.ID == uuid.Nil {
+		return r.CreateProspectStats(ctx, stats)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats.BeforeUpdate(nil)
+	r.data.stats[stats.ID] = copyStats(*stats)
+	return nil
+}
+
+func (r *memoryRepository) ListProspectStats(ctx context.Context, filter StatsFilter) ([]models.ProspectSeasonStats, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	prospects := map[uuid.UUID]bool{}
+	for _, id := range filter.ProspectIDs {
+		prospects[id] = true
+	}
+	stats := []models.ProspectSeasonStats{}
+	for _, s := range values(r.data.stats, createdStats) {
+		if filter.ProspectIDs != nil && !prospects[s.ProspectID] {
+			continue
+		}
+		if filter.Season != 0 && s.Season != filter.Season {
+			continue
+		}
+		stats = append(stats, copyStats(s))
+	}
+	sort.SliceStable(stats, func(i, j int) bool {
+		a, b := stats[i], stats[j]
+		if a.Season != b.Season {
+			return a.Season < b.Season
+		}
+		if c := strings.Compare(a.Level, b.Level); c != 0 {
+			return c < 0
+		}
+		return a.Team < b.Team
+	})
+	return stats, nil
+}
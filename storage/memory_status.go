@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func createdStatus(c models.ProspectStatusChange) (string, string) {
+	return formatCursorTime(c.CreatedAt), c.ID.String()
+}
+
+func (r *memoryRepository) AddProspectStatus(ctx context.Context, change *models.ProspectStatusChange) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	change.BeforeCreate(nil)
+	r.data.statuses[change.ID] = *change
+	return nil
+}
+
+func (r *memoryRepository) ListProspectStatuses(ctx context.Context, prospectID uuid.UUID) ([]models.ProspectStatusChange, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	changes := []models.ProspectStatusChange{}
+	for _, c := range values(r.data.statuses, createdStatus) {
+		if c.ProspectID == prospectID {
+			changes = append(changes, c)
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].EffectiveDate.Before(changes[j].EffectiveDate)
+	})
+	return changes, nil
+}
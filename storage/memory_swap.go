@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func copySwap(s models.PickSwap) models.PickSwap {
+	s.ResolvedAt = clonePtr(s.ResolvedAt)
+	return s
+}
+
+func createdSwap(s models.PickSwap) (string, string) {
+	return formatCursorTime(s.CreatedAt), s.ID.String()
+}
+
+func (r *memoryRepository) CreatePickSwap(ctx context.Context, swap *models.PickSwap) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	swap.BeforeCreate(nil)
+	r.data.swaps[swap.ID] = copySwap(*swap)
+	return nil
+}
+
+func (r *memoryRepository) SavePickSwap(ctx context.Context, swap *models.PickSwap) error {
+	if swap.ID == uuid.Nil {
+		return r.CreatePickSwap(ctx, swap)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	swap.BeforeUpdate(nil)
+	r.data.swaps[swap.ID] = copySwap(*swap)
+	return nil
+}
+
+func (r *memoryRepository) ListPickSwaps(ctx context.Context, filter SwapFilter) ([]models.PickSwap, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	picks := map[uuid.UUID]bool{}
+	for _, id := range filter.PickIDs {
+		picks[id] = true
+	}
+	swaps := []models.PickSwap{}
+	for _, s := range values(r.data.swaps, createdSwap) {
+		if filter.LeagueID != nil && s.LeagueID != *filter.LeagueID {
+			continue
+		}
+		if filter.PickIDs != nil && !picks[s.HolderPickID] && !picks[s.GrantorPickID] {
+			continue
+		}
+		if filter.Status != "" && s.Status != filter.Status {
+			continue
+		}
+		swaps = append(swaps, copySwap(s))
+	}
+	return swaps, nil
+}
@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func copyEntry(e models.TradeBlockEntry) models.TradeBlockEntry {
+	e.ProspectID = clonePtr(e.ProspectID)
+	e.PickID = clonePtr(e.PickID)
+	return e
+}
+
+func createdEntry(e models.TradeBlockEntry) (string, string) {
+	return formatCursorTime(e.CreatedAt), e.ID.String()
+}
+
+func (f TradeBlockFilter) matches(e models.TradeBlockEntry) bool {
+	if f.LeagueID != nil && e.LeagueID != *f.LeagueID {
+		return false
+	}
+	if f.FranchiseID != nil && e.FranchiseID != *f.FranchiseID {
+		return false
+	}
+	return f.AssetID == nil || e.AssetID() == *f.AssetID
+}
+
+func (r *memoryRepository) SaveTradeBlockEntry(ctx context.Context, entry *models.TradeBlockEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	assetID := entry.AssetID()
+	for _, e := range r.data.tradeBlock {
+		if e.AssetID() == assetID {
+			entry.ID = e.ID
+			entry.CreatedAt = e.CreatedAt
+			entry.BeforeUpdate(nil)
+			r.data.tradeBlock[entry.ID] = copyEntry(*entry)
+			return nil
+		}
+	}
+	entry.BeforeCreate(nil)
+	r.data.tradeBlock[entry.ID] = copyEntry(*entry)
+	return nil
+}
+
+func (r *memoryRepository) RemoveTradeBlockEntries(ctx context.Context, filter TradeBlockFilter) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, e := range r.data.tradeBlock {
+		if filter.matches(e) {
+			delete(r.data.tradeBlock, id)
+		}
+	}
+	return nil
+}
+
+func (r *memoryRepository) ListTradeBlock(ctx context.Context, filter TradeBlockFilter) ([]models.TradeBlockEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entries := []models.TradeBlockEntry{}
+	for _, e := range values(r.data.tradeBlock, createdEntry) {
+		if filter.matches(e) {
+			entries = append(entries, copyEntry(e))
+		}
+	}
+	return entries, nil
+}
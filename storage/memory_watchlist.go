@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func copyWatchlistEntry(e models.WatchlistEntry) models.WatchlistEntry {
+	e.Tags = append(models.Tags(nil), e.Tags...)
+	e.Rank = clonePtr(e.Rank)
+	return e
+}
+
+func createdWatchlistEntry(e models.WatchlistEntry) (string, string) {
+	return formatCursorTime(e.CreatedAt), e.ID.String()
+}
+
+func (r *memoryRepository) CreateWatchlistEntry(ctx context.Context, entry *models.WatchlistEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry.BeforeCreate(nil)
+	r.data.watchlists[entry.ID] = copyWatchlistEntry(*entry)
+	return nil
+}
+
+func (r *memoryRepository) SaveWatchlistEntry(ctx context.Context, entry *models.WatchlistEntry) error {
+	if entry.ID == uuid.Nil {
+		return r.CreateWatchlistEntry(ctx, entry)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry.BeforeUpdate(nil)
+	r.data.watchlists[entry.ID] = copyWatchlistEntry(*entry)
+	return nil
+}
+
+func (r *memoryRepository) FindWatchlistEntry(ctx context.Context, userID uuid.UUID, prospectID uuid.UUID) (*models.WatchlistEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range r.data.watchlists {
+		if e.UserID == userID && e.ProspectID == prospectID {
+			entry := copyWatchlistEntry(e)
+			return &entry, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r *memoryRepository) DeleteWatchlistEntry(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.data.watchlists, id)
+	return nil
+}
+
+func (r *memoryRepository) ListWatchlist(ctx context.Context, filter WatchlistFilter) ([]models.WatchlistEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	prospects := map[uuid.UUID]bool{}
+	for _, id := range filter.ProspectIDs {
+		prospects[id] = true
+	}
+	entries := []models.WatchlistEntry{}
+	for _, e := range values(r.data.watchlists, createdWatchlistEntry) {
+		if e.UserID != filter.UserID {
+			continue
+		}
+		if filter.ProspectIDs != nil && !prospects[e.ProspectID] {
+			continue
+		}
+		if filter.Tag != "" && !e.Tags.Has(filter.Tag) {
+			continue
+		}
+		entries = append(entries, copyWatchlistEntry(e))
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i].Rank, entries[j].Rank
+		return a != nil && (b == nil || *a < *b)
+	})
+	return entries, nil
+}
@@ -0,0 +1,271 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationLockID is the key of the advisory lock held while migrating.
+const migrationLockID = 4_721_036_118
+
+var migrationName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migration is a numbered schema change with the sql to apply and to revert it.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus tells whether a migration is applied and when.
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// Migrator applies the embedded migrations and records them in the schema_migrations table.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// NewMigrator returns a migrator for the embedded migrations.
+func NewMigrator(db *sql.DB) (*Migrator, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// loadMigrations reads the migrations from migrations/<version>_<name>.(up|down).sql, ordered by version.
+func loadMigrations() ([]Migration, error) {
+	entries, err := migrationFiles.ReadDir("migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, e := range entries {
+		match := migrationName.FindStringSubmatch(e.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %q", e.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+		content, err := migrationFiles.ReadFile(path.Join("migrations", e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has the names %q and %q", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := []Migration{}
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs an up and a down file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			return nil, fmt.Errorf("migration %d_%s is out of sequence, expected version %d", m.Version, m.Name, i+1)
+		}
+	}
+	return migrations, nil
+}
+
+// Latest returns the version of the newest migration.
+func (m *Migrator) Latest() int {
+	return len(m.migrations)
+}
+
+// Up applies all pending migrations.
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.To(ctx, m.Latest())
+}
+
+// Down reverts the last applied migration.
+func (m *Migrator) Down(ctx context.Context) error {
+	return m.migrate(ctx, func(current int) (int, error) {
+		if current == 0 {
+			return 0, fmt.Errorf("no migration to revert")
+		}
+		return current - 1, nil
+	})
+}
+
+// To migrates up or down to the version, 0 reverts all migrations.
+func (m *Migrator) To(ctx context.Context, version int) error {
+	if version < 0 || version > m.Latest() {
+		return fmt.Errorf("unknown migration version %d, latest is %d", version, m.Latest())
+	}
+	return m.migrate(ctx, func(int) (int, error) { return version, nil })
+}
+
+// Status returns all migrations with the time they were applied, nil if pending.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	if err := createVersionTable(ctx, m.db); err != nil {
+		return nil, err
+	}
+	applied, err := appliedMigrations(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	status := []MigrationStatus{}
+	for _, migration := range m.migrations {
+		s := MigrationStatus{Migration: migration}
+		if appliedAt, ok := applied[migration.Version]; ok {
+			s.AppliedAt = &appliedAt
+		}
+		status = append(status, s)
+	}
+	return status, nil
+}
+
+// migrate holds the advisory lock on a single connection, so concurrent
+// migrators wait for each other, and steps from the current version to the
+// target one migration per transaction.
+func (m *Migrator) migrate(ctx context.Context, target func(current int) (int, error)) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+		return fmt.Errorf("unable to acquire migration lock: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockID)
+
+	if err := createVersionTable(ctx, conn); err != nil {
+		return err
+	}
+	current, err := currentVersion(ctx, conn)
+	if err != nil {
+		return err
+	}
+	version, err := target(current)
+	if err != nil {
+		return err
+	}
+
+	migrationSteps, err := steps(m.migrations, current, version)
+	if err != nil {
+		return err
+	}
+	for _, step := range migrationSteps {
+		if err := step.run(ctx, conn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type migrationStep struct {
+	migration Migration
+	up        bool
+}
+
+// steps returns the migrations to apply (or revert) to get from version current to target.
+// A database ahead of the known migrations was migrated by a newer binary and is left alone.
+func steps(migrations []Migration, current int, target int) ([]migrationStep, error) {
+	if current > len(migrations) {
+		return nil, fmt.Errorf("database is at migration version %d, newer than the latest known version %d", current, len(migrations))
+	}
+	result := []migrationStep{}
+	if target > current {
+		for _, m := range migrations[current:target] {
+			result = append(result, migrationStep{migration: m, up: true})
+		}
+	}
+	for i := current; i > target; i-- {
+		result = append(result, migrationStep{migration: migrations[i-1], up: false})
+	}
+	return result, nil
+}
+
+func (s migrationStep) run(ctx context.Context, conn *sql.Conn) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	script, record := s.migration.Down, "DELETE FROM schema_migrations WHERE version = $1"
+	if s.up {
+		script, record = s.migration.Up, "INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, now())"
+	}
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return fmt.Errorf("migration %d_%s failed: %w", s.migration.Version, s.migration.Name, err)
+	}
+	args := []any{s.migration.Version}
+	if s.up {
+		args = append(args, s.migration.Name)
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+type execQuerier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func createVersionTable(ctx context.Context, db execQuerier) error {
+	_, err := db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS schema_migrations (version integer PRIMARY KEY, name text NOT NULL, applied_at timestamptz NOT NULL)")
+	return err
+}
+
+func currentVersion(ctx context.Context, db execQuerier) (int, error) {
+	var version int
+	err := db.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version)
+	return version, err
+}
+
+func appliedMigrations(ctx context.Context, db execQuerier) (map[int]time.Time, error) {
+	rows, err := db.QueryContext(ctx, "SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[int]time.Time{}
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
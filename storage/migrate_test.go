@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatalf("Loading migrations failed: %v", err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("Expected embedded migrations")
+	}
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			t.Errorf("Migration %q has version %d, expected %d", m.Name, m.Version, i+1)
+		}
+		if strings.TrimSpace(m.Up) == "" || strings.TrimSpace(m.Down) == "" {
+			t.Errorf("Migration %q is missing its up or down sql", m.Name)
+		}
+	}
+}
+
+func TestSteps(t *testing.T) {
+	migrations := []Migration{{Version: 1, Name: "a"}, {Version: 2, Name: "b"}, {Version: 3, Name: "c"}}
+
+	var tests = []struct {
+		current, target int
+		expected        string
+	}{
+		{0, 3, "+1 +2 +3"},
+		{1, 2, "+2"},
+		{3, 1, "-3 -2"},
+		{2, 0, "-2 -1"},
+		{2, 2, ""},
+	}
+	for _, test := range tests {
+		var actual []string
+		result, err := steps(migrations, test.current, test.target)
+		if err != nil {
+			t.Errorf("Steps from %d to %d failed: %v", test.current, test.target, err)
+		}
+		for _, s := range result {
+			sign := "-"
+			if s.up {
+				sign = "+"
+			}
+			actual = append(actual, sign+string(rune('0'+s.migration.Version)))
+		}
+		if strings.Join(actual, " ") != test.expected {
+			t.Errorf("Steps from %d to %d are %q, expected %q", test.current, test.target, strings.Join(actual, " "), test.expected)
+		}
+	}
+	// a database migrated by a newer binary
+	if _, err := steps(migrations, 4, 3); err == nil {
+		t.Errorf("Expected an error for a version ahead of the migrations")
+	}
+}
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS picks;
+DROP TABLE IF EXISTS prospects;
+DROP TABLE IF EXISTS franchises;
+DROP TABLE IF EXISTS leagues;
@@ -0,0 +1,73 @@
+-- tables as created by gorm's AutoMigrate before migrations were introduced,
+-- IF NOT EXISTS adopts databases that were set up that way
+CREATE TABLE IF NOT EXISTS leagues (
+    id uuid PRIMARY KEY,
+    name text NOT NULL,
+    admin text NOT NULL,
+    admin_id uuid NOT NULL,
+    commissioner text NOT NULL,
+    commissioner_id uuid NOT NULL,
+    foundation_year text NOT NULL,
+    max_franchises bigint NOT NULL,
+    max_prospects bigint NOT NULL,
+    draft_rights_goalie bigint NOT NULL,
+    draft_rights_skater bigint NOT NULL,
+    draft_rounds bigint NOT NULL DEFAULT 2,
+    created_at timestamptz,
+    updated_at timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS franchises (
+    id uuid PRIMARY KEY,
+    name text NOT NULL,
+    user_id uuid NOT NULL,
+    user_name text NOT NULL,
+    foundation_year text NOT NULL,
+    league_id uuid NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT fk_leagues_franchises FOREIGN KEY (league_id) REFERENCES leagues (id) ON UPDATE CASCADE ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS prospects (
+    id uuid PRIMARY KEY,
+    full_name text NOT NULL,
+    first_name text NOT NULL,
+    last_name text NOT NULL,
+    nhl_team text NOT NULL,
+    birthdate text NOT NULL,
+    height text NOT NULL,
+    weight text NOT NULL,
+    nhl_draft_year text NOT NULL,
+    nhl_draft_round text NOT NULL,
+    nhl_draft_pick_overall text NOT NULL,
+    nhl_draft_pick_in_round text NOT NULL,
+    position_code text NOT NULL,
+    protected boolean NOT NULL DEFAULT false,
+    league_id uuid,
+    franchise_id uuid,
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT fk_leagues_prospects FOREIGN KEY (league_id) REFERENCES leagues (id) ON UPDATE CASCADE ON DELETE SET NULL,
+    CONSTRAINT fk_franchises_prospects FOREIGN KEY (franchise_id) REFERENCES franchises (id) ON UPDATE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS picks (
+    id uuid PRIMARY KEY,
+    draft_year integer,
+    draft_round integer,
+    draft_pick_overall integer DEFAULT null,
+    draft_pick_in_round integer DEFAULT null,
+    prospect_id uuid,
+    owner_id uuid,
+    owner_name text,
+    last_owner_id uuid,
+    last_owner_name text,
+    origin_id uuid,
+    origin_name text,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    CONSTRAINT fk_prospects_pick FOREIGN KEY (prospect_id) REFERENCES prospects (id) ON UPDATE CASCADE ON DELETE SET NULL,
+    CONSTRAINT fk_franchises_picks FOREIGN KEY (owner_id) REFERENCES franchises (id) ON UPDATE CASCADE
+);
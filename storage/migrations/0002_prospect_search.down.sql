@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS prospects_full_name_trgm_idx;
+DROP INDEX IF EXISTS prospects_search_idx;
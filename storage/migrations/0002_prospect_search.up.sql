@@ -0,0 +1,5 @@
+-- indexes for the prospect text search, trigram matching needs the pg_trgm extension
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS prospects_search_idx ON prospects USING gin (to_tsvector('simple', full_name || ' ' || nhl_team || ' ' || position_code));
+CREATE INDEX IF NOT EXISTS prospects_full_name_trgm_idx ON prospects USING gin (full_name gin_trgm_ops);
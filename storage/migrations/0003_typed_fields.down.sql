@@ -0,0 +1,18 @@
+ALTER TABLE prospects
+    DROP CONSTRAINT IF EXISTS prospects_position_code_check;
+
+ALTER TABLE prospects
+    ALTER COLUMN birthdate TYPE text USING COALESCE(to_char(birthdate, 'YYYY-MM-DD'), ''),
+    ALTER COLUMN birthdate SET NOT NULL,
+    ALTER COLUMN height TYPE text USING CASE WHEN height = 0 THEN '' ELSE format('%s'' %s"', height / 12, height % 12) END,
+    ALTER COLUMN weight TYPE text USING CASE WHEN weight = 0 THEN '' ELSE weight::text END,
+    ALTER COLUMN nhl_draft_year TYPE text USING CASE WHEN nhl_draft_year = 0 THEN '' ELSE nhl_draft_year::text END,
+    ALTER COLUMN nhl_draft_round TYPE text USING CASE WHEN nhl_draft_round = 0 THEN '' ELSE nhl_draft_round::text END,
+    ALTER COLUMN nhl_draft_pick_overall TYPE text USING CASE WHEN nhl_draft_pick_overall = 0 THEN '' ELSE nhl_draft_pick_overall::text END,
+    ALTER COLUMN nhl_draft_pick_in_round TYPE text USING CASE WHEN nhl_draft_pick_in_round = 0 THEN '' ELSE nhl_draft_pick_in_round::text END;
+
+ALTER TABLE franchises
+    ALTER COLUMN foundation_year TYPE text USING foundation_year::text;
+
+ALTER TABLE leagues
+    ALTER COLUMN foundation_year TYPE text USING foundation_year::text;
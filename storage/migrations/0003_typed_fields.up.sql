@@ -0,0 +1,50 @@
+-- years, draft positions, heights and weights become integers and birthdates dates,
+-- values that can't be parsed turn into 0. Birthdates are read in the formats the importer
+-- accepts, empty ones turn into NULL and any other fails the migration with the prospects listed.
+ALTER TABLE leagues
+    ALTER COLUMN foundation_year TYPE integer USING COALESCE(substring(foundation_year from '\d{4}')::integer, 0);
+
+ALTER TABLE franchises
+    ALTER COLUMN foundation_year TYPE integer USING COALESCE(substring(foundation_year from '\d{4}')::integer, 0);
+
+UPDATE prospects SET birthdate = trim(birthdate);
+DO $$
+DECLARE
+    invalid text;
+BEGIN
+    SELECT string_agg(format('%s (%s)', id, birthdate), ', ') INTO invalid FROM prospects
+    WHERE birthdate <> ''
+        AND birthdate !~ '^\d{4}-\d{2}-\d{2}'
+        AND birthdate !~ '^(\d{4}/\d{1,2}/\d{1,2}|\d{1,2}\.\d{1,2}\.\d{4}|\d{1,2}/\d{1,2}/\d{4}|\d{8})$';
+    IF invalid IS NOT NULL THEN
+        RAISE EXCEPTION 'birthdates of prospects can not be converted to dates: %', invalid;
+    END IF;
+END $$;
+
+ALTER TABLE prospects
+    ALTER COLUMN birthdate DROP NOT NULL,
+    -- dots are day first, slashes month first
+    ALTER COLUMN birthdate TYPE date USING CASE
+        WHEN birthdate ~ '^\d{4}-\d{2}-\d{2}' THEN substring(birthdate from 1 for 10)::date
+        WHEN birthdate ~ '^\d{4}/\d{1,2}/\d{1,2}$' THEN to_date(birthdate, 'YYYY/MM/DD')
+        WHEN birthdate ~ '^\d{1,2}\.\d{1,2}\.\d{4}$' THEN to_date(birthdate, 'DD.MM.YYYY')
+        WHEN birthdate ~ '^\d{1,2}/\d{1,2}/\d{4}$' THEN to_date(birthdate, 'MM/DD/YYYY')
+        WHEN birthdate ~ '^\d{8}$' THEN to_date(birthdate, 'YYYYMMDD')
+    END,
+    -- heights come as feet and inches, e.g. 6' 1"
+    ALTER COLUMN height TYPE integer USING CASE
+        WHEN height ~ '^\s*\d+''\s*\d+' THEN (regexp_match(height, '(\d+)''\s*(\d+)'))[1]::integer * 12 + (regexp_match(height, '(\d+)''\s*(\d+)'))[2]::integer
+        ELSE COALESCE(substring(height from '\d+')::integer, 0)
+    END,
+    ALTER COLUMN weight TYPE integer USING COALESCE(substring(weight from '\d+')::integer, 0),
+    ALTER COLUMN nhl_draft_year TYPE integer USING COALESCE(substring(nhl_draft_year from '\d+')::integer, 0),
+    ALTER COLUMN nhl_draft_round TYPE integer USING COALESCE(substring(nhl_draft_round from '\d+')::integer, 0),
+    ALTER COLUMN nhl_draft_pick_overall TYPE integer USING COALESCE(substring(nhl_draft_pick_overall from '\d+')::integer, 0),
+    ALTER COLUMN nhl_draft_pick_in_round TYPE integer USING COALESCE(substring(nhl_draft_pick_in_round from '\d+')::integer, 0);
+
+-- '' is the unknown position like models.Unknown, the names of other sources map to the nhl codes
+UPDATE prospects SET position_code = upper(trim(position_code));
+UPDATE prospects SET position_code = CASE position_code WHEN 'LW' THEN 'L' WHEN 'RW' THEN 'R' ELSE 'D' END
+    WHERE position_code IN ('LW', 'RW', 'LD', 'RD');
+ALTER TABLE prospects
+    ADD CONSTRAINT prospects_position_code_check CHECK (position_code IN ('C', 'L', 'R', 'D', 'G', ''));
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS franchise_histories;
+
+-- soft deleted franchises can't be told apart anymore
+DELETE FROM franchises WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_franchises_deleted_at;
+
+ALTER TABLE franchises
+    DROP COLUMN deleted_at,
+    DROP COLUMN orphan;
@@ -0,0 +1,23 @@
+-- soft deleted franchises and the commissioner held franchise that takes over their picks
+ALTER TABLE franchises
+    ADD COLUMN orphan boolean NOT NULL DEFAULT false,
+    ADD COLUMN deleted_at timestamptz;
+
+CREATE INDEX idx_franchises_deleted_at ON franchises (deleted_at);
+
+CREATE TABLE franchise_histories (
+    id uuid PRIMARY KEY,
+    franchise_id uuid NOT NULL,
+    change text NOT NULL,
+    name text NOT NULL,
+    user_id uuid NOT NULL,
+    user_name text NOT NULL,
+    created_at timestamptz,
+    CONSTRAINT fk_franchises_history FOREIGN KEY (franchise_id) REFERENCES franchises (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX idx_franchise_histories_franchise_id ON franchise_histories (franchise_id);
+
+-- existing franchises start their history with their current name and owner
+INSERT INTO franchise_histories (id, franchise_id, change, name, user_id, user_name, created_at)
+SELECT gen_random_uuid(), id, 'created', name, user_id, user_name, created_at FROM franchises;
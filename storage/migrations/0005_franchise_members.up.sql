@@ -0,0 +1,18 @@
+CREATE TABLE franchise_members (
+    id uuid PRIMARY KEY,
+    franchise_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    user_name text NOT NULL,
+    role text NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT fk_franchises_members FOREIGN KEY (franchise_id) REFERENCES franchises (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT franchise_members_role_check CHECK (role IN ('owner', 'co-owner', 'assistant-gm')),
+    CONSTRAINT franchise_members_user_key UNIQUE (franchise_id, user_id)
+);
+
+CREATE INDEX idx_franchise_members_user_id ON franchise_members (user_id);
+
+-- the owner of every franchise becomes its first member
+INSERT INTO franchise_members (id, franchise_id, user_id, user_name, role, created_at)
+SELECT gen_random_uuid(), id, user_id, user_name, 'owner', created_at FROM franchises;
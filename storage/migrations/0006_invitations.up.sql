@@ -0,0 +1,20 @@
+-- invitations reserve a franchise slot until they are claimed, revoked or expire
+CREATE TABLE invitations (
+    id uuid PRIMARY KEY,
+    league_id uuid NOT NULL,
+    email text NOT NULL,
+    name text,
+    franchise_name text,
+    invited_by_id uuid NOT NULL,
+    expires_at timestamptz NOT NULL,
+    franchise_id uuid,
+    claimed_by_id uuid,
+    claimed_at timestamptz,
+    revoked_at timestamptz,
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT fk_leagues_invitations FOREIGN KEY (league_id) REFERENCES leagues (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_franchises_invitations FOREIGN KEY (franchise_id) REFERENCES franchises (id) ON UPDATE CASCADE ON DELETE SET NULL
+);
+
+CREATE INDEX idx_invitations_league_id ON invitations (league_id);
@@ -0,0 +1,19 @@
+ALTER TABLE picks
+    DROP CONSTRAINT fk_picks_origin,
+    DROP CONSTRAINT fk_picks_last_owner,
+    DROP CONSTRAINT fk_franchises_picks,
+    ADD CONSTRAINT fk_franchises_picks FOREIGN KEY (owner_id) REFERENCES franchises (id) ON UPDATE CASCADE;
+
+ALTER TABLE prospects
+    DROP CONSTRAINT fk_franchises_prospects,
+    ADD CONSTRAINT fk_franchises_prospects FOREIGN KEY (franchise_id) REFERENCES franchises (id) ON UPDATE CASCADE;
+
+ALTER TABLE franchises
+    DROP CONSTRAINT fk_leagues_franchises,
+    ADD CONSTRAINT fk_leagues_franchises FOREIGN KEY (league_id) REFERENCES leagues (id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+ALTER TABLE leagues
+    DROP CONSTRAINT fk_leagues_cloned_from,
+    DROP COLUMN cloned_from_id,
+    DROP COLUMN archived_at,
+    DROP COLUMN season;
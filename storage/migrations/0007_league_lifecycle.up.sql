@@ -0,0 +1,25 @@
+-- archived leagues are read-only, cloned leagues continue an older one in a new season
+ALTER TABLE leagues
+    ADD COLUMN season integer NOT NULL DEFAULT 0,
+    ADD COLUMN archived_at timestamptz,
+    ADD COLUMN cloned_from_id uuid,
+    ADD CONSTRAINT fk_leagues_cloned_from FOREIGN KEY (cloned_from_id) REFERENCES leagues (id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+-- deleting a league deletes its franchises and their picks, the prospects return to the pool.
+-- league_id of franchises is not null, so SET NULL could never succeed.
+ALTER TABLE franchises
+    DROP CONSTRAINT fk_leagues_franchises,
+    ADD CONSTRAINT fk_leagues_franchises FOREIGN KEY (league_id) REFERENCES leagues (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE prospects
+    DROP CONSTRAINT fk_franchises_prospects,
+    ADD CONSTRAINT fk_franchises_prospects FOREIGN KEY (franchise_id) REFERENCES franchises (id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+UPDATE picks SET last_owner_id = NULL WHERE last_owner_id NOT IN (SELECT id FROM franchises);
+UPDATE picks SET origin_id = NULL WHERE origin_id NOT IN (SELECT id FROM franchises);
+
+ALTER TABLE picks
+    DROP CONSTRAINT fk_franchises_picks,
+    ADD CONSTRAINT fk_franchises_picks FOREIGN KEY (owner_id) REFERENCES franchises (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    ADD CONSTRAINT fk_picks_last_owner FOREIGN KEY (last_owner_id) REFERENCES franchises (id) ON UPDATE CASCADE ON DELETE SET NULL,
+    ADD CONSTRAINT fk_picks_origin FOREIGN KEY (origin_id) REFERENCES franchises (id) ON UPDATE CASCADE ON DELETE SET NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS seasons;
+
+ALTER TABLE leagues DROP COLUMN future_pick_years;
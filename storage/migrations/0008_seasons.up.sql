@@ -0,0 +1,25 @@
+ALTER TABLE leagues ADD COLUMN future_pick_years integer NOT NULL DEFAULT 3;
+
+CREATE TABLE seasons (
+    id uuid PRIMARY KEY,
+    league_id uuid NOT NULL,
+    year integer NOT NULL,
+    start_date date NOT NULL,
+    end_date date NOT NULL,
+    status text NOT NULL,
+    closed_at timestamptz,
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT fk_leagues_seasons FOREIGN KEY (league_id) REFERENCES leagues (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT seasons_status_check CHECK (status IN ('active', 'closed')),
+    CONSTRAINT seasons_league_year_key UNIQUE (league_id, year)
+);
+
+-- a league has at most one active season
+CREATE UNIQUE INDEX idx_seasons_active ON seasons (league_id) WHERE status = 'active';
+
+-- leagues with a season get it as a row, it runs from july to the draft in june
+INSERT INTO seasons (id, league_id, year, start_date, end_date, status, closed_at, created_at)
+SELECT gen_random_uuid(), id, season, make_date(season - 1, 7, 1), make_date(season, 6, 30),
+       CASE WHEN archived_at IS NULL THEN 'active' ELSE 'closed' END, archived_at, now()
+FROM leagues WHERE season <> 0;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS pick_conditions;
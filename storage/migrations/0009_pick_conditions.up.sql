@@ -0,0 +1,23 @@
+CREATE TABLE pick_conditions (
+    id uuid PRIMARY KEY,
+    league_id uuid NOT NULL,
+    pick_id uuid NOT NULL,
+    from_id uuid NOT NULL,
+    from_name text,
+    to_id uuid NOT NULL,
+    to_name text,
+    protected_top integer NOT NULL,
+    substitute_pick_id uuid,
+    status text NOT NULL,
+    resolved_at timestamptz,
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT fk_leagues_pick_conditions FOREIGN KEY (league_id) REFERENCES leagues (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_picks_pick_conditions FOREIGN KEY (pick_id) REFERENCES picks (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_picks_pick_conditions_substitute FOREIGN KEY (substitute_pick_id) REFERENCES picks (id) ON UPDATE CASCADE ON DELETE SET NULL,
+    CONSTRAINT pick_conditions_status_check CHECK (status IN ('pending', 'conveyed', 'converted', 'extinguished')),
+    CONSTRAINT pick_conditions_protected_top_check CHECK (protected_top > 0)
+);
+
+CREATE INDEX idx_pick_conditions_pick_id ON pick_conditions (pick_id);
+CREATE INDEX idx_pick_conditions_substitute_pick_id ON pick_conditions (substitute_pick_id);
@@ -0,0 +1,21 @@
+CREATE TABLE pick_swaps (
+    id uuid PRIMARY KEY,
+    league_id uuid NOT NULL,
+    holder_id uuid NOT NULL,
+    holder_name text,
+    holder_pick_id uuid NOT NULL,
+    grantor_id uuid NOT NULL,
+    grantor_name text,
+    grantor_pick_id uuid NOT NULL,
+    status text NOT NULL,
+    resolved_at timestamptz,
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT fk_leagues_pick_swaps FOREIGN KEY (league_id) REFERENCES leagues (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_picks_pick_swaps_holder FOREIGN KEY (holder_pick_id) REFERENCES picks (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_picks_pick_swaps_grantor FOREIGN KEY (grantor_pick_id) REFERENCES picks (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT pick_swaps_status_check CHECK (status IN ('pending', 'exercised', 'declined'))
+);
+
+CREATE INDEX idx_pick_swaps_holder_pick_id ON pick_swaps (holder_pick_id);
+CREATE INDEX idx_pick_swaps_grantor_pick_id ON pick_swaps (grantor_pick_id);
@@ -0,0 +1,4 @@
+ALTER TABLE picks DROP COLUMN reason;
+ALTER TABLE picks DROP COLUMN forfeited_at;
+ALTER TABLE picks DROP COLUMN supplemental;
+ALTER TABLE picks DROP COLUMN slot;
@@ -0,0 +1,7 @@
+ALTER TABLE picks ADD COLUMN slot integer;
+ALTER TABLE picks ADD COLUMN supplemental boolean NOT NULL DEFAULT false;
+ALTER TABLE picks ADD COLUMN forfeited_at timestamptz;
+ALTER TABLE picks ADD COLUMN reason text;
+
+-- positioned picks were placed by their lottery position
+UPDATE picks SET slot = draft_pick_in_round WHERE draft_pick_in_round IS NOT NULL;
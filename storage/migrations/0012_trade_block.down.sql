@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS trade_block_entries;
+
+ALTER TABLE franchises DROP COLUMN looking_for;
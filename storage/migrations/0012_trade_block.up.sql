@@ -0,0 +1,22 @@
+ALTER TABLE franchises ADD COLUMN looking_for text;
+
+CREATE TABLE trade_block_entries (
+    id uuid PRIMARY KEY,
+    league_id uuid NOT NULL,
+    franchise_id uuid NOT NULL,
+    prospect_id uuid,
+    pick_id uuid,
+    note text,
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT fk_leagues_trade_block_entries FOREIGN KEY (league_id) REFERENCES leagues (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_franchises_trade_block_entries FOREIGN KEY (franchise_id) REFERENCES franchises (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_prospects_trade_block_entries FOREIGN KEY (prospect_id) REFERENCES prospects (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_picks_trade_block_entries FOREIGN KEY (pick_id) REFERENCES picks (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT trade_block_entries_asset_check CHECK ((prospect_id IS NULL) <> (pick_id IS NULL))
+);
+
+-- an asset is listed once
+CREATE UNIQUE INDEX idx_trade_block_entries_prospect_id ON trade_block_entries (prospect_id) WHERE prospect_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_trade_block_entries_pick_id ON trade_block_entries (pick_id) WHERE pick_id IS NOT NULL;
+CREATE INDEX idx_trade_block_entries_league_id ON trade_block_entries (league_id);
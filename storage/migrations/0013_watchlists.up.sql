@@ -0,0 +1,14 @@
+CREATE TABLE watchlist_entries (
+    id uuid PRIMARY KEY,
+    user_id uuid NOT NULL,
+    prospect_id uuid NOT NULL,
+    note text,
+    tags jsonb NOT NULL DEFAULT '[]',
+    rank integer,
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT fk_prospects_watchlist_entries FOREIGN KEY (prospect_id) REFERENCES prospects (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+-- a prospect is on a user's watchlist once
+CREATE UNIQUE INDEX idx_watchlist_entries_user_id_prospect_id ON watchlist_entries (user_id, prospect_id);
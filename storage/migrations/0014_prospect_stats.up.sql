@@ -0,0 +1,20 @@
+CREATE TABLE prospect_season_stats (
+    id uuid PRIMARY KEY,
+    prospect_id uuid NOT NULL,
+    season integer NOT NULL,
+    level text NOT NULL,
+    team text NOT NULL,
+    games_played integer NOT NULL DEFAULT 0,
+    goals integer NOT NULL DEFAULT 0,
+    assists integer NOT NULL DEFAULT 0,
+    points integer NOT NULL DEFAULT 0,
+    penalty_minutes integer NOT NULL DEFAULT 0,
+    save_percentage double precision,
+    goals_against_average double precision,
+    created_at timestamptz,
+    updated_at timestamptz,
+    CONSTRAINT fk_prospects_season_stats FOREIGN KEY (prospect_id) REFERENCES prospects (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+-- a prospect has one line per season, level and team
+CREATE UNIQUE INDEX idx_prospect_season_stats_line ON prospect_season_stats (prospect_id, season, lower(level), lower(team));
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS prospect_status_changes;
+
+ALTER TABLE leagues DROP COLUMN IF EXISTS status_rules;
+
+ALTER TABLE prospects
+    DROP COLUMN IF EXISTS status_since,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,18 @@
+-- the status of a prospect and its history, leagues decide what happens to rostered prospects changing status
+ALTER TABLE prospects
+    ADD COLUMN status text NOT NULL DEFAULT 'UNSIGNED',
+    ADD COLUMN status_since date;
+
+ALTER TABLE leagues
+    ADD COLUMN status_rules jsonb NOT NULL DEFAULT '{"RETIRED": "release", "RIGHTS_EXPIRED": "release"}';
+
+CREATE TABLE prospect_status_changes (
+    id uuid PRIMARY KEY,
+    prospect_id uuid NOT NULL,
+    status text NOT NULL,
+    effective_date date NOT NULL,
+    created_at timestamptz,
+    CONSTRAINT fk_prospects_status_changes FOREIGN KEY (prospect_id) REFERENCES prospects (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX idx_prospect_status_changes_prospect_id ON prospect_status_changes (prospect_id, effective_date);
@@ -0,0 +1,17 @@
+-- rankings of prospects for a draft, imported from a named source or submitted by a franchise
+CREATE TABLE prospect_rankings (
+    id uuid PRIMARY KEY,
+    year integer NOT NULL,
+    source text NOT NULL DEFAULT '',
+    franchise_id uuid,
+    prospect_id uuid NOT NULL,
+    rank integer NOT NULL,
+    created_at timestamptz,
+    CONSTRAINT fk_franchises_rankings FOREIGN KEY (franchise_id) REFERENCES franchises (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_prospects_rankings FOREIGN KEY (prospect_id) REFERENCES prospects (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT prospect_rankings_source_check CHECK ((source = '') <> (franchise_id IS NULL))
+);
+
+-- a ranking lists a prospect once
+CREATE UNIQUE INDEX idx_prospect_rankings_source ON prospect_rankings (year, source, prospect_id) WHERE franchise_id IS NULL;
+CREATE UNIQUE INDEX idx_prospect_rankings_franchise ON prospect_rankings (year, franchise_id, prospect_id) WHERE franchise_id IS NOT NULL;
@@ -0,0 +1 @@
+ALTER TABLE leagues DROP COLUMN IF EXISTS eligibility;
@@ -0,0 +1,3 @@
+-- the rules prospects must meet to be drafted in a league, an empty object allows every prospect
+ALTER TABLE leagues
+    ADD COLUMN eligibility jsonb NOT NULL DEFAULT '{}';
@@ -0,0 +1,16 @@
+-- the standings of the franchises in the seasons of their league
+CREATE TABLE standings (
+    id uuid PRIMARY KEY,
+    league_id uuid NOT NULL,
+    franchise_id uuid NOT NULL,
+    year integer NOT NULL,
+    wins integer NOT NULL,
+    losses integer NOT NULL,
+    points integer NOT NULL,
+    rank integer NOT NULL,
+    created_at timestamptz,
+    CONSTRAINT fk_leagues_standings FOREIGN KEY (league_id) REFERENCES leagues (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_franchises_standings FOREIGN KEY (franchise_id) REFERENCES franchises (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_standings_franchise ON standings (league_id, year, franchise_id);
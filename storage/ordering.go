@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"strconv"
+
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+var leagueOrdering = Ordering{
+	Columns: map[string]string{"name": "name", "foundationYear": "foundation_year", "createdAt": "created_at"},
+	Default: "createdAt",
+}
+
+func leagueKey(l models.League, field string) (string, string) {
+	switch field {
+	case "name":
+		return l.Name, l.ID.String()
+	case "foundationYear":
+		return strconv.Itoa(l.FoundationYear), l.ID.String()
+	default:
+		return formatCursorTime(l.CreatedAt), l.ID.String()
+	}
+}
+
+var franchiseOrdering = Ordering{
+	Columns: map[string]string{"name": "name", "ownerName": "user_name", "foundationYear": "foundation_year", "createdAt": "created_at"},
+	Default: "createdAt",
+}
+
+func franchiseKey(f models.Franchise, field string) (string, string) {
+	switch field {
+	case "name":
+		return f.Name, f.ID.String()
+	case "ownerName":
+		return f.UserName, f.ID.String()
+	case "foundationYear":
+		return strconv.Itoa(f.FoundationYear), f.ID.String()
+	default:
+		return formatCursorTime(f.CreatedAt), f.ID.String()
+	}
+}
+
+var prospectOrdering = Ordering{
+	Columns: map[string]string{"fullName": "full_name", "lastName": "last_name", "nhlTeam": "nhl_team", "nhlDraftYear": "nhl_draft_year", "positionCode": "position_code", "createdAt": "created_at"},
+	Default: "lastName",
+}
+
+func prospectKey(p models.Prospect, field string) (string, string) {
+	switch field {
+	case "fullName":
+		return p.FullName, p.ID.String()
+	case "lastName":
+		return p.LastName, p.ID.String()
+	case "nhlTeam":
+		return p.NhlTeam, p.ID.String()
+	case "nhlDraftYear":
+		return strconv.Itoa(p.NhlDraftYear), p.ID.String()
+	case "positionCode":
+		return string(p.PositionCode), p.ID.String()
+	default:
+		return formatCursorTime(p.CreatedAt), p.ID.String()
+	}
+}
+
+var pickOrdering = Ordering{
+	Columns: map[string]string{"draftYear": "draft_year", "draftRound": "draft_round", "ownerName": "owner_name", "originName": "origin_name", "createdAt": "created_at"},
+	Default: "draftYear",
+}
+
+func pickKey(p models.Pick, field string) (string, string) {
+	switch field {
+	case "draftYear":
+		return strconv.Itoa(p.DraftYear), p.ID.String()
+	case "draftRound":
+		return strconv.Itoa(p.DraftRound), p.ID.String()
+	case "ownerName":
+		return p.OwnerName, p.ID.String()
+	case "originName":
+		return p.OriginName, p.ID.String()
+	default:
+		return formatCursorTime(p.CreatedAt), p.ID.String()
+	}
+}
@@ -0,0 +1,229 @@
+package storage
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 1000
+)
+
+// cursorTime formats timestamps in page tokens with a fixed width, so they sort like the column.
+const cursorTime = "2006-01-02T15:04:05.000000Z07:00"
+
+// Page describes the requested slice of a cursor paginated list.
+type Page struct {
+	Size    int
+	Token   string
+	OrderBy string
+}
+
+// Ordering maps the public sort field names of a list to their table columns.
+type Ordering struct {
+	Columns map[string]string
+	Default string
+}
+
+type cursor struct {
+	OrderBy string `json:"o"`
+	Value   string `json:"v"`
+	ID      string `json:"i"`
+}
+
+type sortSpec struct {
+	field  string
+	column string
+	desc   bool
+}
+
+// Limit returns the page size clamped to MaxPageSize, DefaultPageSize if unset.
+func (p Page) Limit() int {
+	if p.Size <= 0 {
+		return DefaultPageSize
+	}
+	if p.Size > MaxPageSize {
+		return MaxPageSize
+	}
+	return p.Size
+}
+
+func (o Ordering) parse(orderBy string) (sortSpec, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		orderBy = o.Default
+	}
+	parts := strings.Fields(orderBy)
+	if len(parts) > 2 {
+		return sortSpec{}, fmt.Errorf("%w: invalid order by %q", ErrInvalidPage, orderBy)
+	}
+	column, ok := o.Columns[parts[0]]
+	if !ok {
+		return sortSpec{}, fmt.Errorf("%w: cannot order by %q", ErrInvalidPage, parts[0])
+	}
+	spec := sortSpec{field: parts[0], column: column}
+	if len(parts) == 2 {
+		switch strings.ToLower(parts[1]) {
+		case "asc":
+		case "desc":
+			spec.desc = true
+		default:
+			return sortSpec{}, fmt.Errorf("%w: invalid sort direction %q", ErrInvalidPage, parts[1])
+		}
+	}
+	return spec, nil
+}
+
+func (s sortSpec) String() string {
+	if s.desc {
+		return s.field + " desc"
+	}
+	return s.field
+}
+
+func decodeCursor(token string) (*cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid page token", ErrInvalidPage)
+	}
+	var c cursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, fmt.Errorf("%w: invalid page token", ErrInvalidPage)
+	}
+	return &c, nil
+}
+
+func encodeCursor(c cursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// start parses the order and the cursor of the page.
+func (o Ordering) start(page Page) (sortSpec, *cursor, error) {
+	spec, err := o.parse(page.OrderBy)
+	if err != nil {
+		return spec, nil, err
+	}
+	if page.Token == "" {
+		return spec, nil, nil
+	}
+	after, err := decodeCursor(page.Token)
+	if err != nil {
+		return spec, nil, err
+	}
+	if after.OrderBy != spec.String() {
+		return spec, nil, fmt.Errorf("%w: page token does not match order by %q", ErrInvalidPage, spec.String())
+	}
+	return spec, after, nil
+}
+
+// Paginate returns a gorm scope that orders the query by the requested field
+// (ties broken by id), continues after the cursor in the page token and
+// fetches one row more than the page size, so that Trim can tell whether a
+// next page exists.
+func Paginate(table string, ordering Ordering, page Page) (func(db *gorm.DB) *gorm.DB, error) {
+	spec, after, err := ordering.start(page)
+	if err != nil {
+		return nil, err
+	}
+
+	column := fmt.Sprintf("%s.%s", table, spec.column)
+	id := fmt.Sprintf("%s.id", table)
+	direction := "ASC"
+	comparison := ">"
+	if spec.desc {
+		direction = "DESC"
+		comparison = "<"
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		if after != nil {
+			db = db.Where(fmt.Sprintf("(%s, %s) %s (?, ?)", column, id, comparison), after.Value, after.ID)
+		}
+		return db.Order(fmt.Sprintf("%s %s, %s %s", column, direction, id, direction)).Limit(page.Limit() + 1)
+	}, nil
+}
+
+// Trim cuts the extra row fetched by Paginate and returns the token for the
+// next page, which is empty on the last page. key returns the value of the
+// sort column and the id of an item.
+func Trim[T any](ordering Ordering, page Page, items []T, key func(item T, field string) (string, string)) ([]T, string) {
+	if len(items) <= page.Limit() {
+		return items, ""
+	}
+	spec, _ := ordering.parse(page.OrderBy)
+	items = items[:page.Limit()]
+	value, id := key(items[len(items)-1], spec.field)
+	return items, encodeCursor(cursor{OrderBy: spec.String(), Value: value, ID: id})
+}
+
+// pageSlice does for in-memory lists what Paginate and Trim do for queries.
+func pageSlice[T any](ordering Ordering, page Page, items []T, key func(item T, field string) (string, string)) ([]T, string, error) {
+	spec, after, err := ordering.start(page)
+	if err != nil {
+		return nil, "", err
+	}
+	compare := func(a, b T) int {
+		av, aid := key(a, spec.field)
+		bv, bid := key(b, spec.field)
+		c := compareKeys(av, bv)
+		if c == 0 {
+			c = strings.Compare(aid, bid)
+		}
+		if spec.desc {
+			return -c
+		}
+		return c
+	}
+	sort.SliceStable(items, func(i, j int) bool { return compare(items[i], items[j]) < 0 })
+
+	result := []T{}
+	for _, item := range items {
+		if after != nil {
+			v, id := key(item, spec.field)
+			c := compareKeys(v, after.Value)
+			if c == 0 {
+				c = strings.Compare(id, after.ID)
+			}
+			if spec.desc {
+				c = -c
+			}
+			if c <= 0 {
+				continue
+			}
+		}
+		result = append(result, item)
+		if len(result) > page.Limit() {
+			break
+		}
+	}
+	result, next := Trim(ordering, page, result, key)
+	return result, next, nil
+}
+
+// compareKeys compares sort values numerically if both are integers, like the integer columns they come from.
+func compareKeys(a, b string) int {
+	if ai, err := strconv.Atoi(a); err == nil {
+		if bi, err := strconv.Atoi(b); err == nil {
+			switch {
+			case ai < bi:
+				return -1
+			case ai > bi:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+func formatCursorTime(t time.Time) string {
+	return t.Format(cursorTime)
+}
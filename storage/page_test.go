@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"fmt"
+	"testing"
+)
+
+var testOrdering = Ordering{
+	Columns: map[string]string{"name": "name", "createdAt": "created_at"},
+	Default: "createdAt",
+}
+
+type item struct {
+	id   string
+	name string
+}
+
+func itemKey(i item, field string) (string, string) {
+	return i.name, i.id
+}
+
+func TestTrim(t *testing.T) {
+	var items []item
+	for i := 0; i < 4; i++ {
+		items = append(items, item{id: fmt.Sprintf("%d", i), name: fmt.Sprintf("name%d", i)})
+	}
+
+	page := Page{Size: 3, OrderBy: "name desc"}
+	trimmed, token := Trim(testOrdering, page, items, itemKey)
+	if len(trimmed) != 3 {
+		t.Fatalf("Page length %d not equal to expected %d", len(trimmed), 3)
+	}
+	if token == "" {
+		t.Fatal("Expected a next page token")
+	}
+
+	c, err := decodeCursor(token)
+	if err != nil {
+		t.Fatalf("Decoding page token failed: %v", err)
+	}
+	if c.OrderBy != "name desc" || c.Value != "name2" || c.ID != "2" {
+		t.Errorf("Cursor %+v does not point after the last item of the page", c)
+	}
+
+	if _, token := Trim(testOrdering, Page{Size: 4}, items, itemKey); token != "" {
+		t.Errorf("Expected no next page token on the last page, got %q", token)
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	if _, err := Paginate("items", testOrdering, Page{OrderBy: "unknown"}); err == nil {
+		t.Error("Expected an error when ordering by an unknown field")
+	}
+
+	if _, err := Paginate("items", testOrdering, Page{OrderBy: "name sideways"}); err == nil {
+		t.Error("Expected an error for an invalid sort direction")
+	}
+
+	if _, err := Paginate("items", testOrdering, Page{Token: "not a token"}); err == nil {
+		t.Error("Expected an error for an invalid page token")
+	}
+
+	token := encodeCursor(cursor{OrderBy: "name", Value: "a", ID: "1"})
+	if _, err := Paginate("items", testOrdering, Page{Token: token, OrderBy: "name desc"}); err == nil {
+		t.Error("Expected an error when the page token was issued for another order")
+	}
+	if _, err := Paginate("items", testOrdering, Page{Token: token, OrderBy: "name"}); err != nil {
+		t.Errorf("Paginate failed: %v", err)
+	}
+}
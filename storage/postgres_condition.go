@@ -0,0 +1,34 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func (r *postgresRepository) CreatePickCondition(ctx context.Context, condition *models.PickCondition) error {
+	return r.db.WithContext(ctx).Create(condition).Error
+}
+
+func (r *postgresRepository) SavePickCondition(ctx context.Context, condition *models.PickCondition) error {
+	return r.db.WithContext(ctx).Save(condition).Error
+}
+
+func (r *postgresRepository) ListPickConditions(ctx context.Context, filter ConditionFilter) ([]models.PickCondition, error) {
+	var conditions []models.PickCondition
+	db := r.db.WithContext(ctx)
+	if filter.LeagueID != nil {
+		db = db.Where("league_id = ?", *filter.LeagueID)
+	}
+	if filter.PickIDs != nil {
+		if len(filter.PickIDs) == 0 {
+			return conditions, nil
+		}
+		db = db.Where("pick_id IN ? OR substitute_pick_id IN ?", filter.PickIDs, filter.PickIDs)
+	}
+	if filter.Status != "" {
+		db = db.Where("status = ?", filter.Status)
+	}
+	err := db.Order("created_at, id").Find(&conditions).Error
+	return conditions, err
+}
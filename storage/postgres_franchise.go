@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// prospectsByName orders the preloaded prospects of franchises
+func prospectsByName(db *gorm.DB) *gorm.DB {
+	return db.Order("last_name, first_name, id")
+}
+
+func (r *postgresRepository) CreateFranchise(ctx context.Context, franchise *models.Franchise) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Create(franchise).Error
+}
+
+func (r *postgresRepository) FindFranchise(ctx context.Context, id uuid.UUID) (*models.Franchise, error) {
+	var franchise models.Franchise
+	if err := r.db.WithContext(ctx).Preload("Prospects", prospectsByName).First(&franchise, "id = ?", id).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &franchise, nil
+}
+
+func (r *postgresRepository) FindFranchiseByName(ctx context.Context, leagueID uuid.UUID, name string) (*models.Franchise, error) {
+	var franchise models.Franchise
+	if err := r.db.WithContext(ctx).Where(&models.Franchise{LeagueID: leagueID, Name: name}).First(&franchise).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &franchise, nil
+}
+
+func (r *postgresRepository) CountFranchises(ctx context.Context, leagueID uuid.UUID) (int64, error) {
+	var n int64
+	err := r.db.WithContext(ctx).Model(&models.Franchise{}).Where("league_id = ? AND NOT orphan", leagueID).Count(&n).Error
+	return n, err
+}
+
+func (r *postgresRepository) ListFranchises(ctx context.Context, leagueID uuid.UUID, page Page) ([]models.Franchise, string, error) {
+	var franchises []models.Franchise
+	paginate, err := Paginate("franchises", franchiseOrdering, page)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := r.db.WithContext(ctx).Preload("Prospects", prospectsByName).Scopes(paginate).Find(&franchises, "league_id = ?", leagueID).Error; err != nil {
+		return nil, "", err
+	}
+	franchises, next := Trim(franchiseOrdering, page, franchises, franchiseKey)
+	return franchises, next, nil
+}
+
+func (r *postgresRepository) SaveFranchise(ctx context.Context, franchise *models.Franchise) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Save(franchise).Error
+}
+
+func (r *postgresRepository) DeleteFranchise(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&models.Franchise{}, "id = ?", id).Error
+}
+
+func (r *postgresRepository) FindOrphanFranchise(ctx context.Context, leagueID uuid.UUID) (*models.Franchise, error) {
+	var franchise models.Franchise
+	if err := r.db.WithContext(ctx).First(&franchise, "league_id = ? AND orphan", leagueID).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &franchise, nil
+}
+
+func (r *postgresRepository) AddFranchiseHistory(ctx context.Context, history *models.FranchiseHistory) error {
+	return r.db.WithContext(ctx).Create(history).Error
+}
+
+func (r *postgresRepository) ListFranchiseHistory(ctx context.Context, franchiseID uuid.UUID) ([]models.FranchiseHistory, error) {
+	var history []models.FranchiseHistory
+	err := r.db.WithContext(ctx).Order("created_at, id").Find(&history, "franchise_id = ?", franchiseID).Error
+	return history, err
+}
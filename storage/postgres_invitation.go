@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"gorm.io/gorm/clause"
+)
+
+func (r *postgresRepository) CreateInvitation(ctx context.Context, invitation *models.Invitation) error {
+	return r.db.WithContext(ctx).Create(invitation).Error
+}
+
+func (r *postgresRepository) SaveInvitation(ctx context.Context, invitation *models.Invitation) error {
+	return r.db.WithContext(ctx).Save(invitation).Error
+}
+
+// FindInvitation locks the invitation, so concurrent claims of it are serialized.
+func (r *postgresRepository) FindInvitation(ctx context.Context, id uuid.UUID) (*models.Invitation, error) {
+	var invitation models.Invitation
+	if err := r.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&invitation, "id = ?", id).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &invitation, nil
+}
+
+func (r *postgresRepository) CountOpenInvitations(ctx context.Context, leagueID uuid.UUID, now time.Time) (int64, error) {
+	var n int64
+	err := r.db.WithContext(ctx).Model(&models.Invitation{}).
+		Where("league_id = ? AND claimed_at IS NULL AND revoked_at IS NULL AND expires_at > ?", leagueID, now).
+		Count(&n).Error
+	return n, err
+}
@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"gorm.io/gorm/clause"
+)
+
+func (r *postgresRepository) CreateLeague(ctx context.Context, league *models.League) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Create(league).Error
+}
+
+func (r *postgresRepository) SaveLeague(ctx context.Context, league *models.League) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Save(league).Error
+}
+
+func (r *postgresRepository) FindLeague(ctx context.Context, id uuid.UUID) (*models.League, error) {
+	var league models.League
+	if err := r.db.WithContext(ctx).Preload("Franchises").First(&league, "id = ?", id).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &league, nil
+}
+
+func (r *postgresRepository) FindLeagueByName(ctx context.Context, name string) (*models.League, error) {
+	var league models.League
+	if err := r.db.WithContext(ctx).Where("name = ? AND archived_at IS NULL", name).First(&league).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &league, nil
+}
+
+func (r *postgresRepository) ListLeagues(ctx context.Context, page Page) ([]models.League, string, error) {
+	var leagues []models.League
+	paginate, err := Paginate("leagues", leagueOrdering, page)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := r.db.WithContext(ctx).Preload("Franchises").Scopes(paginate).Find(&leagues).Error; err != nil {
+		return nil, "", err
+	}
+	leagues, next := Trim(leagueOrdering, page, leagues, leagueKey)
+	return leagues, next, nil
+}
+
+// DeleteLeague releases the prospects, the foreign keys cascade the rest.
+func (r *postgresRepository) DeleteLeague(ctx context.Context, id uuid.UUID) error {
+	db := r.db.WithContext(ctx)
+	err := db.Model(&models.Prospect{}).
+		Where("league_id = ? OR franchise_id IN (?)", id, db.Unscoped().Model(&models.Franchise{}).Select("id").Where("league_id = ?", id)).
+		Updates(map[string]interface{}{"league_id": nil, "franchise_id": nil, "protected": false}).Error
+	if err != nil {
+		return err
+	}
+	return db.Delete(&models.League{}, "id = ?", id).Error
+}
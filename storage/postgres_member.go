@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (r *postgresRepository) SaveFranchiseMember(ctx context.Context, member *models.FranchiseMember) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "franchise_id"}, {Name: "user_id"}},
+		DoUpdates: append(clause.AssignmentColumns([]string{"user_name", "role"}),
+			clause.Assignment{Column: clause.Column{Name: "updated_at"}, Value: gorm.Expr("now()")}),
+	}).Create(member).Error
+}
+
+func (r *postgresRepository) RemoveFranchiseMember(ctx context.Context, franchiseID uuid.UUID, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&models.FranchiseMember{}, "franchise_id = ? AND user_id = ?", franchiseID, userID).Error
+}
+
+func (r *postgresRepository) FindFranchiseMember(ctx context.Context, franchiseID uuid.UUID, userID uuid.UUID) (*models.FranchiseMember, error) {
+	var member models.FranchiseMember
+	if err := r.db.WithContext(ctx).First(&member, "franchise_id = ? AND user_id = ?", franchiseID, userID).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &member, nil
+}
+
+func (r *postgresRepository) ListFranchiseMembers(ctx context.Context, franchiseID uuid.UUID) ([]models.FranchiseMember, error) {
+	var members []models.FranchiseMember
+	err := r.db.WithContext(ctx).Order("created_at, id").Find(&members, "franchise_id = ?", franchiseID).Error
+	return members, err
+}
+
+func (r *postgresRepository) ListMemberFranchises(ctx context.Context, userID uuid.UUID) ([]models.Franchise, error) {
+	var franchises []models.Franchise
+	err := r.db.WithContext(ctx).
+		Joins("JOIN franchise_members ON franchise_members.franchise_id = franchises.id").
+		Where("franchise_members.user_id = ?", userID).
+		Order("franchises.created_at, franchises.id").
+		Find(&franchises).Error
+	return franchises, err
+}
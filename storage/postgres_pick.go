@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (r *postgresRepository) CreatePick(ctx context.Context, pick *models.Pick) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Create(pick).Error
+}
+
+func (r *postgresRepository) SavePick(ctx context.Context, pick *models.Pick) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Save(pick).Error
+}
+
+func (r *postgresRepository) DeletePick(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&models.Pick{}, "id = ?", id).Error
+}
+
+func (r *postgresRepository) FindPick(ctx context.Context, id uuid.UUID) (*models.Pick, error) {
+	var pick models.Pick
+	if err := r.db.WithContext(ctx).First(&pick, "id = ?", id).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &pick, nil
+}
+
+func (r *postgresRepository) FindOriginalPicks(ctx context.Context, originID uuid.UUID, year int, round int) ([]models.Pick, error) {
+	var picks []models.Pick
+	err := r.db.WithContext(ctx).Where("origin_id = ? AND draft_year = ? AND draft_round = ? AND NOT supplemental", originID, year, round).Find(&picks).Error
+	return picks, err
+}
+
+func (r *postgresRepository) ListPicks(ctx context.Context, filter PickFilter, page Page) ([]models.Pick, string, error) {
+	var picks []models.Pick
+	paginate, err := Paginate("picks", pickOrdering, page)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := r.db.WithContext(ctx).Scopes(pickFilter(filter), paginate).Find(&picks).Error; err != nil {
+		return nil, "", err
+	}
+	picks, next := Trim(pickOrdering, page, picks, pickKey)
+	return picks, next, nil
+}
+
+// pickFilter restricts a pick query to the filter
+func pickFilter(f PickFilter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if f.OwnerID != nil {
+			db = db.Where("picks.owner_id = ?", *f.OwnerID)
+		}
+		if f.OriginID != nil {
+			db = db.Where("picks.origin_id = ?", *f.OriginID)
+		}
+		if f.DraftYear != 0 {
+			db = db.Where("picks.draft_year = ?", f.DraftYear)
+		}
+		if f.Drafted != nil {
+			if *f.Drafted {
+				db = db.Where("picks.prospect_id IS NOT NULL")
+			} else {
+				db = db.Where("picks.prospect_id IS NULL")
+			}
+		}
+		return db
+	}
+}
+
+func (r *postgresRepository) TransferPicks(ctx context.Context, from *models.Franchise, to *models.Franchise) error {
+	return r.db.WithContext(ctx).Model(&models.Pick{}).Where("owner_id = ?", from.ID).
+		Updates(map[string]interface{}{"owner_id": to.ID, "owner_name": to.Name, "last_owner_id": from.ID, "last_owner_name": from.Name}).Error
+}
@@ -0,0 +1,192 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// prospectSearchVector is the document searched by SearchProspects, Dial indexes the same expression
+const prospectSearchVector = "to_tsvector('simple', prospects.full_name || ' ' || prospects.nhl_team || ' ' || prospects.position_code)"
+
+func (r *postgresRepository) CreateProspects(ctx context.Context, prospects []models.Prospect) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Create(&prospects).Error
+}
+
+func (r *postgresRepository) SaveProspect(ctx context.Context, prospect *models.Prospect) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Save(prospect).Error
+}
+
+func (r *postgresRepository) FindProspect(ctx context.Context, id uuid.UUID) (*models.Prospect, error) {
+	var prospect models.Prospect
+	if err := r.db.WithContext(ctx).Preload("Pick").First(&prospect, "id = ?", id).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &prospect, nil
+}
+
+func (r *postgresRepository) FindProspectByIdentity(ctx context.Context, identity ProspectIdentity) (*models.Prospect, error) {
+	var prospect models.Prospect
+	findProspect := r.db.WithContext(ctx).
+		Where("full_name = ? AND birthdate IS NOT DISTINCT FROM ? AND nhl_draft_year = ? AND nhl_draft_pick_overall = ?", identity.FullName, identity.Birthdate, identity.NhlDraftYear, identity.NhlDraftPickOverall).
+		First(&prospect)
+	if findProspect.Error != nil {
+		return nil, notFound(findProspect.Error)
+	}
+	return &prospect, nil
+}
+
+func (r *postgresRepository) ListProspects(ctx context.Context, filter ProspectFilter, page Page) ([]models.Prospect, string, error) {
+	var prospects []models.Prospect
+	paginate, err := Paginate("prospects", prospectOrdering, page)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := r.db.WithContext(ctx).Preload("Pick").Scopes(prospectFilter(filter), paginate).Find(&prospects).Error; err != nil {
+		return nil, "", err
+	}
+	prospects, next := Trim(prospectOrdering, page, prospects, prospectKey)
+	return prospects, next, nil
+}
+
+func (r *postgresRepository) SearchProspects(ctx context.Context, search ProspectSearch) ([]models.Prospect, error) {
+	var prospects []models.Prospect
+
+	findProspects := r.db.WithContext(ctx).Joins("Pick").Scopes(prospectFilter(search.Filter))
+
+	if search.AvailableInLeague != nil {
+		findProspects = findProspects.Where("prospects.league_id IS DISTINCT FROM ?", *search.AvailableInLeague)
+	}
+
+	// full text prefix matches on name, nhl team and position, trigram similarity on the name for misspellings
+	rank := "similarity(prospects.full_name, ?)"
+	rankVars := []interface{}{search.Text}
+	if query := prefixQuery(search.Text); query != "" {
+		findProspects = findProspects.Where(fmt.Sprintf("%s @@ to_tsquery('simple', ?) OR prospects.full_name %% ?", prospectSearchVector), query, search.Text)
+		rank = fmt.Sprintf("ts_rank(%s, to_tsquery('simple', ?)) + %s", prospectSearchVector, rank)
+		rankVars = append([]interface{}{query}, rankVars...)
+	} else {
+		findProspects = findProspects.Where("prospects.full_name % ?", search.Text)
+	}
+
+	err := findProspects.Clauses(clause.OrderBy{
+		Expression: clause.Expr{SQL: rank + " DESC, prospects.id", Vars: rankVars, WithoutParentheses: true},
+	}).Limit(Page{Size: search.Limit}.Limit()).Find(&prospects).Error
+	return prospects, err
+}
+
+// prospectFilter restricts a prospect query to the filter
+func prospectFilter(f ProspectFilter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if f.FranchiseID != nil {
+			db = db.Where("prospects.franchise_id = ?", *f.FranchiseID)
+		}
+		if f.PositionCode != "" {
+			db = db.Where("prospects.position_code = ?", f.PositionCode)
+		}
+		if f.NhlTeam != "" {
+			db = db.Where("prospects.nhl_team = ?", f.NhlTeam)
+		}
+		if f.NhlDraftYear != 0 {
+			db = db.Where("prospects.nhl_draft_year = ?", f.NhlDraftYear)
+		}
+		if f.DraftYear != 0 {
+			db = db.Where("EXISTS (SELECT 1 FROM picks WHERE picks.prospect_id = prospects.id AND picks.draft_year = ?)", f.DraftYear)
+		}
+		if f.Drafted != nil {
+			if *f.Drafted {
+				db = db.Where("EXISTS (SELECT 1 FROM picks WHERE picks.prospect_id = prospects.id)")
+			} else {
+				db = db.Where("NOT EXISTS (SELECT 1 FROM picks WHERE picks.prospect_id = prospects.id)")
+			}
+		}
+		if f.Protected != nil {
+			db = db.Where("prospects.protected = ?", *f.Protected)
+		}
+		if r := f.NhlDraftYears; r != nil {
+			if r.Undrafted {
+				db = db.Where("prospects.nhl_draft_year = 0 OR prospects.nhl_draft_year BETWEEN ? AND ?", r.From, r.To)
+			} else {
+				db = db.Where("prospects.nhl_draft_year BETWEEN ? AND ?", r.From, r.To)
+			}
+		}
+		if len(f.Positions) > 0 {
+			db = db.Where("prospects.position_code IN ?", f.Positions)
+		}
+		if f.BornAfter != nil {
+			db = db.Where("prospects.birthdate IS NULL OR prospects.birthdate > ?", f.BornAfter.Format("2006-01-02"))
+		}
+		return db
+	}
+}
+
+// prefixQuery turns free text into a tsquery that prefix matches every word, e.g. "con mcdav" -> "con:* & mcdav:*".
+// Everything except letters and digits is dropped, so the user input can never break the tsquery syntax.
+func prefixQuery(text string) string {
+	terms := searchTerms(text)
+	for i, t := range terms {
+		terms[i] = t + ":*"
+	}
+	return strings.Join(terms, " & ")
+}
+
+// searchTerms splits text into lower case words of letters and digits.
+func searchTerms(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func (r *postgresRepository) ReleaseProspects(ctx context.Context, franchiseID uuid.UUID) error {
+	db := r.db.WithContext(ctx)
+	released := db.Model(&models.Prospect{}).Select("id").Where("franchise_id = ?", franchiseID)
+	if err := db.Model(&models.Pick{}).Where("prospect_id IN (?)", released).Update("prospect_id", nil).Error; err != nil {
+		return err
+	}
+	return db.Model(&models.Prospect{}).Where("franchise_id = ?", franchiseID).
+		Updates(map[string]interface{}{"league_id": nil, "franchise_id": nil, "protected": false}).Error
+}
+
+func (r *postgresRepository) ReassignProspect(ctx context.Context, fromID uuid.UUID, toID uuid.UUID) error {
+	db := r.db.WithContext(ctx)
+	statements := []string{
+		"UPDATE picks SET prospect_id = @to, updated_at = now() WHERE prospect_id = @from",
+		`DELETE FROM prospect_season_stats s WHERE s.prospect_id = @from AND EXISTS (SELECT 1 FROM prospect_season_stats o
+			WHERE o.prospect_id = @to AND o.season = s.season AND lower(o.level) = lower(s.level) AND lower(o.team) = lower(s.team))`,
+		"UPDATE prospect_season_stats SET prospect_id = @to WHERE prospect_id = @from",
+		`DELETE FROM watchlist_entries w WHERE w.prospect_id = @from AND EXISTS (SELECT 1 FROM watchlist_entries o
+			WHERE o.prospect_id = @to AND o.user_id = w.user_id)`,
+		"UPDATE watchlist_entries SET prospect_id = @to WHERE prospect_id = @from",
+		"DELETE FROM trade_block_entries WHERE prospect_id = @from AND EXISTS (SELECT 1 FROM trade_block_entries WHERE prospect_id = @to)",
+		"UPDATE trade_block_entries SET prospect_id = @to WHERE prospect_id = @from",
+		`DELETE FROM prospect_rankings r WHERE r.prospect_id = @from AND EXISTS (SELECT 1 FROM prospect_rankings o
+			WHERE o.prospect_id = @to AND o.year = r.year AND o.source = r.source AND o.franchise_id IS NOT DISTINCT FROM r.franchise_id)`,
+		"UPDATE prospect_rankings SET prospect_id = @to WHERE prospect_id = @from",
+		`DELETE FROM prospect_status_changes c WHERE c.prospect_id = @from AND EXISTS (SELECT 1 FROM prospect_status_changes o
+			WHERE o.prospect_id = @to AND o.status = c.status AND o.effective_date = c.effective_date)`,
+		"UPDATE prospect_status_changes SET prospect_id = @to WHERE prospect_id = @from",
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement, map[string]interface{}{"from": fromID, "to": toID}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *postgresRepository) DeleteProspect(ctx context.Context, id uuid.UUID) error {
+	deleteProspect := r.db.WithContext(ctx).Delete(&models.Prospect{}, "id = ?", id)
+	if deleteProspect.Error != nil {
+		return deleteProspect.Error
+	}
+	if deleteProspect.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func (r *postgresRepository) ReplaceRanking(ctx context.Context, year int, source string, franchiseID *uuid.UUID, rankings []models.ProspectRanking) error {
+	db := r.db.WithContext(ctx)
+	if err := db.Where("year = ? AND source = ? AND franchise_id IS NOT DISTINCT FROM ?", year, source, franchiseID).Delete(&models.ProspectRanking{}).Error; err != nil {
+		return err
+	}
+	if len(rankings) == 0 {
+		return nil
+	}
+	for i := range rankings {
+		rankings[i].Year, rankings[i].Source, rankings[i].FranchiseID = year, source, franchiseID
+	}
+	return db.Create(&rankings).Error
+}
+
+func (r *postgresRepository) ListRankings(ctx context.Context, filter RankingFilter) ([]models.ProspectRanking, error) {
+	var rankings []models.ProspectRanking
+	db := r.db.WithContext(ctx)
+	if filter.Year != 0 {
+		db = db.Where("year = ?", filter.Year)
+	}
+	if filter.Source != "" {
+		db = db.Where("source = ?", filter.Source)
+	}
+	if filter.FranchiseID != nil {
+		db = db.Where("franchise_id = ?", *filter.FranchiseID)
+	}
+	err := db.Order("source, franchise_id, rank").Find(&rankings).Error
+	return rankings, err
+}
@@ -0,0 +1,30 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func (r *postgresRepository) CreateSeason(ctx context.Context, season *models.Season) error {
+	return r.db.WithContext(ctx).Create(season).Error
+}
+
+func (r *postgresRepository) SaveSeason(ctx context.Context, season *models.Season) error {
+	return r.db.WithContext(ctx).Save(season).Error
+}
+
+func (r *postgresRepository) FindActiveSeason(ctx context.Context, leagueID uuid.UUID) (*models.Season, error) {
+	var season models.Season
+	if err := r.db.WithContext(ctx).First(&season, "league_id = ? AND status = ?", leagueID, models.SeasonActive).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &season, nil
+}
+
+func (r *postgresRepository) ListSeasons(ctx context.Context, leagueID uuid.UUID) ([]models.Season, error) {
+	var seasons []models.Season
+	err := r.db.WithContext(ctx).Order("year, id").Find(&seasons, "league_id = ?", leagueID).Error
+	return seasons, err
+}
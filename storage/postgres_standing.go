@@ -0,0 +1,28 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func (r *postgresRepository) ReplaceStandings(ctx context.Context, leagueID uuid.UUID, year int, standings []models.Standing) error {
+	db := r.db.WithContext(ctx)
+	if err := db.Where("league_id = ? AND year = ?", leagueID, year).Delete(&models.Standing{}).Error; err != nil {
+		return err
+	}
+	if len(standings) == 0 {
+		return nil
+	}
+	for i := range standings {
+		standings[i].LeagueID, standings[i].Year = leagueID, year
+	}
+	return db.Create(&standings).Error
+}
+
+func (r *postgresRepository) ListStandings(ctx context.Context, leagueID uuid.UUID, year int) ([]models.Standing, error) {
+	var standings []models.Standing
+	err := r.db.WithContext(ctx).Where("league_id = ? AND year = ?", leagueID, year).Order("rank").Find(&standings).Error
+	return standings, err
+}
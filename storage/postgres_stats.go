@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func (r *postgresRepository) CreateProspectStats(ctx context.Context, stats *models.ProspectSeasonStats) error {
+	return r.db.WithContext(ctx).Create(stats).Error
+}
+
+func (r *postgresRepository) SaveProspectStats(ctx context.Context, stats *models.ProspectSeasonStats) error {
+	return r.db.WithContext(ctx).Save(stats).Error
+}
+
+func (r *postgresRepository) ListProspectStats(ctx context.Context, filter StatsFilter) ([]models.ProspectSeasonStats, error) {
+	var stats []models.ProspectSeasonStats
+	db := r.db.WithContext(ctx)
+	if filter.ProspectIDs != nil {
+		if len(filter.ProspectIDs) == 0 {
+			return stats, nil
+		}
+		db = db.Where("prospect_id IN ?", filter.ProspectIDs)
+	}
+	if filter.Season != 0 {
+		db = db.Where("season = ?", filter.Season)
+	}
+	err := db.Order("season, level, team, created_at").Find(&stats).Error
+	return stats, err
+}
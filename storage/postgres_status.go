@@ -0,0 +1,18 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func (r *postgresRepository) AddProspectStatus(ctx context.Context, change *models.ProspectStatusChange) error {
+	return r.db.WithContext(ctx).Create(change).Error
+}
+
+func (r *postgresRepository) ListProspectStatuses(ctx context.Context, prospectID uuid.UUID) ([]models.ProspectStatusChange, error) {
+	var changes []models.ProspectStatusChange
+	err := r.db.WithContext(ctx).Where("prospect_id = ?", prospectID).Order("effective_date, created_at").Find(&changes).Error
+	return changes, err
+}
@@ -0,0 +1,34 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func (r *postgresRepository) CreatePickSwap(ctx context.Context, swap *models.PickSwap) error {
+	return r.db.WithContext(ctx).Create(swap).Error
+}
+
+func (r *postgresRepository) SavePickSwap(ctx context.Context, swap *models.PickSwap) error {
+	return r.db.WithContext(ctx).Save(swap).Error
+}
+
+func (r *postgresRepository) ListPickSwaps(ctx context.Context, filter SwapFilter) ([]models.PickSwap, error) {
+	var swaps []models.PickSwap
+	db := r.db.WithContext(ctx)
+	if filter.LeagueID != nil {
+		db = db.Where("league_id = ?", *filter.LeagueID)
+	}
+	if filter.PickIDs != nil {
+		if len(filter.PickIDs) == 0 {
+			return swaps, nil
+		}
+		db = db.Where("holder_pick_id IN ? OR grantor_pick_id IN ?", filter.PickIDs, filter.PickIDs)
+	}
+	if filter.Status != "" {
+		db = db.Where("status = ?", filter.Status)
+	}
+	err := db.Order("created_at, id").Find(&swaps).Error
+	return swaps, err
+}
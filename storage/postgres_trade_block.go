@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+	"gorm.io/gorm"
+)
+
+func (r *postgresRepository) SaveTradeBlockEntry(ctx context.Context, entry *models.TradeBlockEntry) error {
+	assetID := entry.AssetID()
+	var existing models.TradeBlockEntry
+	err := r.db.WithContext(ctx).First(&existing, "prospect_id = ? OR pick_id = ?", assetID, assetID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return r.db.WithContext(ctx).Create(entry).Error
+	}
+	if err != nil {
+		return err
+	}
+	entry.ID = existing.ID
+	entry.CreatedAt = existing.CreatedAt
+	return r.db.WithContext(ctx).Save(entry).Error
+}
+
+func (r *postgresRepository) RemoveTradeBlockEntries(ctx context.Context, filter TradeBlockFilter) error {
+	return r.db.WithContext(ctx).Scopes(tradeBlockFilter(filter)).Delete(&models.TradeBlockEntry{}).Error
+}
+
+func (r *postgresRepository) ListTradeBlock(ctx context.Context, filter TradeBlockFilter) ([]models.TradeBlockEntry, error) {
+	var entries []models.TradeBlockEntry
+	err := r.db.WithContext(ctx).Scopes(tradeBlockFilter(filter)).Order("created_at, id").Find(&entries).Error
+	return entries, err
+}
+
+// tradeBlockFilter restricts a trade block query to the filter
+func tradeBlockFilter(f TradeBlockFilter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if f.LeagueID != nil {
+			db = db.Where("league_id = ?", *f.LeagueID)
+		}
+		if f.FranchiseID != nil {
+			db = db.Where("franchise_id = ?", *f.FranchiseID)
+		}
+		if f.AssetID != nil {
+			db = db.Where("prospect_id = ? OR pick_id = ?", *f.AssetID, *f.AssetID)
+		}
+		return db
+	}
+}
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+func (r *postgresRepository) CreateWatchlistEntry(ctx context.Context, entry *models.WatchlistEntry) error {
+	return r.db.WithContext(ctx).Create(entry).Error
+}
+
+func (r *postgresRepository) SaveWatchlistEntry(ctx context.Context, entry *models.WatchlistEntry) error {
+	return r.db.WithContext(ctx).Save(entry).Error
+}
+
+func (r *postgresRepository) FindWatchlistEntry(ctx context.Context, userID uuid.UUID, prospectID uuid.UUID) (*models.WatchlistEntry, error) {
+	var entry models.WatchlistEntry
+	if err := r.db.WithContext(ctx).First(&entry, "user_id = ? AND prospect_id = ?", userID, prospectID).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &entry, nil
+}
+
+func (r *postgresRepository) DeleteWatchlistEntry(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&models.WatchlistEntry{}, "id = ?", id).Error
+}
+
+func (r *postgresRepository) ListWatchlist(ctx context.Context, filter WatchlistFilter) ([]models.WatchlistEntry, error) {
+	var entries []models.WatchlistEntry
+	db := r.db.WithContext(ctx).Where("user_id = ?", filter.UserID)
+	if filter.ProspectIDs != nil {
+		if len(filter.ProspectIDs) == 0 {
+			return entries, nil
+		}
+		db = db.Where("prospect_id IN ?", filter.ProspectIDs)
+	}
+	if filter.Tag != "" {
+		db = db.Where("tags @> ?", models.Tags{filter.Tag})
+	}
+	err := db.Order("rank NULLS LAST, created_at, id").Find(&entries).Error
+	return entries, err
+}
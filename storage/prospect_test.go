@@ -0,0 +1,31 @@
+package storage
+
+import "testing"
+
+func TestPrefixQuery(t *testing.T) {
+	cases := map[string]string{
+		"Connor McDavid":      "connor:* & mcdavid:*",
+		"  con   mcdav ":      "con:* & mcdav:*",
+		"o'reilly":            "o:* & reilly:*",
+		"'); DROP TABLE x;--": "drop:* & table:* & x:*",
+		"Stützle":             "stützle:*",
+		"!&|:*":               "",
+	}
+	for text, expected := range cases {
+		if actual := prefixQuery(text); actual != expected {
+			t.Errorf("prefixQuery(%q) = %q, expected %q", text, actual, expected)
+		}
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	if s := Similarity("Connor McDavid", "Connor McDavid"); s != 1 {
+		t.Errorf("Similarity of equal names %v not equal to expected %v", s, 1)
+	}
+	if s := Similarity("Connor McDavid", "Conor McDavit"); s < minSimilarity {
+		t.Errorf("Similarity %v of a misspelled name below threshold %v", s, minSimilarity)
+	}
+	if s := Similarity("Connor McDavid", "Auston Matthews"); s >= minSimilarity {
+		t.Errorf("Similarity %v of different names above threshold %v", s, minSimilarity)
+	}
+}
@@ -0,0 +1,285 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hiltpold/lakelandcup-fantasy-service/models"
+)
+
+// ErrNotFound is returned when a looked up record does not exist.
+var ErrNotFound = errors.New("record not found")
+
+// ErrInvalidPage is returned for malformed page tokens or unknown sort fields.
+var ErrInvalidPage = errors.New("invalid page request")
+
+// Repository is the storage of the fantasy service. Dial returns the postgres
+// implementation, NewMemoryRepository one that keeps everything in memory.
+type Repository interface {
+	LeagueStore
+	FranchiseStore
+	ProspectStore
+	PickStore
+	MemberStore
+	InvitationStore
+	SeasonStore
+	ConditionStore
+	SwapStore
+	TradeBlockStore
+	WatchlistStore
+	StatsStore
+	StatusStore
+	RankingStore
+	StandingStore
+	// Transaction runs fn with a repository whose changes are only committed if fn returns nil.
+	Transaction(ctx context.Context, fn func(tx Repository) error) error
+}
+
+type LeagueStore interface {
+	CreateLeague(ctx context.Context, league *models.League) error
+	SaveLeague(ctx context.Context, league *models.League) error
+	// FindLeague returns the league with its franchises.
+	FindLeague(ctx context.Context, id uuid.UUID) (*models.League, error)
+	// FindLeagueByName returns the league with the name that is not archived.
+	FindLeagueByName(ctx context.Context, name string) (*models.League, error)
+	// DeleteLeague deletes the league with its franchises, their picks, members and
+	// invitations. Its prospects return to the pool.
+	DeleteLeague(ctx context.Context, id uuid.UUID) error
+	// ListLeagues returns a page of leagues with their franchises and the token of the next page.
+	ListLeagues(ctx context.Context, page Page) ([]models.League, string, error)
+}
+
+// FranchiseStore never returns deleted franchises.
+type FranchiseStore interface {
+	CreateFranchise(ctx context.Context, franchise *models.Franchise) error
+	SaveFranchise(ctx context.Context, franchise *models.Franchise) error
+	// DeleteFranchise soft deletes the franchise.
+	DeleteFranchise(ctx context.Context, id uuid.UUID) error
+	// FindFranchise returns the franchise with its prospects ordered by name.
+	FindFranchise(ctx context.Context, id uuid.UUID) (*models.Franchise, error)
+	FindFranchiseByName(ctx context.Context, leagueID uuid.UUID, name string) (*models.Franchise, error)
+	// FindOrphanFranchise returns the franchise holding the picks of the league's deleted franchises.
+	FindOrphanFranchise(ctx context.Context, leagueID uuid.UUID) (*models.Franchise, error)
+	// CountFranchises counts the league's franchises without the orphan franchise.
+	CountFranchises(ctx context.Context, leagueID uuid.UUID) (int64, error)
+	// ListFranchises returns a page of the league's franchises with their prospects ordered by name.
+	ListFranchises(ctx context.Context, leagueID uuid.UUID, page Page) ([]models.Franchise, string, error)
+	AddFranchiseHistory(ctx context.Context, history *models.FranchiseHistory) error
+	// ListFranchiseHistory returns the changes of a franchise, oldest first.
+	ListFranchiseHistory(ctx context.Context, franchiseID uuid.UUID) ([]models.FranchiseHistory, error)
+}
+
+type ProspectStore interface {
+	CreateProspects(ctx context.Context, prospects []models.Prospect) error
+	SaveProspect(ctx context.Context, prospect *models.Prospect) error
+	// FindProspect returns the prospect with its pick.
+	FindProspect(ctx context.Context, id uuid.UUID) (*models.Prospect, error)
+	FindProspectByIdentity(ctx context.Context, identity ProspectIdentity) (*models.Prospect, error)
+	// ReleaseProspects returns the franchise's prospects to the pool, the picks they were drafted with are freed.
+	ReleaseProspects(ctx context.Context, franchiseID uuid.UUID) error
+	// ReassignProspect moves the picks, stats, watchlist entries, trade block listings, rankings and status history of the
+	// prospect to the other one. Rows the other prospect already has for the same season line, user, listing, ranking or
+	// status change are dropped.
+	ReassignProspect(ctx context.Context, fromID uuid.UUID, toID uuid.UUID) error
+	DeleteProspect(ctx context.Context, id uuid.UUID) error
+	// ListProspects returns a page of prospects with their picks.
+	ListProspects(ctx context.Context, filter ProspectFilter, page Page) ([]models.Prospect, string, error)
+	// SearchProspects returns the prospects matching the search, best matches first.
+	SearchProspects(ctx context.Context, search ProspectSearch) ([]models.Prospect, error)
+}
+
+type PickStore interface {
+	CreatePick(ctx context.Context, pick *models.Pick) error
+	SavePick(ctx context.Context, pick *models.Pick) error
+	DeletePick(ctx context.Context, id uuid.UUID) error
+	FindPick(ctx context.Context, id uuid.UUID) (*models.Pick, error)
+	// FindOriginalPicks returns the picks a franchise originally held in a draft round, supplemental picks are not original.
+	FindOriginalPicks(ctx context.Context, originID uuid.UUID, year int, round int) ([]models.Pick, error)
+	// TransferPicks moves all picks owned by a franchise to another one.
+	TransferPicks(ctx context.Context, from *models.Franchise, to *models.Franchise) error
+	ListPicks(ctx context.Context, filter PickFilter, page Page) ([]models.Pick, string, error)
+}
+
+type MemberStore interface {
+	// SaveFranchiseMember adds the member or updates its role.
+	SaveFranchiseMember(ctx context.Context, member *models.FranchiseMember) error
+	RemoveFranchiseMember(ctx context.Context, franchiseID uuid.UUID, userID uuid.UUID) error
+	FindFranchiseMember(ctx context.Context, franchiseID uuid.UUID, userID uuid.UUID) (*models.FranchiseMember, error)
+	// ListFranchiseMembers returns the members of a franchise, oldest first.
+	ListFranchiseMembers(ctx context.Context, franchiseID uuid.UUID) ([]models.FranchiseMember, error)
+	// ListMemberFranchises returns the franchises the user is a member of, oldest first.
+	ListMemberFranchises(ctx context.Context, userID uuid.UUID) ([]models.Franchise, error)
+}
+
+type InvitationStore interface {
+	CreateInvitation(ctx context.Context, invitation *models.Invitation) error
+	SaveInvitation(ctx context.Context, invitation *models.Invitation) error
+	FindInvitation(ctx context.Context, id uuid.UUID) (*models.Invitation, error)
+	// CountOpenInvitations counts the league's invitations that are neither claimed, revoked nor expired at now.
+	CountOpenInvitations(ctx context.Context, leagueID uuid.UUID, now time.Time) (int64, error)
+}
+
+type SeasonStore interface {
+	CreateSeason(ctx context.Context, season *models.Season) error
+	SaveSeason(ctx context.Context, season *models.Season) error
+	// FindActiveSeason returns the league's active season.
+	FindActiveSeason(ctx context.Context, leagueID uuid.UUID) (*models.Season, error)
+	// ListSeasons returns the league's seasons ordered by year.
+	ListSeasons(ctx context.Context, leagueID uuid.UUID) ([]models.Season, error)
+}
+
+type ConditionStore interface {
+	CreatePickCondition(ctx context.Context, condition *models.PickCondition) error
+	SavePickCondition(ctx context.Context, condition *models.PickCondition) error
+	// ListPickConditions returns the conditions matching the filter, oldest first.
+	ListPickConditions(ctx context.Context, filter ConditionFilter) ([]models.PickCondition, error)
+}
+
+type SwapStore interface {
+	CreatePickSwap(ctx context.Context, swap *models.PickSwap) error
+	SavePickSwap(ctx context.Context, swap *models.PickSwap) error
+	// ListPickSwaps returns the swaps matching the filter, oldest first.
+	ListPickSwaps(ctx context.Context, filter SwapFilter) ([]models.PickSwap, error)
+}
+
+type TradeBlockStore interface {
+	// SaveTradeBlockEntry lists the asset or updates the note of its listing.
+	SaveTradeBlockEntry(ctx context.Context, entry *models.TradeBlockEntry) error
+	// RemoveTradeBlockEntries removes the listings matching the filter.
+	RemoveTradeBlockEntries(ctx context.Context, filter TradeBlockFilter) error
+	// ListTradeBlock returns the listings matching the filter, oldest first.
+	ListTradeBlock(ctx context.Context, filter TradeBlockFilter) ([]models.TradeBlockEntry, error)
+}
+
+type WatchlistStore interface {
+	CreateWatchlistEntry(ctx context.Context, entry *models.WatchlistEntry) error
+	SaveWatchlistEntry(ctx context.Context, entry *models.WatchlistEntry) error
+	// FindWatchlistEntry returns the user's entry of the prospect.
+	FindWatchlistEntry(ctx context.Context, userID uuid.UUID, prospectID uuid.UUID) (*models.WatchlistEntry, error)
+	DeleteWatchlistEntry(ctx context.Context, id uuid.UUID) error
+	// ListWatchlist returns the entries matching the filter, ranked ones first by rank, then oldest first.
+	ListWatchlist(ctx context.Context, filter WatchlistFilter) ([]models.WatchlistEntry, error)
+}
+
+type StatsStore interface {
+	CreateProspectStats(ctx context.Context, stats *models.ProspectSeasonStats) error
+	SaveProspectStats(ctx context.Context, stats *models.ProspectSeasonStats) error
+	// ListProspectStats returns the stats matching the filter by season, level and team.
+	ListProspectStats(ctx context.Context, filter StatsFilter) ([]models.ProspectSeasonStats, error)
+}
+
+type StatusStore interface {
+	AddProspectStatus(ctx context.Context, change *models.ProspectStatusChange) error
+	// ListProspectStatuses returns the status history of the prospect by effective date.
+	ListProspectStatuses(ctx context.Context, prospectID uuid.UUID) ([]models.ProspectStatusChange, error)
+}
+
+type RankingStore interface {
+	// ReplaceRanking replaces the ranking of the source, or of the franchise if the source is "", for the year.
+	ReplaceRanking(ctx context.Context, year int, source string, franchiseID *uuid.UUID, rankings []models.ProspectRanking) error
+	// ListRankings returns the rankings matching the filter by source, franchise and rank.
+	ListRankings(ctx context.Context, filter RankingFilter) ([]models.ProspectRanking, error)
+}
+
+type StandingStore interface {
+	// ReplaceStandings replaces the league's standings of the season ending with the draft of the year.
+	ReplaceStandings(ctx context.Context, leagueID uuid.UUID, year int, standings []models.Standing) error
+	// ListStandings returns the league's standings of the year by rank.
+	ListStandings(ctx context.Context, leagueID uuid.UUID, year int) ([]models.Standing, error)
+}
+
+// ProspectIdentity are the attributes that identify a prospect on import.
+type ProspectIdentity struct {
+	FullName            string
+	Birthdate           *time.Time
+	NhlDraftYear        int
+	NhlDraftPickOverall int
+}
+
+// ProspectFilter restricts prospect lists, zero values don't filter.
+type ProspectFilter struct {
+	FranchiseID  *uuid.UUID
+	PositionCode models.Position
+	NhlTeam      string
+	NhlDraftYear int
+	// DraftYear is the year of the fantasy draft the prospect was picked in
+	DraftYear int
+	Drafted   *bool
+	Protected *bool
+	// NhlDraftYears restricts the nhl draft year to a range
+	NhlDraftYears *YearRange
+	// Positions matches any of the positions
+	Positions []models.Position
+	// BornAfter excludes prospects born on or before the date, unknown birthdates match
+	BornAfter *time.Time
+}
+
+// YearRange are the years From to To, Undrafted also matches prospects without nhl draft year.
+type YearRange struct {
+	From      int
+	To        int
+	Undrafted bool
+}
+
+// ProspectSearch is a free text search over name, nhl team and position.
+type ProspectSearch struct {
+	Text   string
+	Filter ProspectFilter
+	// AvailableInLeague only matches prospects not drafted in this league
+	AvailableInLeague *uuid.UUID
+	Limit             int
+}
+
+// PickFilter restricts pick lists, zero values don't filter.
+type PickFilter struct {
+	OwnerID   *uuid.UUID
+	OriginID  *uuid.UUID
+	DraftYear int
+	Drafted   *bool
+}
+
+// ConditionFilter restricts pick condition lists, zero values don't filter.
+type ConditionFilter struct {
+	LeagueID *uuid.UUID
+	// PickIDs match the conditional and the substitute picks
+	PickIDs []uuid.UUID
+	Status  models.ConditionStatus
+}
+
+// SwapFilter restricts pick swap lists, zero values don't filter.
+type SwapFilter struct {
+	LeagueID *uuid.UUID
+	// PickIDs match the picks of both franchises
+	PickIDs []uuid.UUID
+	Status  models.SwapStatus
+}
+
+// TradeBlockFilter restricts trade block listings, zero values don't filter.
+type TradeBlockFilter struct {
+	LeagueID    *uuid.UUID
+	FranchiseID *uuid.UUID
+	// AssetID matches the listed prospect or pick
+	AssetID *uuid.UUID
+}
+
+// WatchlistFilter restricts the watchlist of the user, zero values don't filter.
+type WatchlistFilter struct {
+	UserID      uuid.UUID
+	ProspectIDs []uuid.UUID
+	Tag         string
+}
+
+// RankingFilter restricts rankings, zero values don't filter.
+type RankingFilter struct {
+	Year        int
+	Source      string
+	FranchiseID *uuid.UUID
+}
+
+// StatsFilter restricts prospect stats, zero values don't filter.
+type StatsFilter struct {
+	ProspectIDs []uuid.UUID
+	Season      int
+}
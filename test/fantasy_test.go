@@ -7,25 +7,29 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
-	"github.com/hiltpold/lakelandcup-fantasy-service/conf"
+	"github.com/hiltpold/lakelandcup-fantasy-service/importer"
 	"github.com/hiltpold/lakelandcup-fantasy-service/models"
 	"github.com/hiltpold/lakelandcup-fantasy-service/service"
 	"github.com/hiltpold/lakelandcup-fantasy-service/service/pb"
 	"github.com/hiltpold/lakelandcup-fantasy-service/storage"
+	"github.com/hiltpold/lakelandcup-fantasy-service/utils"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/test/bufconn"
-	"gorm.io/gorm"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const bufSize = 1024 * 1024
-const testConfig = ".test.env"
 
 var lis *bufconn.Listener
-var db *gorm.DB
+var server *service.Server
 var client pb.FantasyServiceClient
 var ctx context.Context
 var conn *grpc.ClientConn
@@ -53,16 +57,14 @@ func bufDialer(context.Context, string) (net.Conn, error) {
 	return lis.Dial()
 }
 
-func setupServer(c *conf.Configuration) {
-	h := storage.Dial(&c.DB)
-	db = h.DB
-
+func setupServer() {
 	lis = bufconn.Listen(bufSize)
-	s := service.Server{
-		R: h,
+	server = &service.Server{
+		R:   storage.NewMemoryRepository(),
+		Jwt: &utils.JwtWrapper{TokenKey: "test", ExpirationHours: 1},
 	}
 	grpcServer := grpc.NewServer()
-	pb.RegisterFantasyServiceServer(grpcServer, &s)
+	pb.RegisterFantasyServiceServer(grpcServer, server)
 	go func() {
 		if err := grpcServer.Serve(lis); err != nil {
 			log.Fatalf("Server exited with error: %v", err)
@@ -81,14 +83,15 @@ func setupClient() (pb.FantasyServiceClient, context.Context, *grpc.ClientConn)
 }
 
 func setup() (pb.FantasyServiceClient, context.Context, *grpc.ClientConn) {
-	c, err := conf.LoadConfig(testConfig)
-	if err != nil {
-		logrus.Fatal(fmt.Sprintf("Failed to load config %s.", testConfig), err)
-	}
-	setupServer(c)
+	setupServer()
 	return setupClient()
 }
 
+// cleanUp drops everything the test created
+func cleanUp() {
+	server.R = storage.NewMemoryRepository()
+}
+
 func TestMain(m *testing.M) {
 	client, ctx, conn = setup()
 	exitVal := m.Run()
@@ -103,7 +106,7 @@ func createLeague(userId string, leagueName string, foundationYear string, maxFr
 }
 
 func createFranchise(leagueId string, franchiseOwner string, franchiseName string, foundationYear string) (*pb.FranchiseResponse, error) {
-	req := pb.FranchiseRequest{LeagueId: leagueId, OwnerName: franchiseOwner, Name: franchiseName, FoundationYear: foundationYear}
+	req := pb.FranchiseRequest{LeagueId: leagueId, OwnerID: franchiseOwner, OwnerName: franchiseOwner, Name: franchiseName, FoundationYear: foundationYear, UserId: userId}
 	resp, err := client.CreateFranchise(ctx, &req)
 	return resp, err
 }
@@ -111,6 +114,10 @@ func createFranchise(leagueId string, franchiseOwner string, franchiseName strin
 // League
 
 func TestLeagueCreation(t *testing.T) {
+	if resp, err := createLeague("no-uuid", leagueName, foundationYear, maxFranchises, maxProspects, draftRightsGoalie, draftRightsSkater); err != nil || resp.Status != http.StatusBadRequest {
+		t.Errorf("League creation with an invalid commissioner id not rejected: %v %v", err, resp)
+	}
+
 	resp, err := createLeague(userId, leagueName, foundationYear, maxFranchises, maxProspects, draftRightsGoalie, draftRightsSkater)
 	if err != nil {
 		t.Errorf("League creation failed: %v", err)
@@ -172,8 +179,7 @@ func TestLeagueCreation(t *testing.T) {
 	}
 
 	// Clean up
-	db.Where("league_founder = ?", userId).Delete(&models.League{})
-	db.Where("league_founder = ?", userId2).Delete(&models.League{})
+	cleanUp()
 }
 
 func TestFranchiseCreation(t *testing.T) {
@@ -274,8 +280,7 @@ func TestFranchiseCreation(t *testing.T) {
 	}
 
 	// Clean up
-	db.Where("franchise_owner = ?", userId).Delete(&models.Franchise{})
-	db.Where("league_founder = ?", userId).Delete(&models.League{})
+	cleanUp()
 
 }
 
@@ -322,8 +327,8 @@ func TestGetLeague(t *testing.T) {
 		t.Errorf("Output %q not equal to expected %q", resp.Result.ID, lResp.LeagueId)
 	}
 
-	db.Where("franchise_owner = ?", userId).Delete(&models.Franchise{})
-	db.Where("league_founder = ?", userId).Delete(&models.League{})
+	// Clean up
+	cleanUp()
 }
 
 func TestGetLeagueFranchisePairs(t *testing.T) {
@@ -339,18 +344,6 @@ func TestGetLeagueFranchisePairs(t *testing.T) {
 	t.Logf("%+v", lResp)
 	t.Log("-----------------------")
 
-	// Create league 2
-	lResp2, lErr2 := createLeague(userId2, leagueName2, foundationYear, maxFranchises2, maxProspects, draftRightsGoalie, draftRightsSkater)
-	if lErr2 != nil {
-		t.Errorf("League creation failed: %v", lErr2)
-	}
-
-	// Log result
-	t.Log("-----------------------")
-	t.Log("Create League Response:")
-	t.Logf("%+v", lResp2)
-	t.Log("-----------------------")
-
 	// Create franchise 1 in league 1
 	fResp, fErr := createFranchise(lResp.LeagueId, userId, franchiseName, franchiseFoundationYear)
 	if fErr != nil {
@@ -410,7 +403,7 @@ func TestGetLeagueFranchisePairs(t *testing.T) {
 	t.Logf("%+v", fResp3)
 	t.Log("----------------------------")
 
-	// Get leagues for user 1
+	// Get leagues for user 1, one pair per franchise
 	result2, lfErr2 := client.GetLeagueFranchisePairs(ctx, &getLeaguesForUserReq)
 	if lfErr2 != nil {
 		t.Fatalf("Get all leagues failed: %v", lfErr2)
@@ -419,10 +412,14 @@ func TestGetLeagueFranchisePairs(t *testing.T) {
 	log.Printf("Get Leagues Response: %v", result2.Result)
 	t.Log("---------------------------------------------")
 
+	if len(result2.Result) != 2 || result2.Result[1].FranchiseID != fResp3.FranchiseId {
+		t.Errorf("Pairs %v do not contain franchise %q", result2.Result, fResp3.FranchiseId)
+	}
+
 	// Get leagues for user 2
 	getLeaguesForUserReq2 := pb.GetLeagueFranchisePairsRequest{UserId: userId2}
 	result3, lfErr3 := client.GetLeagueFranchisePairs(ctx, &getLeaguesForUserReq2)
-	if lfErr2 != nil {
+	if lfErr3 != nil {
 		t.Fatalf("Get all leagues failed: %v", lfErr3)
 	}
 	t.Log("---------------------------------------------")
@@ -432,22 +429,34 @@ func TestGetLeagueFranchisePairs(t *testing.T) {
 	if result3.Status != http.StatusAccepted {
 		t.Errorf("Http Status %d not equal to expected status %d", result3.Status, http.StatusAccepted)
 	}
-	actualLeagueId3 := result3.Result[1].LeagueID
-	actualFranchiseId3 := result3.Result[1].FranchiseID
+	if len(result3.Result) != 1 || result3.Result[0].FranchiseID != fResp2.FranchiseId {
+		t.Errorf("Pairs %v not equal to expected franchise %q", result3.Result, fResp2.FranchiseId)
+	}
 
-	if actualLeagueId3 != lResp2.LeagueId {
-		t.Errorf("LeagueId %q not equal to expected %q", actualLeagueId3, lResp2.LeagueId)
+	// Members are paired with the franchise as well
+	mResp, mErr := client.AddFranchiseMember(ctx, &pb.FranchiseMemberRequest{FranchiseID: fResp.FranchiseId, MemberID: userId2, MemberName: "TestUser2", Role: string(models.AssistantGM), UserId: userId})
+	if mErr != nil || mResp.Status != http.StatusOK {
+		t.Fatalf("Adding member failed: %v %v", mErr, mResp)
+	}
+	result4, lfErr4 := client.GetLeagueFranchisePairs(ctx, &getLeaguesForUserReq2)
+	if lfErr4 != nil {
+		t.Fatalf("Get all leagues failed: %v", lfErr4)
+	}
+	if len(result4.Result) != 2 || result4.Result[0].FranchiseID != fResp.FranchiseId {
+		t.Errorf("Pairs %v do not contain franchise %q", result4.Result, fResp.FranchiseId)
 	}
 
-	if actualFranchiseId3 != "" {
-		t.Errorf("FranchiseId %q not equal to expected %q", actualFranchiseId3, "")
+	// A user without franchises gets the league without a franchise
+	result5, lfErr5 := client.GetLeagueFranchisePairs(ctx, &pb.GetLeagueFranchisePairsRequest{UserId: uuid.NewString()})
+	if lfErr5 != nil {
+		t.Fatalf("Get all leagues failed: %v", lfErr5)
+	}
+	if len(result5.Result) != 1 || result5.Result[0].LeagueID != lResp.LeagueId || result5.Result[0].FranchiseID != "" {
+		t.Errorf("Pairs %v not equal to expected league %q without franchise", result5.Result, lResp.LeagueId)
 	}
 
 	// Clean up
-	db.Where("franchise_owner = ?", userId).Delete(&models.Franchise{})
-	db.Where("franchise_owner = ?", userId2).Delete(&models.Franchise{})
-	db.Where("league_founder = ?", userId).Delete(&models.League{})
-	db.Where("league_founder = ?", userId2).Delete(&models.League{})
+	cleanUp()
 
 }
 
@@ -496,8 +505,1960 @@ func TestGetFranchise(t *testing.T) {
 		t.Errorf("Output %q not equal to expected %q", resp.Result.ID, fResp.FranchiseId)
 	}
 
-	db.Where("franchise_owner = ?", userId).Delete(&models.Franchise{})
-	db.Where("league_founder = ?", userId).Delete(&models.League{})
+	// Clean up
+	cleanUp()
+}
+
+func TestFranchiseLifecycle(t *testing.T) {
+	lResp, err := createLeague(userId, leagueName, foundationYear, maxFranchises2, maxProspects, draftRightsGoalie, draftRightsSkater)
+	if err != nil {
+		t.Fatalf("League creation failed: %v", err)
+	}
+	fResp, err := createFranchise(lResp.LeagueId, userId, franchiseName, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	fId := fResp.FranchiseId
+
+	// Rename
+	uResp, err := client.UpdateFranchise(ctx, &pb.FranchiseUpdateRequest{Id: fId, Name: franchiseName2, UserId: userId})
+	if err != nil {
+		t.Fatalf("Update franchise failed: %v", err)
+	}
+	if uResp.Status != http.StatusOK {
+		t.Errorf("Http Status %d not equal to expected status %d: %s", uResp.Status, http.StatusOK, uResp.Error)
+	}
+
+	// Transfer to user 2
+	tResp, err := client.TransferFranchiseOwnership(ctx, &pb.TransferFranchiseRequest{Id: fId, OwnerID: userId2, OwnerName: "TestUser2", UserId: userId})
+	if err != nil {
+		t.Fatalf("Transfer franchise failed: %v", err)
+	}
+	if tResp.Status != http.StatusOK {
+		t.Errorf("Http Status %d not equal to expected status %d: %s", tResp.Status, http.StatusOK, tResp.Error)
+	}
+
+	getResp, err := client.GetFranchise(ctx, &pb.GetFranchiseRequest{FranchiseID: fId})
+	if err != nil {
+		t.Fatalf("Get franchise failed: %v", err)
+	}
+	if getResp.Result.Name != franchiseName2 || getResp.Result.OwnerID != userId2 {
+		t.Errorf("Franchise %q of %q not equal to expected %q of %q", getResp.Result.Name, getResp.Result.OwnerID, franchiseName2, userId2)
+	}
+
+	// Give the franchise a pick and draft a prospect with it
+	pReq := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId, Picks: []*pb.CreateOrUpdatePick{{Franchise: franchiseName2, FranchiseID: fId, DraftYear: 2023, LotteryPosition: 1}}}
+	if resp, err := client.CreateOrUpdatePicks(ctx, &pReq); err != nil || resp.Status != http.StatusCreated {
+		t.Fatalf("Creating picks failed: %v %v", err, resp)
+	}
+	picks, err := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: fId})
+	if err != nil || len(picks.Picks) == 0 {
+		t.Fatalf("Getting picks failed: %v %v", err, picks)
+	}
+	prospect := pb.CreateProspect{FullName: "Max Muster", FirstName: "Max", LastName: "Muster", Birthdate: "2004-05-12"}
+	if _, err := client.CreateProspectsBulk(ctx, &pb.CreateProspectsBulkRequest{Prospects: []*pb.CreateProspect{&prospect}}); err != nil {
+		t.Fatalf("Create prospects failed: %v", err)
+	}
+	search, err := client.TextSearchProspects(ctx, &pb.TextSearchRequest{Text: "Muster"})
+	if err != nil || len(search.Prospects) != 1 {
+		t.Fatalf("Search prospects failed: %v %v", err, search)
+	}
+	dReq := pb.DraftRequest{LeagueID: lResp.LeagueId, FranchiseID: fId, ProspectID: search.Prospects[0].ID, PickID: picks.Picks[0].ID, UserId: userId}
+	if resp, err := client.DraftProspect(ctx, &dReq); err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Drafting prospect failed: %v %v", err, resp)
+	}
+
+	// Delete
+	dResp, err := client.DeleteFranchise(ctx, &pb.DeleteFranchiseRequest{Id: fId, UserId: userId})
+	if err != nil {
+		t.Fatalf("Delete franchise failed: %v", err)
+	}
+	if dResp.Status != http.StatusOK {
+		t.Fatalf("Http Status %d not equal to expected status %d: %s", dResp.Status, http.StatusOK, dResp.Error)
+	}
+
+	getResp, err = client.GetFranchise(ctx, &pb.GetFranchiseRequest{FranchiseID: fId})
+	if err != nil {
+		t.Fatalf("Get franchise failed: %v", err)
+	}
+	if getResp.Status != http.StatusConflict {
+		t.Errorf("Http Status %d not equal to expected status %d", getResp.Status, http.StatusConflict)
+	}
+
+	// prospect is back in the pool
+	undrafted, err := client.TextSearchProspects(ctx, &pb.TextSearchRequest{Text: "Muster", Filter: &pb.ProspectFilter{Drafted: pb.BoolFilter_BOOL_FILTER_NO}})
+	if err != nil || len(undrafted.Prospects) != 1 || undrafted.Prospects[0].FranchiseID != "" {
+		t.Errorf("Expected the prospect to be undrafted: %v %v", err, undrafted)
+	}
+
+	// picks are held by the orphan franchise of the commissioner
+	franchises, err := client.GetLeagueFranchises(ctx, &pb.GetLeagueRequest{LeagueId: lResp.LeagueId})
+	if err != nil || len(franchises.Result) != 1 {
+		t.Fatalf("Expected only the orphan franchise to be left: %v %v", err, franchises)
+	}
+	orphan := franchises.Result[0]
+	if orphan.OwnerID != userId {
+		t.Errorf("Orphan franchise owner %q not equal to expected commissioner %q", orphan.OwnerID, userId)
+	}
+	orphanPicks, err := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: orphan.ID})
+	if err != nil || len(orphanPicks.Picks) != len(picks.Picks) {
+		t.Fatalf("Expected the orphan franchise to hold %d picks: %v %v", len(picks.Picks), err, orphanPicks)
+	}
+	if orphanPicks.Picks[0].LastOwnerID != fId || orphanPicks.Picks[0].ProspectID != "" {
+		t.Errorf("Pick %+v was not freed and transferred from %q", orphanPicks.Picks[0], fId)
+	}
+
+	// history
+	history, err := client.GetFranchiseHistory(ctx, &pb.GetFranchiseRequest{FranchiseID: fId})
+	if err != nil {
+		t.Fatalf("Get franchise history failed: %v", err)
+	}
+	var changes []string
+	for _, h := range history.Result {
+		changes = append(changes, h.Change)
+	}
+	expectedChanges := "created updated transferred deleted"
+	if strings.Join(changes, " ") != expectedChanges {
+		t.Errorf("Changes %q not equal to expected %q", strings.Join(changes, " "), expectedChanges)
+	}
+	if history.Result[0].Name != franchiseName || history.Result[2].OwnerID != userId2 {
+		t.Errorf("History %+v does not record names and owners", history.Result)
+	}
+
+	// the orphan franchise can't be deleted
+	oResp, err := client.DeleteFranchise(ctx, &pb.DeleteFranchiseRequest{Id: orphan.ID, UserId: userId})
+	if err != nil {
+		t.Fatalf("Delete franchise failed: %v", err)
+	}
+	if oResp.Status != http.StatusConflict {
+		t.Errorf("Http Status %d not equal to expected status %d", oResp.Status, http.StatusConflict)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestFranchiseMembers(t *testing.T) {
+	const assistantId = "22222222-2222-2222-2222-222222222222"
+	lResp, err := createLeague(userId, leagueName, foundationYear, maxFranchises2, maxProspects, draftRightsGoalie, draftRightsSkater)
+	if err != nil {
+		t.Fatalf("League creation failed: %v", err)
+	}
+	fResp, err := createFranchise(lResp.LeagueId, userId2, franchiseName, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	fId := fResp.FranchiseId
+
+	// the owner adds an assistant gm
+	aResp, err := client.AddFranchiseMember(ctx, &pb.FranchiseMemberRequest{FranchiseID: fId, MemberID: assistantId, MemberName: "Assistant", Role: string(models.AssistantGM), UserId: userId2})
+	if err != nil {
+		t.Fatalf("Adding member failed: %v", err)
+	}
+	if aResp.Status != http.StatusOK {
+		t.Errorf("Http Status %d not equal to expected status %d: %s", aResp.Status, http.StatusOK, aResp.Error)
+	}
+
+	// nobody becomes owner by adding members
+	oResp, _ := client.AddFranchiseMember(ctx, &pb.FranchiseMemberRequest{FranchiseID: fId, MemberID: assistantId, Role: string(models.Owner), UserId: userId2})
+	if oResp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", oResp.Status, http.StatusBadRequest)
+	}
+
+	members, err := client.GetFranchiseMembers(ctx, &pb.GetFranchiseRequest{FranchiseID: fId})
+	if err != nil {
+		t.Fatalf("Get franchise members failed: %v", err)
+	}
+	if len(members.Result) != 2 || members.Result[0].Role != string(models.Owner) || members.Result[1].UserID != assistantId {
+		t.Errorf("Members %v not equal to expected owner and assistant gm", members.Result)
+	}
+
+	// the assistant gm may not rename the franchise, the commissioner may
+	uResp, _ := client.UpdateFranchise(ctx, &pb.FranchiseUpdateRequest{Id: fId, Name: franchiseName2, UserId: assistantId})
+	if uResp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", uResp.Status, http.StatusForbidden)
+	}
+	uResp, _ = client.UpdateFranchise(ctx, &pb.FranchiseUpdateRequest{Id: fId, Name: franchiseName2, UserId: userId})
+	if uResp.Status != http.StatusOK {
+		t.Errorf("Http Status %d not equal to expected status %d: %s", uResp.Status, http.StatusOK, uResp.Error)
+	}
+
+	// the assistant gm may not add members or trade
+	aResp, _ = client.AddFranchiseMember(ctx, &pb.FranchiseMemberRequest{FranchiseID: fId, MemberID: uuid.NewString(), Role: string(models.CoOwner), UserId: assistantId})
+	if aResp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", aResp.Status, http.StatusForbidden)
+	}
+	trResp, _ := client.Trade(ctx, &pb.TradeRequest{First: &pb.TradePayload{FranchiseID: fId}, Second: &pb.TradePayload{FranchiseID: fId}, UserId: assistantId})
+	if trResp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", trResp.Status, http.StatusForbidden)
+	}
+
+	// requests without an acting user are forbidden
+	aResp, _ = client.AddFranchiseMember(ctx, &pb.FranchiseMemberRequest{FranchiseID: fId, MemberID: uuid.NewString(), Role: string(models.CoOwner)})
+	if aResp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", aResp.Status, http.StatusForbidden)
+	}
+	if resp, _ := client.RemoveFranchiseMember(ctx, &pb.FranchiseMemberRequest{FranchiseID: fId, MemberID: assistantId}); resp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusForbidden)
+	}
+
+	// the owner can't be removed, members may leave
+	rResp, _ := client.RemoveFranchiseMember(ctx, &pb.FranchiseMemberRequest{FranchiseID: fId, MemberID: userId2, UserId: userId2})
+	if rResp.Status != http.StatusConflict {
+		t.Errorf("Http Status %d not equal to expected status %d", rResp.Status, http.StatusConflict)
+	}
+	rResp, _ = client.RemoveFranchiseMember(ctx, &pb.FranchiseMemberRequest{FranchiseID: fId, MemberID: assistantId, UserId: assistantId})
+	if rResp.Status != http.StatusOK {
+		t.Errorf("Http Status %d not equal to expected status %d: %s", rResp.Status, http.StatusOK, rResp.Error)
+	}
+
+	// a transfer replaces the owner member
+	tResp, _ := client.TransferFranchiseOwnership(ctx, &pb.TransferFranchiseRequest{Id: fId, OwnerID: assistantId, OwnerName: "Assistant", UserId: userId2})
+	if tResp.Status != http.StatusOK {
+		t.Errorf("Http Status %d not equal to expected status %d: %s", tResp.Status, http.StatusOK, tResp.Error)
+	}
+	members, _ = client.GetFranchiseMembers(ctx, &pb.GetFranchiseRequest{FranchiseID: fId})
+	if len(members.Result) != 1 || members.Result[0].UserID != assistantId || members.Result[0].Role != string(models.Owner) {
+		t.Errorf("Members %v not equal to expected new owner %q", members.Result, assistantId)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestInvitations(t *testing.T) {
+	const inviteeId = "22222222-2222-2222-2222-222222222222"
+	lResp, err := createLeague(userId, leagueName, foundationYear, 2, maxProspects, draftRightsGoalie, draftRightsSkater)
+	if err != nil {
+		t.Fatalf("League creation failed: %v", err)
+	}
+
+	// only the commissioner invites
+	iResp, err := client.InviteFranchise(ctx, &pb.InvitationRequest{LeagueID: lResp.LeagueId, Email: "invitee@lakelandcup.ch", FranchiseName: franchiseName, UserId: userId2})
+	if err != nil {
+		t.Fatalf("Invite failed: %v", err)
+	}
+	if iResp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", iResp.Status, http.StatusForbidden)
+	}
+	iResp, _ = client.InviteFranchise(ctx, &pb.InvitationRequest{LeagueID: lResp.LeagueId, Email: "invitee@lakelandcup.ch", FranchiseName: franchiseName, UserId: userId})
+	if iResp.Status != http.StatusCreated || iResp.Token == "" {
+		t.Fatalf("Http Status %d not equal to expected status %d: %s", iResp.Status, http.StatusCreated, iResp.Error)
+	}
+	revoked, _ := client.InviteFranchise(ctx, &pb.InvitationRequest{LeagueID: lResp.LeagueId, Email: "revoked@lakelandcup.ch", FranchiseName: franchiseName2, UserId: userId})
+	if revoked.Status != http.StatusCreated {
+		t.Fatalf("Http Status %d not equal to expected status %d: %s", revoked.Status, http.StatusCreated, revoked.Error)
+	}
+
+	// both slots are reserved
+	fResp, _ := createFranchise(lResp.LeagueId, userId2, franchiseName3, franchiseFoundationYear)
+	if fResp.Status != http.StatusConflict {
+		t.Errorf("Http Status %d not equal to expected status %d", fResp.Status, http.StatusConflict)
+	}
+
+	// revoking frees a slot and the token can't be claimed anymore
+	rResp, _ := client.RevokeInvitation(ctx, &pb.RevokeInvitationRequest{Id: revoked.InvitationID, UserId: userId})
+	if rResp.Status != http.StatusOK {
+		t.Errorf("Http Status %d not equal to expected status %d: %s", rResp.Status, http.StatusOK, rResp.Error)
+	}
+	cResp, _ := client.ClaimFranchise(ctx, &pb.ClaimFranchiseRequest{Token: revoked.Token, UserId: userId2, UserName: "TestUser2"})
+	if cResp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", cResp.Status, http.StatusForbidden)
+	}
+
+	// claiming binds the user to the reserved franchise, once
+	cResp, _ = client.ClaimFranchise(ctx, &pb.ClaimFranchiseRequest{Token: iResp.Token, UserId: inviteeId, UserName: "Invitee"})
+	if cResp.Status != http.StatusCreated {
+		t.Fatalf("Http Status %d not equal to expected status %d: %s", cResp.Status, http.StatusCreated, cResp.Error)
+	}
+	getResp, _ := client.GetFranchise(ctx, &pb.GetFranchiseRequest{FranchiseID: cResp.FranchiseId})
+	if getResp.Result.OwnerID != inviteeId || getResp.Result.Name != franchiseName {
+		t.Errorf("Franchise %+v not owned by %q", getResp.Result, inviteeId)
+	}
+	cResp, _ = client.ClaimFranchise(ctx, &pb.ClaimFranchiseRequest{Token: iResp.Token, UserId: userId2, UserName: "TestUser2", Name: franchiseName2})
+	if cResp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", cResp.Status, http.StatusForbidden)
+	}
+	cResp, _ = client.ClaimFranchise(ctx, &pb.ClaimFranchiseRequest{Token: "forged", UserId: userId2})
+	if cResp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", cResp.Status, http.StatusForbidden)
+	}
+
+	// only the commissioner creates franchises for others
+	fResp, _ = client.CreateFranchise(ctx, &pb.FranchiseRequest{LeagueId: lResp.LeagueId, OwnerID: userId2, OwnerName: "TestUser2", Name: franchiseName3, Founded: 2022, UserId: userId2})
+	if fResp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", fResp.Status, http.StatusForbidden)
+	}
+	for status, r := range map[int64]*pb.FranchiseRequest{
+		http.StatusForbidden:  {LeagueId: lResp.LeagueId, OwnerID: userId2, OwnerName: "TestUser2", Name: franchiseName3, Founded: 2022},
+		http.StatusBadRequest: {LeagueId: lResp.LeagueId, OwnerID: "no-uuid", Name: franchiseName3, Founded: 2022, UserId: userId},
+	} {
+		if resp, _ := client.CreateFranchise(ctx, r); resp.Status != status {
+			t.Errorf("Http Status %d not equal to expected status %d: %s", resp.Status, status, resp.Error)
+		}
+	}
+	if resp, _ := client.CreateFranchise(ctx, &pb.FranchiseRequest{LeagueId: "no-uuid", UserId: userId}); resp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusBadRequest)
+	}
+	fResp, _ = client.CreateFranchise(ctx, &pb.FranchiseRequest{LeagueId: lResp.LeagueId, OwnerID: userId2, OwnerName: "TestUser2", Name: franchiseName3, Founded: 2022, UserId: userId})
+	if fResp.Status != http.StatusCreated {
+		t.Errorf("Http Status %d not equal to expected status %d: %s", fResp.Status, http.StatusCreated, fResp.Error)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestLeagueLifecycle(t *testing.T) {
+	req := pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: maxFranchises2, MaxProspects: maxProspects, DraftRightsGoalie: draftRightsGoalie, DraftRightsSkater: draftRightsSkater, Season: 2024}
+	lResp, err := client.CreateLeague(ctx, &req)
+	if err != nil {
+		t.Fatalf("League creation failed: %v", err)
+	}
+	fResp, err := createFranchise(lResp.LeagueId, userId2, franchiseName, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+
+	// picks of the past and the next draft
+	picksReq := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId, Picks: []*pb.CreateOrUpdatePick{
+		{Franchise: franchiseName, FranchiseID: fResp.FranchiseId, DraftYear: 2024, LotteryPosition: 1},
+		{Franchise: franchiseName, FranchiseID: fResp.FranchiseId, DraftYear: 2025},
+	}}
+	if resp, err := client.CreateOrUpdatePicks(ctx, &picksReq); err != nil || resp.Status != http.StatusCreated {
+		t.Fatalf("Create picks failed: %v %v", err, resp)
+	}
+
+	// a drafted prospect
+	prospect := pb.CreateProspect{FullName: "Max Muster", FirstName: "Max", LastName: "Muster", NhlTeam: "EDM", PositionCode: "C"}
+	bulkResp, err := client.CreateProspectsBulk(ctx, &pb.CreateProspectsBulkRequest{Prospects: []*pb.CreateProspect{&prospect}})
+	if err != nil || bulkResp.Status != http.StatusCreated {
+		t.Fatalf("Create prospects failed: %v %v", err, bulkResp)
+	}
+	found, _ := client.TextSearchProspects(ctx, &pb.TextSearchRequest{Text: "Muster"})
+	picks2024, _ := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: fResp.FranchiseId, DraftYear: 2024})
+	draftResp, err := client.DraftProspect(ctx, &pb.DraftRequest{LeagueID: lResp.LeagueId, FranchiseID: fResp.FranchiseId, ProspectID: found.Prospects[0].ID, PickID: picks2024.Picks[0].ID, UserId: userId})
+	if err != nil || draftResp.Status != http.StatusOK {
+		t.Fatalf("Draft prospect failed: %v %v", err, draftResp)
+	}
+
+	// only the commissioner clones, into a later season
+	cResp, _ := client.CloneLeagueForSeason(ctx, &pb.CloneLeagueRequest{Id: lResp.LeagueId, Season: 2025, UserId: userId2})
+	if cResp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", cResp.Status, http.StatusForbidden)
+	}
+	if resp, _ := client.CloneLeagueForSeason(ctx, &pb.CloneLeagueRequest{Id: lResp.LeagueId, Season: 2025}); resp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusForbidden)
+	}
+	if resp, _ := client.DeleteLeague(ctx, &pb.LeagueActionRequest{Id: lResp.LeagueId}); resp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusForbidden)
+	}
+
+	// only the commissioner updates the league
+	update := proto.Clone(&req).(*pb.LeagueRequest)
+	for status, u := range map[int64]*pb.LeagueUpdateRequest{
+		http.StatusForbidden:  {Id: lResp.LeagueId, League: update, UserId: userId2},
+		http.StatusBadRequest: {Id: "no-uuid", League: update, UserId: userId},
+	} {
+		if resp, _ := client.UpdateLeague(ctx, u); resp.Status != status {
+			t.Errorf("Http Status %d not equal to expected status %d: %s", resp.Status, status, resp.Error)
+		}
+	}
+	cResp, _ = client.CloneLeagueForSeason(ctx, &pb.CloneLeagueRequest{Id: lResp.LeagueId, Season: 2024, UserId: userId})
+	if cResp.Status != http.StatusConflict {
+		t.Errorf("Http Status %d not equal to expected status %d", cResp.Status, http.StatusConflict)
+	}
+	cResp, _ = client.CloneLeagueForSeason(ctx, &pb.CloneLeagueRequest{Id: lResp.LeagueId, Season: 2025, UserId: userId})
+	if cResp.Status != http.StatusCreated {
+		t.Fatalf("Http Status %d not equal to expected status %d: %s", cResp.Status, http.StatusCreated, cResp.Error)
+	}
+
+	// the old season is archived and read-only
+	old, _ := client.GetLeague(ctx, &pb.GetLeagueRequest{LeagueId: lResp.LeagueId})
+	if !old.Result.Archived {
+		t.Errorf("League %q is not archived", lResp.LeagueId)
+	}
+	uResp, _ := client.UpdateFranchise(ctx, &pb.FranchiseUpdateRequest{Id: fResp.FranchiseId, Name: franchiseName2, UserId: userId})
+	if uResp.Status != http.StatusConflict {
+		t.Errorf("Http Status %d not equal to expected status %d", uResp.Status, http.StatusConflict)
+	}
+
+	// the clone carries settings, franchises, members, roster and future picks
+	clone, _ := client.GetLeague(ctx, &pb.GetLeagueRequest{LeagueId: cResp.LeagueId})
+	if clone.Result.Season != 2025 || clone.Result.ClonedFromID != lResp.LeagueId || clone.Result.MaxFranchises != maxFranchises2 {
+		t.Errorf("Clone %+v does not continue league %q", clone.Result, lResp.LeagueId)
+	}
+	if len(clone.Result.Franchises) != 1 || clone.Result.Franchises[0].Name != franchiseName {
+		t.Fatalf("Franchises %v not copied", clone.Result.Franchises)
+	}
+	cloneFranchiseId := clone.Result.Franchises[0].ID
+	members, _ := client.GetFranchiseMembers(ctx, &pb.GetFranchiseRequest{FranchiseID: cloneFranchiseId})
+	if len(members.Result) != 1 || members.Result[0].UserID != userId2 {
+		t.Errorf("Members %v not copied", members.Result)
+	}
+	roster, _ := client.GetProspectsByFranchise(ctx, &pb.GetFranchiseRequest{FranchiseID: cloneFranchiseId})
+	if len(roster.Prospects) != 1 || roster.Prospects[0].IsProtected {
+		t.Errorf("Roster %v not carried over unprotected", roster.Prospects)
+	}
+	picks, _ := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: cloneFranchiseId})
+	for _, p := range picks.Picks {
+		if p.Year < 2025 || p.OriginID != cloneFranchiseId {
+			t.Errorf("Pick %+v should not have been carried over", p)
+		}
+	}
+	// 2025 to 2027 are carried over, 2028 completes the horizon
+	if len(picks.Picks) != 8 {
+		t.Errorf("Franchise has %d picks, expected %d", len(picks.Picks), 8)
+	}
+
+	// deleting the clone returns the prospects to the pool
+	dResp, _ := client.DeleteLeague(ctx, &pb.LeagueActionRequest{Id: cResp.LeagueId, UserId: userId})
+	if dResp.Status != http.StatusOK {
+		t.Errorf("Http Status %d not equal to expected status %d: %s", dResp.Status, http.StatusOK, dResp.Error)
+	}
+	if g, _ := client.GetLeague(ctx, &pb.GetLeagueRequest{LeagueId: cResp.LeagueId}); g.Result != nil {
+		t.Errorf("League %q was not deleted", cResp.LeagueId)
+	}
+	search, _ := client.TextSearchProspects(ctx, &pb.TextSearchRequest{Text: "Muster"})
+	if len(search.Prospects) != 1 || search.Prospects[0].FranchiseID != "" || search.Prospects[0].LeagueID != "" {
+		t.Errorf("Prospects %v not returned to the pool", search.Prospects)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestSeasonRollover(t *testing.T) {
+	req := pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: maxFranchises, MaxProspects: maxProspects, DraftRightsGoalie: draftRightsGoalie, DraftRightsSkater: draftRightsSkater, Season: 2024, FuturePickYears: 1}
+	lResp, err := client.CreateLeague(ctx, &req)
+	if err != nil {
+		t.Fatalf("League creation failed: %v", err)
+	}
+	fResp, err := createFranchise(lResp.LeagueId, userId2, franchiseName, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+
+	// the franchise starts with the picks of the season's draft and one more
+	picks, _ := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: fResp.FranchiseId})
+	if len(picks.Picks) != 4 {
+		t.Errorf("Franchise has %d picks, expected %d", len(picks.Picks), 4)
+	}
+
+	// only the commissioner rolls over
+	rResp, _ := client.RolloverSeason(ctx, &pb.RolloverSeasonRequest{LeagueID: lResp.LeagueId, UserId: userId2})
+	if rResp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", rResp.Status, http.StatusForbidden)
+	}
+	rResp, _ = client.RolloverSeason(ctx, &pb.RolloverSeasonRequest{LeagueID: lResp.LeagueId, UserId: userId})
+	if rResp.Status != http.StatusCreated {
+		t.Fatalf("Http Status %d not equal to expected status %d: %s", rResp.Status, http.StatusCreated, rResp.Error)
+	}
+	if rResp.Result.Year != 2025 || rResp.Result.Status != string(models.SeasonActive) || rResp.Result.StartDate.AsTime().Format("2006-01-02") != "2024-07-01" {
+		t.Errorf("Season %+v not equal to expected season 2025", rResp.Result)
+	}
+
+	// the picks of the 2026 draft are added
+	picks, _ = client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: fResp.FranchiseId})
+	if len(picks.Picks) != 6 {
+		t.Errorf("Franchise has %d picks, expected %d", len(picks.Picks), 6)
+	}
+
+	// rolling over again only adds the missing draft
+	rResp, _ = client.RolloverSeason(ctx, &pb.RolloverSeasonRequest{LeagueID: lResp.LeagueId, UserId: userId})
+	if rResp.Status != http.StatusCreated || rResp.Result.Year != 2026 {
+		t.Fatalf("Rollover to 2026 failed: %+v", rResp)
+	}
+	picks, _ = client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: fResp.FranchiseId})
+	if len(picks.Picks) != 8 {
+		t.Errorf("Franchise has %d picks, expected %d", len(picks.Picks), 8)
+	}
+
+	seasons, _ := client.GetSeasons(ctx, &pb.GetLeagueRequest{LeagueId: lResp.LeagueId})
+	var status []string
+	for _, season := range seasons.Result {
+		status = append(status, fmt.Sprintf("%d %s", season.Year, season.Status))
+	}
+	if strings.Join(status, ", ") != "2024 closed, 2025 closed, 2026 active" {
+		t.Errorf("Seasons %q not as expected", strings.Join(status, ", "))
+	}
+	league, _ := client.GetLeague(ctx, &pb.GetLeagueRequest{LeagueId: lResp.LeagueId})
+	if league.Result.Season != 2026 {
+		t.Errorf("League season %d not equal to expected %d", league.Result.Season, 2026)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestFuturePicks(t *testing.T) {
+	req := pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: maxFranchises2, MaxProspects: maxProspects, DraftRightsGoalie: draftRightsGoalie, DraftRightsSkater: draftRightsSkater, Season: 2024, FuturePickYears: 2}
+	lResp, err := client.CreateLeague(ctx, &req)
+	if err != nil {
+		t.Fatalf("League creation failed: %v", err)
+	}
+	fResp, err := createFranchise(lResp.LeagueId, userId2, franchiseName, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	countPicks := func() map[int32]int {
+		picks, _ := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: fResp.FranchiseId})
+		rounds := map[int32]int{}
+		for _, p := range picks.Picks {
+			rounds[p.Round]++
+		}
+		return rounds
+	}
+
+	// 2024 to 2026 with two rounds each
+	if rounds := countPicks(); rounds[1] != 3 || rounds[2] != 3 || len(rounds) != 2 {
+		t.Errorf("Picks per round %v not as expected", rounds)
+	}
+
+	// positions are kept when the picks already exist
+	picksReq := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId, Picks: []*pb.CreateOrUpdatePick{{Franchise: franchiseName, FranchiseID: fResp.FranchiseId, DraftYear: 2024, LotteryPosition: 1}}}
+	if resp, err := client.CreateOrUpdatePicks(ctx, &picksReq); err != nil || resp.Status != http.StatusCreated {
+		t.Fatalf("Create picks failed: %v %v", err, resp)
+	}
+	// a request with an invalid position stores none of them
+	invalid := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId, Picks: []*pb.CreateOrUpdatePick{
+		{Franchise: franchiseName, FranchiseID: fResp.FranchiseId, DraftYear: 2025, LotteryPosition: 1},
+		{Franchise: franchiseName, FranchiseID: "no-uuid", DraftYear: 2025, LotteryPosition: 2},
+	}}
+	if resp, _ := client.CreateOrUpdatePicks(ctx, &invalid); resp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusBadRequest)
+	}
+	if picks, _ := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: fResp.FranchiseId, DraftYear: 2025}); picks.Picks[0].PickOverall != 0 {
+		t.Errorf("Pick %v of the invalid request was positioned", picks.Picks[0])
+	}
+	// only the commissioner positions the picks, and only of the league's franchises
+	forbidden := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId2, Picks: picksReq.Picks}
+	if resp, _ := client.CreateOrUpdatePicks(ctx, &forbidden); resp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusForbidden)
+	}
+	foreign := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId, Picks: []*pb.CreateOrUpdatePick{{Franchise: franchiseName, FranchiseID: uuid.New().String(), DraftYear: 2025, LotteryPosition: 1}}}
+	if resp, _ := client.CreateOrUpdatePicks(ctx, &foreign); resp.Status != http.StatusConflict {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusConflict)
+	}
+
+	update := func(rounds int32) {
+		uReq := pb.LeagueUpdateRequest{Id: lResp.LeagueId, League: &pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: maxFranchises2, MaxProspects: maxProspects, DraftRounds: rounds}, UserId: userId}
+		if resp, err := client.UpdateLeague(ctx, &uReq); err != nil || resp.Status != http.StatusCreated {
+			t.Fatalf("Update league failed: %v %v", err, resp)
+		}
+	}
+
+	// a third round adds its picks, twice does nothing
+	update(3)
+	update(3)
+	if rounds := countPicks(); rounds[1] != 3 || rounds[2] != 3 || rounds[3] != 3 {
+		t.Errorf("Picks per round %v not as expected", rounds)
+	}
+
+	// rounds with traded picks can't be dropped until the picks return
+	other, err := createFranchise(lResp.LeagueId, userId, franchiseName2, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	picks, _ := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: fResp.FranchiseId, DraftYear: 2025})
+	traded := ""
+	for _, p := range picks.Picks {
+		if p.Round == 3 {
+			traded = p.ID
+		}
+	}
+	trade := func(from string, to string) {
+		resp, err := client.Trade(ctx, &pb.TradeRequest{First: &pb.TradePayload{FranchiseID: from, Picks: []string{traded}}, Second: &pb.TradePayload{FranchiseID: to}, UserId: userId})
+		if err != nil || resp.Status != http.StatusOK {
+			t.Fatalf("Trade failed: %v %v", err, resp)
+		}
+	}
+	trade(fResp.FranchiseId, other.FranchiseId)
+	uReq := pb.LeagueUpdateRequest{Id: lResp.LeagueId, League: &pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: maxFranchises2, MaxProspects: maxProspects, DraftRounds: 1}, UserId: userId}
+	if resp, _ := client.UpdateLeague(ctx, &uReq); resp.Status != http.StatusConflict || !strings.Contains(resp.Error, "traded") {
+		t.Errorf("Http Status %d not equal to expected status %d: %s", resp.Status, http.StatusConflict, resp.Error)
+	}
+	trade(other.FranchiseId, fResp.FranchiseId)
+
+	// back to one round removes the unpositioned picks, the positioned 2024 pick stays
+	update(1)
+	if rounds := countPicks(); rounds[1] != 3 || rounds[2] != 1 || rounds[3] != 0 {
+		t.Errorf("Picks per round %v not as expected", rounds)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestConditionalPicks(t *testing.T) {
+	req := pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: 2, MaxProspects: maxProspects, DraftRightsGoalie: draftRightsGoalie, DraftRightsSkater: draftRightsSkater, Season: 2024, FuturePickYears: 1}
+	lResp, err := client.CreateLeague(ctx, &req)
+	if err != nil {
+		t.Fatalf("League creation failed: %v", err)
+	}
+	first, err := createFranchise(lResp.LeagueId, userId, franchiseName, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	second, err := createFranchise(lResp.LeagueId, userId2, franchiseName2, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	ownedPicks := func(franchiseID string, year int32) []*pb.Pick {
+		picks, _ := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: franchiseID, DraftYear: year})
+		return picks.Picks
+	}
+	findPick := func(franchiseID string, year int32, round int32) *pb.Pick {
+		for _, p := range ownedPicks(franchiseID, year) {
+			if p.Round == round && p.OriginID == franchiseID {
+				return p
+			}
+		}
+		return nil
+	}
+	ownedPick := func(franchiseID string, id string) *pb.Pick {
+		for _, p := range ownedPicks(franchiseID, 0) {
+			if p.ID == id {
+				return p
+			}
+		}
+		return nil
+	}
+	setPositions := func(year int32, firstPosition int32, secondPosition int32) {
+		pReq := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId, Picks: []*pb.CreateOrUpdatePick{
+			{Franchise: franchiseName, FranchiseID: first.FranchiseId, DraftYear: year, LotteryPosition: firstPosition},
+			{Franchise: franchiseName2, FranchiseID: second.FranchiseId, DraftYear: year, LotteryPosition: secondPosition},
+		}}
+		if resp, err := client.CreateOrUpdatePicks(ctx, &pReq); err != nil || resp.Status != http.StatusCreated {
+			t.Fatalf("Setting positions failed: %v %v", err, resp)
+		}
+	}
+
+	// 2024 1st, top-3 protected, otherwise converts to the 2025 2nd
+	protected := findPick(first.FranchiseId, 2024, 1)
+	substitute := findPick(first.FranchiseId, 2025, 2)
+	trade := pb.TradeRequest{
+		First:  &pb.TradePayload{FranchiseID: first.FranchiseId, ConditionalPicks: []*pb.ConditionalPick{{PickID: protected.ID, ProtectedTop: 3, SubstitutePickID: substitute.ID}}},
+		Second: &pb.TradePayload{FranchiseID: second.FranchiseId},
+		UserId: userId,
+	}
+	if resp, err := client.Trade(ctx, &trade); err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Trade failed: %v %v", err, resp)
+	}
+
+	// both picks stay until the condition is resolved and show it
+	for _, id := range []string{protected.ID, substitute.ID} {
+		p := ownedPick(first.FranchiseId, id)
+		if p == nil || len(p.Conditions) != 1 || p.Conditions[0].Status != string(models.ConditionPending) {
+			t.Errorf("Pick %v has not one pending condition", p)
+		}
+	}
+
+	// pledged picks can't be traded
+	tResp, _ := client.Trade(ctx, &pb.TradeRequest{First: &pb.TradePayload{FranchiseID: first.FranchiseId, Picks: []string{substitute.ID}}, Second: &pb.TradePayload{FranchiseID: second.FranchiseId}, UserId: userId})
+	if tResp.Status != http.StatusConflict {
+		t.Errorf("Http Status %d not equal to expected status %d", tResp.Status, http.StatusConflict)
+	}
+
+	// the pick lands second, the protection applies and the substitute is conveyed
+	setPositions(2024, 2, 1)
+	if p := ownedPick(first.FranchiseId, protected.ID); p == nil || len(p.Conditions) != 0 {
+		t.Errorf("Protected pick %v not kept", p)
+	}
+	if p := ownedPick(second.FranchiseId, substitute.ID); p == nil || p.LastOwnerID != first.FranchiseId {
+		t.Errorf("Substitute pick %v not conveyed", p)
+	}
+
+	// 2025 1st, top-1 protected without substitute
+	unprotected := findPick(first.FranchiseId, 2025, 1)
+	trade.First.ConditionalPicks = []*pb.ConditionalPick{{PickID: unprotected.ID, ProtectedTop: 1}}
+	if resp, err := client.Trade(ctx, &trade); err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Trade failed: %v %v", err, resp)
+	}
+
+	// the pick lands second and is conveyed
+	setPositions(2025, 2, 1)
+	if p := ownedPick(second.FranchiseId, unprotected.ID); p == nil {
+		t.Errorf("Pick %s not conveyed", unprotected.ID)
+	}
+
+	// the resolutions are recorded
+	cResp, _ := client.GetPickConditions(ctx, &pb.GetLeagueRequest{LeagueId: lResp.LeagueId})
+	if cResp.Status != http.StatusOK || len(cResp.Result) != 2 {
+		t.Fatalf("Conditions %+v not as expected", cResp)
+	}
+	if cResp.Result[0].Status != string(models.ConditionConverted) || cResp.Result[1].Status != string(models.ConditionConveyed) || cResp.Result[1].ResolvedAt == nil {
+		t.Errorf("Conditions %v not resolved as expected", cResp.Result)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestPickSwaps(t *testing.T) {
+	req := pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: 2, MaxProspects: maxProspects, DraftRightsGoalie: draftRightsGoalie, DraftRightsSkater: draftRightsSkater, Season: 2024, FuturePickYears: 1}
+	lResp, err := client.CreateLeague(ctx, &req)
+	if err != nil {
+		t.Fatalf("League creation failed: %v", err)
+	}
+	first, err := createFranchise(lResp.LeagueId, userId, franchiseName, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	second, err := createFranchise(lResp.LeagueId, userId2, franchiseName2, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	ownedPicks := func(franchiseID string, year int32) []*pb.Pick {
+		picks, _ := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: franchiseID, DraftYear: year})
+		return picks.Picks
+	}
+	firstRounder := func(franchiseID string, year int32) *pb.Pick {
+		for _, p := range ownedPicks(franchiseID, year) {
+			if p.Round == 1 {
+				return p
+			}
+		}
+		return nil
+	}
+	setPositions := func(year int32, firstPosition int32, secondPosition int32) {
+		pReq := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId, Picks: []*pb.CreateOrUpdatePick{
+			{Franchise: franchiseName, FranchiseID: first.FranchiseId, DraftYear: year, LotteryPosition: firstPosition},
+			{Franchise: franchiseName2, FranchiseID: second.FranchiseId, DraftYear: year, LotteryPosition: secondPosition},
+		}}
+		if resp, err := client.CreateOrUpdatePicks(ctx, &pReq); err != nil || resp.Status != http.StatusCreated {
+			t.Fatalf("Setting positions failed: %v %v", err, resp)
+		}
+	}
+
+	// the second franchise may swap 1st-rounders with the first in 2024 and 2025
+	firstPick, secondPick := firstRounder(first.FranchiseId, 2024), firstRounder(second.FranchiseId, 2024)
+	laterFirstPick, laterSecondPick := firstRounder(first.FranchiseId, 2025), firstRounder(second.FranchiseId, 2025)
+	// only picks of the same draft and round are swapped
+	mismatched := pb.TradeRequest{
+		First:  &pb.TradePayload{FranchiseID: first.FranchiseId, SwapRights: []*pb.SwapRight{{PickID: firstPick.ID, SwapPickID: laterSecondPick.ID}}},
+		Second: &pb.TradePayload{FranchiseID: second.FranchiseId},
+		UserId: userId,
+	}
+	if resp, _ := client.Trade(ctx, &mismatched); resp.Status != http.StatusConflict {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusConflict)
+	}
+	trade := pb.TradeRequest{
+		First: &pb.TradePayload{FranchiseID: first.FranchiseId, SwapRights: []*pb.SwapRight{
+			{PickID: firstPick.ID, SwapPickID: secondPick.ID},
+			{PickID: laterFirstPick.ID, SwapPickID: laterSecondPick.ID},
+		}},
+		Second: &pb.TradePayload{FranchiseID: second.FranchiseId},
+		UserId: userId,
+	}
+	if resp, err := client.Trade(ctx, &trade); err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Trade failed: %v %v", err, resp)
+	}
+	if p := firstRounder(second.FranchiseId, 2024); len(p.Swaps) != 1 || p.Swaps[0].Status != string(models.SwapPending) {
+		t.Errorf("Pick %v has not one pending swap", p)
+	}
+
+	// picks of a pending swap can't be traded
+	tResp, _ := client.Trade(ctx, &pb.TradeRequest{First: &pb.TradePayload{FranchiseID: first.FranchiseId, Picks: []string{firstPick.ID}}, Second: &pb.TradePayload{FranchiseID: second.FranchiseId}, UserId: userId})
+	if tResp.Status != http.StatusConflict {
+		t.Errorf("Http Status %d not equal to expected status %d", tResp.Status, http.StatusConflict)
+	}
+
+	// the first franchise picks first in 2024, the picks are swapped
+	setPositions(2024, 1, 2)
+	if p := firstRounder(second.FranchiseId, 2024); p == nil || p.ID != firstPick.ID || len(p.Swaps) != 0 {
+		t.Errorf("Pick %v not swapped to the holder", p)
+	}
+	if p := firstRounder(first.FranchiseId, 2024); p == nil || p.ID != secondPick.ID {
+		t.Errorf("Pick %v not swapped to the grantor", p)
+	}
+
+	// the second franchise picks first in 2025, the picks stay
+	setPositions(2025, 2, 1)
+	if p := firstRounder(second.FranchiseId, 2025); p == nil || p.ID != laterSecondPick.ID {
+		t.Errorf("Pick %v should not be swapped", p)
+	}
+
+	// the ledger has both resolutions
+	sResp, _ := client.GetPickSwaps(ctx, &pb.GetLeagueRequest{LeagueId: lResp.LeagueId})
+	if sResp.Status != http.StatusOK || len(sResp.Result) != 2 {
+		t.Fatalf("Swaps %+v not as expected", sResp)
+	}
+	expected := map[string]string{firstPick.ID: string(models.SwapExercised), laterFirstPick.ID: string(models.SwapDeclined)}
+	for _, sw := range sResp.Result {
+		if sw.Status != expected[sw.GrantorPickID] || sw.ResolvedAt == nil {
+			t.Errorf("Swap %v not resolved as expected", sw)
+		}
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestPickAdjustments(t *testing.T) {
+	req := pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: 2, MaxProspects: maxProspects, DraftRightsGoalie: draftRightsGoalie, DraftRightsSkater: draftRightsSkater, Season: 2024, FuturePickYears: 1}
+	lResp, err := client.CreateLeague(ctx, &req)
+	if err != nil {
+		t.Fatalf("League creation failed: %v", err)
+	}
+	first, err := createFranchise(lResp.LeagueId, userId, franchiseName, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	second, err := createFranchise(lResp.LeagueId, userId2, franchiseName2, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	setPositions := func() {
+		pReq := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId, Picks: []*pb.CreateOrUpdatePick{
+			{Franchise: franchiseName, FranchiseID: first.FranchiseId, DraftYear: 2024, LotteryPosition: 1},
+			{Franchise: franchiseName2, FranchiseID: second.FranchiseId, DraftYear: 2024, LotteryPosition: 2},
+		}}
+		if resp, err := client.CreateOrUpdatePicks(ctx, &pReq); err != nil || resp.Status != http.StatusCreated {
+			t.Fatalf("Setting positions failed: %v %v", err, resp)
+		}
+	}
+	draft := func() map[string]*pb.Pick {
+		picks, _ := client.GetPicksByYear(ctx, &pb.GetPicksRequest{DraftYear: 2024})
+		byID := map[string]*pb.Pick{}
+		for _, p := range picks.Picks {
+			byID[p.ID] = p
+		}
+		return byID
+	}
+	originalPick := func(franchiseID string, round int32) string {
+		for _, p := range draft() {
+			if p.OriginID == franchiseID && p.Round == round && !p.Supplemental {
+				return p.ID
+			}
+		}
+		return ""
+	}
+	type position struct {
+		id               string
+		inRound, overall int32
+	}
+	checkOrder := func(expected []position) {
+		picks := draft()
+		for _, e := range expected {
+			p := picks[e.id]
+			if p == nil || p.PickInRound != e.inRound || p.PickOverall != e.overall {
+				t.Errorf("Pick %v not at %d in round, %d overall", p, e.inRound, e.overall)
+			}
+		}
+	}
+	setPositions()
+	firstOne, secondOne := originalPick(first.FranchiseId, 1), originalPick(second.FranchiseId, 1)
+	firstTwo, secondTwo := originalPick(first.FranchiseId, 2), originalPick(second.FranchiseId, 2)
+
+	// an extra first-rounder in the first slot for the second franchise
+	sResp, _ := client.IssueSupplementalPick(ctx, &pb.SupplementalPickRequest{FranchiseID: second.FranchiseId, Year: 2024, Round: 1, Slot: 1, Reason: "expansion", UserId: userId})
+	if sResp.Status != http.StatusCreated || !sResp.Result.Supplemental || sResp.Result.PickOverall != 1 {
+		t.Fatalf("Issuing a supplemental pick failed: %+v", sResp)
+	}
+	supplementalOne := sResp.Result.ID
+
+	// and one at the end of the second round for the first franchise
+	sResp, _ = client.IssueSupplementalPick(ctx, &pb.SupplementalPickRequest{FranchiseID: first.FranchiseId, Year: 2024, Round: 2, UserId: userId})
+	if sResp.Status != http.StatusCreated {
+		t.Fatalf("Issuing a supplemental pick failed: %+v", sResp)
+	}
+	supplementalTwo := sResp.Result.ID
+	checkOrder([]position{{supplementalOne, 1, 1}, {firstOne, 2, 2}, {secondOne, 3, 3}, {firstTwo, 1, 4}, {secondTwo, 2, 5}, {supplementalTwo, 3, 6}})
+
+	// only the commissioner forfeits picks
+	fResp, _ := client.ForfeitPick(ctx, &pb.ForfeitPickRequest{PickID: firstOne, UserId: userId2})
+	if fResp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", fResp.Status, http.StatusForbidden)
+	}
+	fResp, _ = client.ForfeitPick(ctx, &pb.ForfeitPickRequest{PickID: firstOne, Reason: "salary cap violation", UserId: userId})
+	if fResp.Status != http.StatusOK {
+		t.Fatalf("Forfeiting failed: %+v", fResp)
+	}
+
+	// the forfeited pick stays without a position, the others move up, also after the positions are set again
+	setPositions()
+	if p := draft()[firstOne]; p == nil || !p.Forfeited || p.ForfeitedAt == nil || p.Reason != "salary cap violation" || p.PickOverall != 0 {
+		t.Errorf("Pick %v not forfeited", p)
+	}
+	checkOrder([]position{{supplementalOne, 1, 1}, {secondOne, 2, 2}, {firstTwo, 1, 3}, {secondTwo, 2, 4}, {supplementalTwo, 3, 5}})
+
+	// forfeited picks can't be traded
+	tResp, _ := client.Trade(ctx, &pb.TradeRequest{First: &pb.TradePayload{FranchiseID: first.FranchiseId, Picks: []string{firstOne}}, Second: &pb.TradePayload{FranchiseID: second.FranchiseId}, UserId: userId})
+	if tResp.Status != http.StatusConflict {
+		t.Errorf("Http Status %d not equal to expected status %d", tResp.Status, http.StatusConflict)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestEvaluateTrade(t *testing.T) {
+	req := pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: 2, MaxProspects: maxProspects, DraftRightsGoalie: draftRightsGoalie, DraftRightsSkater: draftRightsSkater, Season: 2024, FuturePickYears: 1}
+	lResp, err := client.CreateLeague(ctx, &req)
+	if err != nil {
+		t.Fatalf("League creation failed: %v", err)
+	}
+	first, err := createFranchise(lResp.LeagueId, userId, franchiseName, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	second, err := createFranchise(lResp.LeagueId, userId2, franchiseName2, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	pReq := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId, Picks: []*pb.CreateOrUpdatePick{
+		{Franchise: franchiseName, FranchiseID: first.FranchiseId, DraftYear: 2024, LotteryPosition: 1},
+		{Franchise: franchiseName2, FranchiseID: second.FranchiseId, DraftYear: 2024, LotteryPosition: 2},
+	}}
+	if resp, err := client.CreateOrUpdatePicks(ctx, &pReq); err != nil || resp.Status != http.StatusCreated {
+		t.Fatalf("Setting positions failed: %v %v", err, resp)
+	}
+	firstRounder := func(franchiseID string, year int32) string {
+		picks, _ := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: franchiseID, DraftYear: year})
+		for _, p := range picks.Picks {
+			if p.Round == 1 {
+				return p.ID
+			}
+		}
+		return ""
+	}
+	firstPick := firstRounder(first.FranchiseId, 2024)
+
+	// the first overall pick for the second franchise's next 1st-rounder
+	trade := pb.TradeRequest{
+		First:  &pb.TradePayload{FranchiseID: first.FranchiseId, Picks: []string{firstPick}},
+		Second: &pb.TradePayload{FranchiseID: second.FranchiseId, Picks: []string{firstRounder(second.FranchiseId, 2025)}},
+		UserId: userId,
+	}
+	eResp, err := client.EvaluateTrade(ctx, &trade)
+	if err != nil || eResp.Status != http.StatusOK {
+		t.Fatalf("Evaluating the trade failed: %v %v", err, eResp)
+	}
+	if eResp.First.Given <= eResp.Second.Given || eResp.First.Delta >= 0 || eResp.First.Delta != -eResp.Second.Delta {
+		t.Errorf("Evaluation %+v not as expected", eResp)
+	}
+	if len(eResp.First.Assets) != 1 || eResp.First.Assets[0].ID != firstPick || eResp.First.Assets[0].Value != eResp.First.Given {
+		t.Errorf("Assets %v not as expected", eResp.First.Assets)
+	}
+
+	// nothing is traded
+	if firstRounder(first.FranchiseId, 2024) != firstPick {
+		t.Errorf("Pick %s was traded", firstPick)
+	}
+
+	// only own assets are valued
+	trade.Second.Picks = []string{firstPick}
+	if eResp, _ := client.EvaluateTrade(ctx, &trade); eResp.Status != http.StatusConflict {
+		t.Errorf("Http Status %d not equal to expected status %d", eResp.Status, http.StatusConflict)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestTradeBlock(t *testing.T) {
+	req := pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: 2, MaxProspects: maxProspects, DraftRightsGoalie: draftRightsGoalie, DraftRightsSkater: draftRightsSkater, Season: 2024, FuturePickYears: 1}
+	lResp, err := client.CreateLeague(ctx, &req)
+	if err != nil {
+		t.Fatalf("League creation failed: %v", err)
+	}
+	first, err := createFranchise(lResp.LeagueId, userId, franchiseName, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	second, err := createFranchise(lResp.LeagueId, userId2, franchiseName2, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	pReq := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId, Picks: []*pb.CreateOrUpdatePick{
+		{Franchise: franchiseName, FranchiseID: first.FranchiseId, DraftYear: 2024, LotteryPosition: 1},
+		{Franchise: franchiseName2, FranchiseID: second.FranchiseId, DraftYear: 2024, LotteryPosition: 2},
+	}}
+	if resp, err := client.CreateOrUpdatePicks(ctx, &pReq); err != nil || resp.Status != http.StatusCreated {
+		t.Fatalf("Setting positions failed: %v %v", err, resp)
+	}
+	firstRounder := func(franchiseID string, year int32) string {
+		picks, _ := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: franchiseID, DraftYear: year})
+		for _, p := range picks.Picks {
+			if p.Round == 1 && p.ProspectID == "" {
+				return p.ID
+			}
+		}
+		return ""
+	}
+
+	// the first franchise drafts a center
+	prospects := []*pb.CreateProspect{
+		{FullName: "Max Muster", FirstName: "Max", LastName: "Muster", PositionCode: "C"},
+		{FullName: "Moritz Muster", FirstName: "Moritz", LastName: "Muster", PositionCode: "D"},
+	}
+	if resp, err := client.CreateProspectsBulk(ctx, &pb.CreateProspectsBulkRequest{Prospects: prospects}); err != nil || resp.Status != http.StatusCreated {
+		t.Fatalf("Create prospects failed: %v %v", err, resp)
+	}
+	found, _ := client.TextSearchProspects(ctx, &pb.TextSearchRequest{Text: "Max Muster"})
+	center := found.Prospects[0].ID
+	dResp, err := client.DraftProspect(ctx, &pb.DraftRequest{LeagueID: lResp.LeagueId, FranchiseID: first.FranchiseId, ProspectID: center, PickID: firstRounder(first.FranchiseId, 2024), UserId: userId})
+	if err != nil || dResp.Status != http.StatusOK {
+		t.Fatalf("Draft prospect failed: %v %v", err, dResp)
+	}
+
+	// owners list their assets
+	futurePick := firstRounder(first.FranchiseId, 2025)
+	secondPick := firstRounder(second.FranchiseId, 2024)
+	listings := []*pb.TradeBlockRequest{
+		{FranchiseID: first.FranchiseId, ProspectID: center, Note: "for a defenseman", UserId: userId},
+		{FranchiseID: first.FranchiseId, PickID: futurePick, UserId: userId},
+		{FranchiseID: second.FranchiseId, PickID: secondPick, Note: "for a center", UserId: userId2},
+	}
+	for _, l := range listings {
+		if resp, err := client.AddToTradeBlock(ctx, l); err != nil || resp.Status != http.StatusCreated {
+			t.Fatalf("Adding to the trade block failed: %v %v", err, resp)
+		}
+	}
+	if resp, _ := client.AddToTradeBlock(ctx, &pb.TradeBlockRequest{FranchiseID: first.FranchiseId, ProspectID: center, PickID: futurePick, UserId: userId}); resp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusBadRequest)
+	}
+	if resp, _ := client.AddToTradeBlock(ctx, &pb.TradeBlockRequest{FranchiseID: first.FranchiseId, PickID: secondPick, UserId: userId}); resp.Status != http.StatusConflict {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusConflict)
+	}
+	if resp, _ := client.AddToTradeBlock(ctx, &pb.TradeBlockRequest{FranchiseID: first.FranchiseId, ProspectID: center, UserId: userId2}); resp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusForbidden)
+	}
+	if resp, err := client.SetTradeProfile(ctx, &pb.TradeProfileRequest{FranchiseID: second.FranchiseId, LookingFor: "a top-6 center", UserId: userId2}); err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Setting the trade profile failed: %v %v", err, resp)
+	}
+
+	block := func(filter *pb.ListTradeBlockRequest) *pb.TradeBlockResponse {
+		filter.LeagueID = lResp.LeagueId
+		resp, err := client.ListTradeBlock(ctx, filter)
+		if err != nil || resp.Status != http.StatusOK {
+			t.Fatalf("Listing the trade block failed: %v %v", err, resp)
+		}
+		return resp
+	}
+	all := block(&pb.ListTradeBlockRequest{})
+	if len(all.Result) != 3 || all.Result[0].Prospect.ID != center || all.Result[0].Note != "for a defenseman" || all.Result[0].FranchiseName != franchiseName {
+		t.Errorf("Trade block %v not as expected", all.Result)
+	}
+	if len(all.Profiles) != 1 || all.Profiles[0].FranchiseID != second.FranchiseId || all.Profiles[0].LookingFor != "a top-6 center" {
+		t.Errorf("Profiles %v not as expected", all.Profiles)
+	}
+	if centers := block(&pb.ListTradeBlockRequest{Position: pb.Position_POSITION_CENTER}); len(centers.Result) != 1 || centers.Result[0].Prospect == nil {
+		t.Errorf("Centers %v not as expected", centers.Result)
+	}
+	if future := block(&pb.ListTradeBlockRequest{DraftYear: 2025}); len(future.Result) != 1 || future.Result[0].Pick.ID != futurePick {
+		t.Errorf("Future picks %v not as expected", future.Result)
+	}
+	if seconds := block(&pb.ListTradeBlockRequest{DraftRound: 2}); len(seconds.Result) != 0 {
+		t.Errorf("Second rounders %v not as expected", seconds.Result)
+	}
+	if own := block(&pb.ListTradeBlockRequest{FranchiseID: second.FranchiseId}); len(own.Result) != 1 || own.Result[0].Pick.ID != secondPick {
+		t.Errorf("Listings %v of the second franchise not as expected", own.Result)
+	}
+
+	// an owner can't take assets of the other side, the commissioner only moves assets of the giving side
+	steal := &pb.TradeRequest{First: &pb.TradePayload{FranchiseID: second.FranchiseId}, Second: &pb.TradePayload{FranchiseID: first.FranchiseId, Prospects: []string{center}}, UserId: userId2}
+	if resp, _ := client.Trade(ctx, steal); resp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusForbidden)
+	}
+	for _, misplaced := range []*pb.TradePayload{
+		{FranchiseID: second.FranchiseId, Prospects: []string{center}},
+		{FranchiseID: second.FranchiseId, Picks: []string{futurePick}},
+	} {
+		resp, _ := client.Trade(ctx, &pb.TradeRequest{First: misplaced, Second: &pb.TradePayload{FranchiseID: first.FranchiseId}, UserId: userId})
+		if resp.Status != http.StatusConflict {
+			t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusConflict)
+		}
+	}
+	if resp, _ := client.Trade(ctx, &pb.TradeRequest{First: &pb.TradePayload{FranchiseID: first.FranchiseId, Picks: []string{"no-uuid"}}, Second: &pb.TradePayload{FranchiseID: second.FranchiseId}, UserId: userId}); resp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusBadRequest)
+	}
+
+	// listings end when the asset is traded or used
+	trade := pb.TradeRequest{
+		First:  &pb.TradePayload{FranchiseID: first.FranchiseId, Prospects: []string{center}},
+		Second: &pb.TradePayload{FranchiseID: second.FranchiseId},
+		UserId: userId,
+	}
+	if resp, err := client.Trade(ctx, &trade); err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Trade failed: %v %v", err, resp)
+	}
+	found, _ = client.TextSearchProspects(ctx, &pb.TextSearchRequest{Text: "Moritz Muster"})
+	// a franchise only drafts with its own picks and in its own league
+	if resp, _ := client.DraftProspect(ctx, &pb.DraftRequest{LeagueID: lResp.LeagueId, FranchiseID: second.FranchiseId, ProspectID: found.Prospects[0].ID, PickID: futurePick, UserId: userId2}); resp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusForbidden)
+	}
+	if resp, _ := client.DraftProspect(ctx, &pb.DraftRequest{LeagueID: wrongLeagueId, FranchiseID: second.FranchiseId, ProspectID: found.Prospects[0].ID, PickID: secondPick, UserId: userId2}); resp.Status != http.StatusConflict {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusConflict)
+	}
+	dResp, err = client.DraftProspect(ctx, &pb.DraftRequest{LeagueID: lResp.LeagueId, FranchiseID: second.FranchiseId, ProspectID: found.Prospects[0].ID, PickID: secondPick, UserId: userId})
+	if err != nil || dResp.Status != http.StatusOK {
+		t.Fatalf("Draft prospect failed: %v %v", err, dResp)
+	}
+	if left := block(&pb.ListTradeBlockRequest{}); len(left.Result) != 1 || left.Result[0].Pick.ID != futurePick {
+		t.Errorf("Trade block %v not as expected", left.Result)
+	}
+
+	if resp, err := client.RemoveFromTradeBlock(ctx, &pb.TradeBlockRequest{FranchiseID: first.FranchiseId, PickID: futurePick, UserId: userId}); err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Removing from the trade block failed: %v %v", err, resp)
+	}
+	if left := block(&pb.ListTradeBlockRequest{}); len(left.Result) != 0 {
+		t.Errorf("Trade block %v not empty", left.Result)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestWatchlist(t *testing.T) {
+	prospects := []*pb.CreateProspect{
+		{FullName: "Max Muster", FirstName: "Max", LastName: "Muster", PositionCode: "C"},
+		{FullName: "Moritz Muster", FirstName: "Moritz", LastName: "Muster", PositionCode: "D"},
+		{FullName: "Mia Muster", FirstName: "Mia", LastName: "Muster", PositionCode: "G"},
+	}
+	if resp, err := client.CreateProspectsBulk(ctx, &pb.CreateProspectsBulkRequest{Prospects: prospects}); err != nil || resp.Status != http.StatusCreated {
+		t.Fatalf("Create prospects failed: %v %v", err, resp)
+	}
+	ids := map[string]string{}
+	found, _ := client.TextSearchProspects(ctx, &pb.TextSearchRequest{Text: "Muster"})
+	for _, p := range found.Prospects {
+		ids[p.FirstName] = p.ID
+	}
+
+	entries := []*pb.WatchlistRequest{
+		{UserId: userId, ProspectID: ids["Max"], Note: "great hands", Tags: []string{"sleeper", " skater ", "sleeper"}, Rank: 2},
+		{UserId: userId, ProspectID: ids["Moritz"], Tags: []string{"skater"}},
+		{UserId: userId, ProspectID: ids["Mia"], Rank: 1},
+		{UserId: userId2, ProspectID: ids["Max"], Note: "overrated"},
+	}
+	for _, e := range entries {
+		if resp, err := client.AddToWatchlist(ctx, e); err != nil || resp.Status != http.StatusCreated {
+			t.Fatalf("Adding to the watchlist failed: %v %v", err, resp)
+		}
+	}
+	if resp, _ := client.AddToWatchlist(ctx, entries[0]); resp.Status != http.StatusConflict {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusConflict)
+	}
+	if resp, _ := client.AddToWatchlist(ctx, &pb.WatchlistRequest{UserId: userId, ProspectID: wrongLeagueId}); resp.Status != http.StatusNotFound {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusNotFound)
+	}
+	if resp, _ := client.AddToWatchlist(ctx, &pb.WatchlistRequest{ProspectID: ids["Max"]}); resp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusBadRequest)
+	}
+
+	// ranked prospects first, the notes are private
+	watchlist := func(userID string, tag string) []*pb.WatchlistEntry {
+		resp, err := client.GetWatchlist(ctx, &pb.GetWatchlistRequest{UserId: userID, Tag: tag})
+		if err != nil || resp.Status != http.StatusOK {
+			t.Fatalf("Getting the watchlist failed: %v %v", err, resp)
+		}
+		return resp.Result
+	}
+	own := watchlist(userId, "")
+	if len(own) != 3 || own[0].ProspectID != ids["Mia"] || own[1].ProspectID != ids["Max"] || own[2].Rank != 0 {
+		t.Fatalf("Watchlist %v not as expected", own)
+	}
+	if own[1].Note != "great hands" || strings.Join(own[1].Tags, ",") != "sleeper,skater" || own[1].Prospect.FullName != "Max Muster" {
+		t.Errorf("Entry %v not as expected", own[1])
+	}
+	if other := watchlist(userId2, ""); len(other) != 1 || other[0].Note != "overrated" {
+		t.Errorf("Watchlist %v not as expected", other)
+	}
+	if skaters := watchlist(userId, "skater"); len(skaters) != 2 {
+		t.Errorf("Skaters %v not as expected", skaters)
+	}
+
+	update := &pb.WatchlistRequest{UserId: userId, ProspectID: ids["Moritz"], Note: "top pair upside", Rank: 1}
+	if resp, err := client.UpdateWatchlistEntry(ctx, update); err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Updating the watchlist failed: %v %v", err, resp)
+	}
+	if own := watchlist(userId, ""); own[0].ProspectID != ids["Moritz"] || own[0].Note != "top pair upside" || len(own[0].Tags) != 0 {
+		t.Errorf("Watchlist %v not as expected", own)
+	}
+	if resp, _ := client.UpdateWatchlistEntry(ctx, &pb.WatchlistRequest{UserId: userId2, ProspectID: ids["Mia"]}); resp.Status != http.StatusNotFound {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusNotFound)
+	}
+
+	// search results carry the caller's entries
+	search, err := client.TextSearchProspects(ctx, &pb.TextSearchRequest{Text: "Muster", WatchlistUserId: userId2})
+	if err != nil || search.Status != http.StatusOK {
+		t.Fatalf("Search prospects failed: %v %v", err, search)
+	}
+	for _, p := range search.Prospects {
+		if watched := p.Watchlist != nil; watched != (p.ID == ids["Max"]) {
+			t.Errorf("Watchlist %v of prospect %s not as expected", p.Watchlist, p.FullName)
+		}
+	}
+	if search, _ := client.TextSearchProspects(ctx, &pb.TextSearchRequest{Text: "Muster"}); search.Prospects[0].Watchlist != nil {
+		t.Errorf("Prospect %v is annotated", search.Prospects[0])
+	}
+
+	if resp, err := client.RemoveFromWatchlist(ctx, &pb.WatchlistRequest{UserId: userId, ProspectID: ids["Mia"]}); err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Removing from the watchlist failed: %v %v", err, resp)
+	}
+	if own := watchlist(userId, ""); len(own) != 2 {
+		t.Errorf("Watchlist %v not as expected", own)
+	}
+	if resp, _ := client.RemoveFromWatchlist(ctx, &pb.WatchlistRequest{UserId: userId, ProspectID: ids["Mia"]}); resp.Status != http.StatusNotFound {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusNotFound)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestProspectStats(t *testing.T) {
+	prospect := pb.CreateProspect{FullName: "Max Muster", FirstName: "Max", LastName: "Muster", PositionCode: "G", Birthdate: "2005-03-01"}
+	bulk := pb.CreateProspectsBulkRequest{Prospects: []*pb.CreateProspect{&prospect}}
+	bResp, err := client.CreateProspectsBulk(ctx, &bulk)
+	if err != nil || bResp.Status != http.StatusCreated || len(bResp.ProspectIds) != 1 {
+		t.Fatalf("Create prospects failed: %v %v", err, bResp)
+	}
+	pId := bResp.ProspectIds[0]
+	// existing prospects are skipped
+	if bResp, _ := client.CreateProspectsBulk(ctx, &bulk); len(bResp.ProspectIds) != 0 {
+		t.Errorf("Prospects %v were created again", bResp.ProspectIds)
+	}
+
+	csv := "player,dob,season,league,team,gp,g,a,pim,sv%,gaa\n" +
+		"\"Muster, Max\",01.03.2005,2022-23,OHL,Kitchener,30,0,1,2,.905,2.9\n" +
+		"Max Muster,2005-03-01,2023-24,OHL,Kitchener,40,0,2,4,.915,2.71\n"
+	records, err := importer.Read(strings.NewReader(csv), importer.CSV, importer.StatsFields, nil)
+	if err != nil {
+		t.Fatalf("Reading stats failed: %v", err)
+	}
+	if report, err := importer.ImportStats(ctx, server.R, records, false); err != nil || len(report.IDs(importer.Insert)) != 2 {
+		t.Fatalf("Importing stats failed: %v %+v", err, report)
+	}
+
+	resp, err := client.GetProspectStats(ctx, &pb.GetProspectStatsRequest{ProspectID: pId})
+	if err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Getting stats failed: %v %v", err, resp)
+	}
+	if resp.Prospect.ID != pId || len(resp.Result) != 2 || resp.Result[0].SeasonLabel != "2022-23" || resp.Result[1].Points != 2 || resp.Result[1].SavePercentage != 0.915 {
+		t.Errorf("Stats %v not as expected", resp)
+	}
+	if resp, _ := client.GetProspectStats(ctx, &pb.GetProspectStatsRequest{ProspectID: pId, Season: 2023}); len(resp.Result) != 1 || resp.Result[0].GamesPlayed != 40 {
+		t.Errorf("Stats of 2023 %v not as expected", resp.Result)
+	}
+	if resp, _ := client.GetProspectStats(ctx, &pb.GetProspectStatsRequest{ProspectID: wrongLeagueId}); resp.Status != http.StatusNotFound {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusNotFound)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestMergeProspects(t *testing.T) {
+	req := pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: 1, MaxProspects: maxProspects, DraftRightsGoalie: draftRightsGoalie, DraftRightsSkater: draftRightsSkater, Season: 2024}
+	lResp, err := client.CreateLeague(ctx, &req)
+	if err != nil {
+		t.Fatalf("League creation failed: %v", err)
+	}
+	franchise, err := createFranchise(lResp.LeagueId, userId, franchiseName, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	pReq := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId, Picks: []*pb.CreateOrUpdatePick{
+		{Franchise: franchiseName, FranchiseID: franchise.FranchiseId, DraftYear: 2024, LotteryPosition: 1},
+	}}
+	if resp, err := client.CreateOrUpdatePicks(ctx, &pReq); err != nil || resp.Status != http.StatusCreated {
+		t.Fatalf("Setting positions failed: %v %v", err, resp)
+	}
+	picks, _ := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: franchise.FranchiseId, DraftYear: 2024})
+	pickID := picks.Picks[0].ID
+
+	// the same player was imported twice from different sources
+	prospects := []*pb.CreateProspect{
+		{FullName: "Jiří Kulich", FirstName: "Jiří", LastName: "Kulich", PositionCode: "C", Birthdate: "2004-04-14"},
+		{FullName: "Kulich, Jiri", FirstName: "Jiri", LastName: "Kulich", NhlTeam: "BUF", Weight: "179"},
+		{FullName: "Adam Fantilli", FirstName: "Adam", LastName: "Fantilli", PositionCode: "C"},
+	}
+	bResp, err := client.CreateProspectsBulk(ctx, &pb.CreateProspectsBulkRequest{Prospects: prospects})
+	if err != nil || bResp.Status != http.StatusCreated || len(bResp.ProspectIds) != 3 {
+		t.Fatalf("Create prospects failed: %v %v", err, bResp)
+	}
+	keepID, dropID := bResp.ProspectIds[0], bResp.ProspectIds[1]
+
+	dResp, err := client.GetDuplicateProspects(ctx, &pb.DuplicateProspectsRequest{})
+	if err != nil || dResp.Status != http.StatusOK || len(dResp.Result) != 1 {
+		t.Fatalf("Getting duplicates failed: %v %v", err, dResp)
+	}
+	if d := dResp.Result[0]; d.First.ID != keepID || d.Second.ID != dropID || d.Similarity != 1 {
+		t.Errorf("Duplicate %v not as expected", d)
+	}
+	if resp, _ := client.GetDuplicateProspects(ctx, &pb.DuplicateProspectsRequest{MinSimilarity: 2}); resp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusBadRequest)
+	}
+
+	// the duplicate was drafted, watched and has stats
+	if resp, err := client.DraftProspect(ctx, &pb.DraftRequest{LeagueID: lResp.LeagueId, FranchiseID: franchise.FranchiseId, ProspectID: dropID, PickID: pickID, UserId: userId}); err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Draft prospect failed: %v %v", err, resp)
+	}
+	for _, id := range []string{keepID, dropID} {
+		if resp, err := client.AddToWatchlist(ctx, &pb.WatchlistRequest{UserId: userId, ProspectID: id}); err != nil || resp.Status != http.StatusCreated {
+			t.Fatalf("Adding to the watchlist failed: %v %v", err, resp)
+		}
+	}
+	drop, _ := uuid.Parse(dropID)
+	if err := server.R.CreateProspectStats(ctx, &models.ProspectSeasonStats{ProspectID: drop, Season: 2023, Level: "Liiga", Team: "Tappara", GamesPlayed: 20}); err != nil {
+		t.Fatalf("Creating stats failed: %v", err)
+	}
+	keep, _ := uuid.Parse(keepID)
+	signed, graduated := time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+	for _, c := range []models.ProspectStatusChange{
+		{ProspectID: drop, Status: models.SignedELC, EffectiveDate: signed},
+		{ProspectID: drop, Status: models.NhlGraduated, EffectiveDate: graduated},
+		{ProspectID: keep, Status: models.SignedELC, EffectiveDate: signed},
+	} {
+		if err := server.R.AddProspectStatus(ctx, &c); err != nil {
+			t.Fatalf("Adding status failed: %v", err)
+		}
+	}
+
+	// updates only change the given values
+	uResp, err := client.UpdateProspect(ctx, &pb.UpdateProspectRequest{ProspectID: keepID, Prospect: &pb.CreateProspect{HeightInches: 72}})
+	if err != nil || uResp.Status != http.StatusOK || len(uResp.Changes) != 1 || uResp.Prospect.HeightInches != 72 || uResp.Prospect.FullName != "Jiří Kulich" {
+		t.Errorf("Updating prospect failed: %v %v", err, uResp)
+	}
+	if resp, _ := client.UpdateProspect(ctx, &pb.UpdateProspectRequest{ProspectID: wrongLeagueId, Prospect: &pb.CreateProspect{HeightInches: 72}}); resp.Status != http.StatusNotFound {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusNotFound)
+	}
+	if resp, _ := client.UpdateProspect(ctx, &pb.UpdateProspectRequest{ProspectID: keepID, Prospect: &pb.CreateProspect{Height: "tall"}}); resp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusBadRequest)
+	}
+
+	if resp, _ := client.MergeProspects(ctx, &pb.MergeProspectsRequest{KeepID: keepID, DropID: keepID}); resp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusBadRequest)
+	}
+	if resp, _ := client.MergeProspects(ctx, &pb.MergeProspectsRequest{KeepID: keepID, DropID: wrongLeagueId}); resp.Status != http.StatusNotFound {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusNotFound)
+	}
+	mResp, err := client.MergeProspects(ctx, &pb.MergeProspectsRequest{KeepID: keepID, DropID: dropID})
+	if err != nil || mResp.Status != http.StatusOK {
+		t.Fatalf("Merging prospects failed: %v %v", err, mResp)
+	}
+	kept := mResp.Prospect
+	if kept.ID != keepID || kept.FullName != "Jiří Kulich" || kept.NhlTeam != "BUF" || kept.WeightPounds != 179 || kept.HeightInches != 72 {
+		t.Errorf("Merged prospect %v not as expected", kept)
+	}
+	if kept.FranchiseID != franchise.FranchiseId || kept.Pick == nil || kept.Pick.ID != pickID {
+		t.Errorf("Merged prospect %v did not take over the pick and roster spot", kept)
+	}
+
+	// the duplicate is gone, everything points to the kept prospect
+	if resp, _ := client.GetProspectStats(ctx, &pb.GetProspectStatsRequest{ProspectID: dropID}); resp.Status != http.StatusNotFound {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusNotFound)
+	}
+	if resp, _ := client.GetProspectStats(ctx, &pb.GetProspectStatsRequest{ProspectID: keepID}); len(resp.Result) != 1 || resp.Result[0].GamesPlayed != 20 {
+		t.Errorf("Stats %v were not moved", resp.Result)
+	}
+	if resp, _ := client.GetWatchlist(ctx, &pb.GetWatchlistRequest{UserId: userId}); len(resp.Result) != 1 || resp.Result[0].ProspectID != keepID {
+		t.Errorf("Watchlist %v not as expected", resp.Result)
+	}
+	if history, err := server.R.ListProspectStatuses(ctx, keep); err != nil || len(history) != 2 || history[1].Status != models.NhlGraduated {
+		t.Errorf("Status history %+v not as expected: %v", history, err)
+	}
+	roster, _ := client.GetProspectsByFranchise(ctx, &pb.GetFranchiseRequest{FranchiseID: franchise.FranchiseId})
+	if len(roster.Prospects) != 1 || roster.Prospects[0].ID != keepID {
+		t.Errorf("Roster %v not as expected", roster.Prospects)
+	}
+	if resp, _ := client.GetDuplicateProspects(ctx, &pb.DuplicateProspectsRequest{}); len(resp.Result) != 0 {
+		t.Errorf("Duplicates %v left after merge", resp.Result)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestProspectStatus(t *testing.T) {
+	req := pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: 1, MaxProspects: maxProspects, DraftRightsGoalie: draftRightsGoalie, DraftRightsSkater: draftRightsSkater, Season: 2024}
+	lResp, err := client.CreateLeague(ctx, &req)
+	if err != nil {
+		t.Fatalf("League creation failed: %v", err)
+	}
+	league, _ := client.GetLeague(ctx, &pb.GetLeagueRequest{LeagueId: lResp.LeagueId})
+	if rules := league.Result.StatusRules; len(rules) != 2 || rules[1].Status != pb.ProspectStatus_PROSPECT_STATUS_RETIRED || rules[1].Action != pb.StatusAction_STATUS_ACTION_RELEASE {
+		t.Errorf("Default rules %v not as expected", rules)
+	}
+	franchise, err := createFranchise(lResp.LeagueId, userId, franchiseName, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	pReq := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId, Picks: []*pb.CreateOrUpdatePick{
+		{Franchise: franchiseName, FranchiseID: franchise.FranchiseId, DraftYear: 2024, LotteryPosition: 1},
+	}}
+	if resp, err := client.CreateOrUpdatePicks(ctx, &pReq); err != nil || resp.Status != http.StatusCreated {
+		t.Fatalf("Setting positions failed: %v %v", err, resp)
+	}
+	picks, _ := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: franchise.FranchiseId, DraftYear: 2024})
+
+	prospects := []*pb.CreateProspect{
+		{FullName: "Max Muster", FirstName: "Max", LastName: "Muster", PositionCode: "C"},
+		{FullName: "Moritz Muster", FirstName: "Moritz", LastName: "Muster", PositionCode: "D"},
+	}
+	bResp, err := client.CreateProspectsBulk(ctx, &pb.CreateProspectsBulkRequest{Prospects: prospects})
+	if err != nil || bResp.Status != http.StatusCreated || len(bResp.ProspectIds) != 2 {
+		t.Fatalf("Create prospects failed: %v %v", err, bResp)
+	}
+	maxID, moritzID := bResp.ProspectIds[0], bResp.ProspectIds[1]
+	for i, id := range []string{maxID, moritzID} {
+		if resp, err := client.DraftProspect(ctx, &pb.DraftRequest{LeagueID: lResp.LeagueId, FranchiseID: franchise.FranchiseId, ProspectID: id, PickID: picks.Picks[i].ID, UserId: userId}); err != nil || resp.Status != http.StatusOK {
+			t.Fatalf("Draft prospect failed: %v %v", err, resp)
+		}
+	}
+
+	// new prospects are unsigned, signing keeps them on the roster
+	sResp, err := client.SetProspectStatus(ctx, &pb.ProspectStatusRequest{ProspectID: maxID, Status: pb.ProspectStatus_PROSPECT_STATUS_SIGNED_ELC, EffectiveDate: timestamppb.New(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC))})
+	if err != nil || sResp.Status != http.StatusOK || len(sResp.Changes) != 1 {
+		t.Fatalf("Setting status failed: %v %v", err, sResp)
+	}
+	if p := sResp.Prospect; p.Status != pb.ProspectStatus_PROSPECT_STATUS_SIGNED_ELC || p.StatusSince.AsTime().Format("2006-01-02") != "2024-07-01" || p.FranchiseID != franchise.FranchiseId {
+		t.Errorf("Prospect %v not as expected", p)
+	}
+
+	// the league releases retired prospects
+	sResp, err = client.SetProspectStatus(ctx, &pb.ProspectStatusRequest{ProspectID: moritzID, Status: pb.ProspectStatus_PROSPECT_STATUS_RETIRED})
+	if err != nil || sResp.Status != http.StatusOK || sResp.Prospect.FranchiseID != "" || sResp.Prospect.Status != pb.ProspectStatus_PROSPECT_STATUS_RETIRED {
+		t.Fatalf("Retiring prospect failed: %v %v", err, sResp)
+	}
+	roster, _ := client.GetProspectsByFranchise(ctx, &pb.GetFranchiseRequest{FranchiseID: franchise.FranchiseId})
+	if len(roster.Prospects) != 1 || roster.Prospects[0].ID != maxID || roster.Prospects[0].Status != pb.ProspectStatus_PROSPECT_STATUS_SIGNED_ELC {
+		t.Errorf("Roster %v not as expected", roster.Prospects)
+	}
+
+	// graduates stay on the roster unless the league releases them
+	update := proto.Clone(&req).(*pb.LeagueRequest)
+	update.StatusRules = []*pb.StatusRule{
+		{Status: pb.ProspectStatus_PROSPECT_STATUS_NHL_GRADUATED, Action: pb.StatusAction_STATUS_ACTION_RELEASE},
+		{Status: pb.ProspectStatus_PROSPECT_STATUS_RIGHTS_EXPIRED, Action: pb.StatusAction_STATUS_ACTION_KEEP},
+	}
+	if resp, err := client.UpdateLeague(ctx, &pb.LeagueUpdateRequest{Id: lResp.LeagueId, League: update, UserId: userId}); err != nil || resp.Status != http.StatusCreated {
+		t.Fatalf("Updating league failed: %v %v", err, resp)
+	}
+	league, _ = client.GetLeague(ctx, &pb.GetLeagueRequest{LeagueId: lResp.LeagueId})
+	if rules := league.Result.StatusRules; len(rules) != 2 || rules[0].Status != pb.ProspectStatus_PROSPECT_STATUS_NHL_GRADUATED {
+		t.Errorf("Updated rules %v not as expected", rules)
+	}
+	if resp, _ := client.SetProspectStatus(ctx, &pb.ProspectStatusRequest{ProspectID: maxID, Status: pb.ProspectStatus_PROSPECT_STATUS_NHL_GRADUATED}); resp.Prospect.FranchiseID != "" {
+		t.Errorf("Graduated prospect %v was not released", resp.Prospect)
+	}
+
+	hResp, err := client.GetProspectStatusHistory(ctx, &pb.ProspectStatusHistoryRequest{ProspectID: maxID})
+	if err != nil || hResp.Status != http.StatusOK || len(hResp.Result) != 2 || hResp.Result[0].Status != pb.ProspectStatus_PROSPECT_STATUS_SIGNED_ELC {
+		t.Errorf("Status history not as expected: %v %v", err, hResp)
+	}
+
+	if resp, _ := client.SetProspectStatus(ctx, &pb.ProspectStatusRequest{ProspectID: maxID}); resp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusBadRequest)
+	}
+	if resp, _ := client.SetProspectStatus(ctx, &pb.ProspectStatusRequest{ProspectID: maxID, Status: pb.ProspectStatus_PROSPECT_STATUS_AHL, EffectiveDate: timestamppb.New(time.Now().AddDate(0, 1, 0))}); resp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusBadRequest)
+	}
+	if resp, _ := client.SetProspectStatus(ctx, &pb.ProspectStatusRequest{ProspectID: wrongLeagueId, Status: pb.ProspectStatus_PROSPECT_STATUS_AHL}); resp.Status != http.StatusNotFound {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusNotFound)
+	}
+	if resp, _ := client.GetProspectStatusHistory(ctx, &pb.ProspectStatusHistoryRequest{ProspectID: wrongLeagueId}); resp.Status != http.StatusNotFound {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusNotFound)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestDraftBoard(t *testing.T) {
+	req := pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: 2, MaxProspects: maxProspects, DraftRightsGoalie: draftRightsGoalie, DraftRightsSkater: draftRightsSkater, Season: 2024}
+	lResp, err := client.CreateLeague(ctx, &req)
+	if err != nil {
+		t.Fatalf("League creation failed: %v", err)
+	}
+	first, err := createFranchise(lResp.LeagueId, userId, franchiseName, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	second, err := createFranchise(lResp.LeagueId, userId2, franchiseName2, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+
+	prospects := []*pb.CreateProspect{
+		{FullName: "Max Muster", FirstName: "Max", LastName: "Muster", PositionCode: "C"},
+		{FullName: "Moritz Muster", FirstName: "Moritz", LastName: "Muster", PositionCode: "D"},
+		{FullName: "Mia Muster", FirstName: "Mia", LastName: "Muster", PositionCode: "G"},
+		{FullName: "Mara Muster", FirstName: "Mara", LastName: "Muster", PositionCode: "L"},
+	}
+	bResp, err := client.CreateProspectsBulk(ctx, &pb.CreateProspectsBulkRequest{Prospects: prospects})
+	if err != nil || bResp.Status != http.StatusCreated || len(bResp.ProspectIds) != 4 {
+		t.Fatalf("Create prospects failed: %v %v", err, bResp)
+	}
+	max, moritz, mia, mara := bResp.ProspectIds[0], bResp.ProspectIds[1], bResp.ProspectIds[2], bResp.ProspectIds[3]
+
+	// an imported ranking and the owners' rankings
+	csv := "rank,name\n1,Moritz Muster\n2,Max Muster\n3,Mia Muster\n4,Mara Muster\n"
+	records, _ := importer.Read(strings.NewReader(csv), importer.CSV, importer.RankingFields, nil)
+	if report, err := importer.ImportRankings(ctx, server.R, records, "Scouting", 2024, false); err != nil || len(report.Errors) != 0 {
+		t.Fatalf("Importing ranking failed: %v %+v", err, report)
+	}
+	submissions := []*pb.SubmitRankingRequest{
+		{FranchiseID: first.FranchiseId, UserId: userId, ProspectIds: []string{max, moritz, mia}},
+		{FranchiseID: second.FranchiseId, UserId: userId2, Year: 2024, ProspectIds: []string{max, mia, moritz}},
+	}
+	for _, s := range submissions {
+		if resp, err := client.SubmitRanking(ctx, s); err != nil || resp.Status != http.StatusOK {
+			t.Fatalf("Submitting ranking failed: %v %v", err, resp)
+		}
+	}
+	if resp, _ := client.SubmitRanking(ctx, &pb.SubmitRankingRequest{FranchiseID: first.FranchiseId, UserId: userId2, ProspectIds: []string{max}}); resp.Status != http.StatusForbidden {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusForbidden)
+	}
+	if resp, _ := client.SubmitRanking(ctx, &pb.SubmitRankingRequest{FranchiseID: first.FranchiseId, UserId: userId, ProspectIds: []string{max, max}}); resp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusBadRequest)
+	}
+	if resp, _ := client.SubmitRanking(ctx, &pb.SubmitRankingRequest{FranchiseID: first.FranchiseId, UserId: userId, ProspectIds: []string{wrongLeagueId}}); resp.Status != http.StatusNotFound {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusNotFound)
+	}
+
+	rResp, err := client.GetRankings(ctx, &pb.GetRankingsRequest{Year: 2024})
+	if err != nil || rResp.Status != http.StatusOK || len(rResp.Result) != 3 {
+		t.Fatalf("Getting rankings failed: %v %v", err, rResp)
+	}
+	if resp, _ := client.GetRankings(ctx, &pb.GetRankingsRequest{Year: 2024, FranchiseID: second.FranchiseId}); len(resp.Result) != 1 || resp.Result[0].FranchiseName != franchiseName2 || resp.Result[0].Prospects[1].Prospect.ID != mia {
+		t.Errorf("Ranking of the second franchise %v not as expected", resp.Result)
+	}
+
+	// max averages 1.33, moritz 2, mia 2.67, mara is only ranked 4th
+	board, err := client.GetDraftBoard(ctx, &pb.DraftBoardRequest{LeagueID: lResp.LeagueId})
+	if err != nil || board.Status != http.StatusOK || board.Rankings != 3 || len(board.Result) != 4 {
+		t.Fatalf("Getting the draft board failed: %v %v", err, board)
+	}
+	order := []string{}
+	for _, e := range board.Result {
+		order = append(order, e.Prospect.FirstName)
+	}
+	if strings.Join(order, ",") != "Max,Moritz,Mia,Mara" || board.Result[0].Score != 1.3 || board.Result[0].BestRank != 1 || board.Result[0].WorstRank != 2 || board.Result[3].Rankings != 1 {
+		t.Errorf("Board %v not as expected", board.Result)
+	}
+	median, _ := client.GetDraftBoard(ctx, &pb.DraftBoardRequest{LeagueID: lResp.LeagueId, Year: 2024, Method: pb.ConsensusMethod_CONSENSUS_METHOD_MEDIAN})
+	if median.Result[0].Prospect.ID != max || median.Result[0].Score != 1 || median.Result[1].Score != 2 {
+		t.Errorf("Median board %v not as expected", median.Result)
+	}
+
+	// drafted prospects leave the board
+	pReq := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId, Picks: []*pb.CreateOrUpdatePick{
+		{Franchise: franchiseName, FranchiseID: first.FranchiseId, DraftYear: 2024, LotteryPosition: 1},
+		{Franchise: franchiseName2, FranchiseID: second.FranchiseId, DraftYear: 2024, LotteryPosition: 2},
+	}}
+	if resp, err := client.CreateOrUpdatePicks(ctx, &pReq); err != nil || resp.Status != http.StatusCreated {
+		t.Fatalf("Setting positions failed: %v %v", err, resp)
+	}
+	picks, _ := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: first.FranchiseId, DraftYear: 2024})
+	if resp, err := client.DraftProspect(ctx, &pb.DraftRequest{LeagueID: lResp.LeagueId, FranchiseID: first.FranchiseId, ProspectID: max, PickID: picks.Picks[0].ID, UserId: userId}); err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Draft prospect failed: %v %v", err, resp)
+	}
+	board, _ = client.GetDraftBoard(ctx, &pb.DraftBoardRequest{LeagueID: lResp.LeagueId})
+	if len(board.Result) != 3 || board.Result[0].Prospect.ID != moritz || board.Result[0].Rank != 1 {
+		t.Errorf("Board %v after the draft not as expected", board.Result)
+	}
+
+	// withdrawn rankings no longer count
+	if resp, err := client.SubmitRanking(ctx, &pb.SubmitRankingRequest{FranchiseID: second.FranchiseId, UserId: userId2}); err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Withdrawing ranking failed: %v %v", err, resp)
+	}
+	if board, _ := client.GetDraftBoard(ctx, &pb.DraftBoardRequest{LeagueID: lResp.LeagueId}); len(board.Result) != 3 || board.Rankings != 2 || board.Result[1].Prospect.ID != mia || board.Result[2].Prospect.ID != mara {
+		t.Errorf("Board %v after the withdrawal not as expected", board)
+	}
+	if resp, _ := client.GetDraftBoard(ctx, &pb.DraftBoardRequest{LeagueID: wrongLeagueId}); resp.Status != http.StatusNotFound {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusNotFound)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestEligibleProspects(t *testing.T) {
+	rules := &pb.EligibilityRules{
+		DraftYears: &pb.DraftYearOffsets{Min: -1, Max: 0},
+		MaxAge:     19,
+		Positions:  []pb.Position{pb.Position_POSITION_CENTER, pb.Position_POSITION_DEFENSEMAN},
+	}
+	req := pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: maxFranchises, MaxProspects: maxProspects, DraftRightsGoalie: draftRightsGoalie, DraftRightsSkater: draftRightsSkater, Season: 2024, Eligibility: rules}
+	lResp, err := client.CreateLeague(ctx, &req)
+	if err != nil || lResp.Status != http.StatusCreated {
+		t.Fatalf("League creation failed: %v %v", err, lResp)
+	}
+	league, _ := client.GetLeague(ctx, &pb.GetLeagueRequest{LeagueId: lResp.LeagueId})
+	if e := league.Result.Eligibility; e.DraftYears.Min != -1 || e.MaxAge != 19 || len(e.Positions) != 2 {
+		t.Errorf("Eligibility %v not as expected", e)
+	}
+	fResp, err := createFranchise(lResp.LeagueId, userId, franchiseName, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+
+	prospects := []*pb.CreateProspect{
+		{FullName: "Max Muster", FirstName: "Max", LastName: "Muster", PositionCode: "C", DraftYear: "2024", Birthdate: "2006-01-01"},
+		{FullName: "Moritz Muster", FirstName: "Moritz", LastName: "Muster", PositionCode: "D", DraftYear: "2023"},
+		{FullName: "Mia Muster", FirstName: "Mia", LastName: "Muster", PositionCode: "G", DraftYear: "2024"},
+		{FullName: "Mara Muster", FirstName: "Mara", LastName: "Muster", PositionCode: "C", DraftYear: "2022"},
+		{FullName: "Mats Muster", FirstName: "Mats", LastName: "Muster", PositionCode: "D", DraftYear: "2024", Birthdate: "2004-09-15"},
+	}
+	bResp, err := client.CreateProspectsBulk(ctx, &pb.CreateProspectsBulkRequest{Prospects: prospects})
+	if err != nil || bResp.Status != http.StatusCreated {
+		t.Fatalf("Create prospects failed: %v %v", err, bResp)
+	}
+	max, mia := bResp.ProspectIds[0], bResp.ProspectIds[2]
+
+	names := func(resp *pb.ProspectsResponse) string {
+		result := []string{}
+		for _, p := range resp.Prospects {
+			result = append(result, p.FirstName)
+		}
+		sort.Strings(result)
+		return strings.Join(result, ",")
+	}
+	eResp, err := client.ListEligibleProspects(ctx, &pb.EligibleProspectsRequest{LeagueID: lResp.LeagueId})
+	if err != nil || eResp.Status != http.StatusOK || names(eResp) != "Max,Moritz" {
+		t.Fatalf("Eligible prospects %v not as expected: %v", eResp, err)
+	}
+	// a year later the 2023 class is no longer eligible
+	if eResp, _ := client.ListEligibleProspects(ctx, &pb.EligibleProspectsRequest{LeagueID: lResp.LeagueId, Year: 2025}); names(eResp) != "Max" {
+		t.Errorf("Eligible prospects of 2025 %v not as expected", eResp.Prospects)
+	}
+
+	pReq := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId, Picks: []*pb.CreateOrUpdatePick{
+		{Franchise: franchiseName, FranchiseID: fResp.FranchiseId, DraftYear: 2024, LotteryPosition: 1},
+	}}
+	if resp, err := client.CreateOrUpdatePicks(ctx, &pReq); err != nil || resp.Status != http.StatusCreated {
+		t.Fatalf("Setting positions failed: %v %v", err, resp)
+	}
+	picks, _ := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: fResp.FranchiseId, DraftYear: 2024})
+	draft := pb.DraftRequest{LeagueID: lResp.LeagueId, FranchiseID: fResp.FranchiseId, ProspectID: mia, PickID: picks.Picks[0].ID, UserId: userId}
+	if resp, _ := client.DraftProspect(ctx, &draft); resp.Status != http.StatusConflict || !strings.Contains(resp.Error, "not eligible") {
+		t.Errorf("Drafting an ineligible prospect returned %v", resp)
+	}
+	draft.ProspectID = max
+	if resp, err := client.DraftProspect(ctx, &draft); err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Draft prospect failed: %v %v", err, resp)
+	}
+	if eResp, _ := client.ListEligibleProspects(ctx, &pb.EligibleProspectsRequest{LeagueID: lResp.LeagueId}); names(eResp) != "Moritz" {
+		t.Errorf("Eligible prospects %v after the draft not as expected", eResp.Prospects)
+	}
+
+	// updates replace the rules
+	update := proto.Clone(&req).(*pb.LeagueRequest)
+	update.Eligibility = &pb.EligibilityRules{Positions: []pb.Position{pb.Position_POSITION_GOALIE}}
+	if resp, err := client.UpdateLeague(ctx, &pb.LeagueUpdateRequest{Id: lResp.LeagueId, League: update, UserId: userId}); err != nil || resp.Status != http.StatusCreated {
+		t.Fatalf("Updating league failed: %v %v", err, resp)
+	}
+	if eResp, _ := client.ListEligibleProspects(ctx, &pb.EligibleProspectsRequest{LeagueID: lResp.LeagueId}); names(eResp) != "Mia" {
+		t.Errorf("Eligible prospects %v after the update not as expected", eResp.Prospects)
+	}
+	update.Eligibility = &pb.EligibilityRules{DraftYears: &pb.DraftYearOffsets{Min: 0, Max: -1}}
+	if resp, _ := client.UpdateLeague(ctx, &pb.LeagueUpdateRequest{Id: lResp.LeagueId, League: update, UserId: userId}); resp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusBadRequest)
+	}
+	if resp, _ := client.ListEligibleProspects(ctx, &pb.EligibleProspectsRequest{LeagueID: wrongLeagueId}); resp.Status != http.StatusNotFound {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusNotFound)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestFranchiseRoster(t *testing.T) {
+	req := pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: 2, MaxProspects: maxProspects, DraftRightsGoalie: draftRightsGoalie, DraftRightsSkater: draftRightsSkater, Season: 2024}
+	lResp, err := client.CreateLeague(ctx, &req)
+	if err != nil || lResp.Status != http.StatusCreated {
+		t.Fatalf("League creation failed: %v %v", err, lResp)
+	}
+	first, err := createFranchise(lResp.LeagueId, userId, franchiseName, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	second, err := createFranchise(lResp.LeagueId, userId2, franchiseName2, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+
+	prospects := []*pb.CreateProspect{
+		{FullName: "Max Muster", FirstName: "Max", LastName: "Muster", PositionCode: "C"},
+		{FullName: "Mia Muster", FirstName: "Mia", LastName: "Muster", PositionCode: "G"},
+		{FullName: "Moritz Muster", FirstName: "Moritz", LastName: "Muster", PositionCode: "D"},
+	}
+	bResp, err := client.CreateProspectsBulk(ctx, &pb.CreateProspectsBulkRequest{Prospects: prospects})
+	if err != nil || bResp.Status != http.StatusCreated {
+		t.Fatalf("Create prospects failed: %v %v", err, bResp)
+	}
+	pReq := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId, Picks: []*pb.CreateOrUpdatePick{
+		{Franchise: franchiseName, FranchiseID: first.FranchiseId, DraftYear: 2024, LotteryPosition: 1},
+		{Franchise: franchiseName2, FranchiseID: second.FranchiseId, DraftYear: 2024, LotteryPosition: 2},
+	}}
+	if resp, err := client.CreateOrUpdatePicks(ctx, &pReq); err != nil || resp.Status != http.StatusCreated {
+		t.Fatalf("Setting positions failed: %v %v", err, resp)
+	}
+	draft := func(franchiseID string, prospectID string, pick int) {
+		picks, _ := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: franchiseID, DraftYear: 2024, OrderBy: "draftRound"})
+		resp, err := client.DraftProspect(ctx, &pb.DraftRequest{LeagueID: lResp.LeagueId, FranchiseID: franchiseID, ProspectID: prospectID, PickID: picks.Picks[pick].ID, UserId: userId})
+		if err != nil || resp.Status != http.StatusOK {
+			t.Fatalf("Draft prospect failed: %v %v", err, resp)
+		}
+	}
+	// a franchise without prospects lists none
+	if fResp, err := client.GetFranchise(ctx, &pb.GetFranchiseRequest{FranchiseID: first.FranchiseId}); err != nil || len(fResp.Result.Prospects) != 0 {
+		t.Errorf("Prospects %v of the empty franchise not as expected: %v", fResp.Result.Prospects, err)
+	}
+	draft(first.FranchiseId, bResp.ProspectIds[1], 1)
+	draft(first.FranchiseId, bResp.ProspectIds[0], 0)
+	draft(second.FranchiseId, bResp.ProspectIds[2], 0)
+
+	// the prospects show up at their own franchise ordered by name
+	fResp, err := client.GetFranchise(ctx, &pb.GetFranchiseRequest{FranchiseID: first.FranchiseId})
+	if err != nil || len(fResp.Result.Prospects) != 2 || fResp.Result.Prospects[0].FullName != "Max Muster" || fResp.Result.Prospects[1].FullName != "Mia Muster" {
+		t.Errorf("Prospects %v of the franchise not as expected: %v", fResp.Result.Prospects, err)
+	}
+	franchises, err := client.GetLeagueFranchises(ctx, &pb.GetLeagueRequest{LeagueId: lResp.LeagueId})
+	if err != nil || len(franchises.Result) != 2 {
+		t.Fatalf("Getting franchises failed: %v %v", err, franchises)
+	}
+	for _, f := range franchises.Result {
+		expected := map[string]int{franchiseName: 2, franchiseName2: 1}[f.Name]
+		if len(f.Prospects) != expected || f.Prospects[0].FranchiseID != f.ID {
+			t.Errorf("Prospects %v of franchise %s not as expected", f.Prospects, f.Name)
+		}
+	}
+
+	rResp, err := client.GetFranchiseRoster(ctx, &pb.GetFranchiseRequest{FranchiseID: first.FranchiseId})
+	if err != nil || rResp.Status != http.StatusOK {
+		t.Fatalf("Getting the roster failed: %v %v", err, rResp)
+	}
+	roster := rResp.Result
+	if u := roster.Usage; u.Prospects != 2 || u.MaxProspects != maxProspects || u.Protected != 2 || u.Goalies != 1 || u.Skaters != 1 || u.RightsExpired != 0 {
+		t.Errorf("Roster usage %v not as expected", u)
+	}
+	if len(roster.Positions) != 5 || roster.Positions[0].Prospects != 1 || roster.Positions[3].Prospects != 0 || roster.Positions[4].Prospects != 1 {
+		t.Errorf("Positions %v not as expected", roster.Positions)
+	}
+	rights := map[string]int32{}
+	for _, p := range roster.Prospects {
+		if p.Prospect.Pick.Year != 2024 {
+			t.Errorf("Pick %v of prospect %s not as expected", p.Prospect.Pick, p.Prospect.FullName)
+		}
+		rights[p.Prospect.FirstName] = p.RightsUntil
+	}
+	if rights["Max"] != 2024+draftRightsSkater-1 || rights["Mia"] != 2024+draftRightsGoalie-1 {
+		t.Errorf("Rights %v not as expected", rights)
+	}
+	if len(roster.Picks) != 4 || roster.Picks[0].Year != 2024 || len(roster.Picks[0].Picks) != 2 || roster.Picks[0].Picks[0].Round != 1 || roster.Picks[3].Year != 2027 {
+		t.Errorf("Picks %v not as expected", roster.Picks)
+	}
+
+	// the skater's rights run out before the goalie's
+	update := proto.Clone(&req).(*pb.LeagueRequest)
+	update.Season = 2027
+	if resp, err := client.UpdateLeague(ctx, &pb.LeagueUpdateRequest{Id: lResp.LeagueId, League: update, UserId: userId}); err != nil || resp.Status != http.StatusCreated {
+		t.Fatalf("Updating league failed: %v %v", err, resp)
+	}
+	rResp, _ = client.GetFranchiseRoster(ctx, &pb.GetFranchiseRequest{FranchiseID: first.FranchiseId})
+	if rResp.Result.Usage.RightsExpired != 1 {
+		t.Errorf("Roster usage %v not as expected", rResp.Result.Usage)
+	}
+	for _, p := range rResp.Result.Prospects {
+		if p.RightsExpired != (p.Prospect.FirstName == "Max") {
+			t.Errorf("Rights of %s expired %v", p.Prospect.FirstName, p.RightsExpired)
+		}
+	}
+	if resp, _ := client.GetFranchiseRoster(ctx, &pb.GetFranchiseRequest{FranchiseID: wrongLeagueId}); resp.Status != http.StatusNotFound {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusNotFound)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+func TestStandings(t *testing.T) {
+	req := pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: maxFranchises2, MaxProspects: maxProspects, DraftRightsGoalie: draftRightsGoalie, DraftRightsSkater: draftRightsSkater, Season: 2024}
+	lResp, err := client.CreateLeague(ctx, &req)
+	if err != nil || lResp.Status != http.StatusCreated {
+		t.Fatalf("League creation failed: %v %v", err, lResp)
+	}
+	first, err := createFranchise(lResp.LeagueId, userId, franchiseName, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	second, err := createFranchise(lResp.LeagueId, userId2, franchiseName2, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+
+	// a deleted franchise leaves its picks to the orphan franchise
+	third, err := createFranchise(lResp.LeagueId, userId, franchiseName3, franchiseFoundationYear)
+	if err != nil {
+		t.Fatalf("Franchise creation failed: %v", err)
+	}
+	if resp, err := client.DeleteFranchise(ctx, &pb.DeleteFranchiseRequest{Id: third.FranchiseId, UserId: userId}); err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Delete franchise failed: %v %v", err, resp)
+	}
+	orphanID := ""
+	franchises, _ := client.GetLeagueFranchises(ctx, &pb.GetLeagueRequest{LeagueId: lResp.LeagueId})
+	for _, f := range franchises.Result {
+		if f.ID != first.FranchiseId && f.ID != second.FranchiseId {
+			orphanID = f.ID
+		}
+	}
+
+	// only the commissioner enters the standings of the league's franchises
+	sReq := pb.StandingsRequest{LeagueID: lResp.LeagueId, UserId: userId, Standings: []*pb.Standing{
+		{FranchiseID: first.FranchiseId, Wins: 10, Losses: 8, Points: 20},
+		{FranchiseID: second.FranchiseId, Wins: 15, Losses: 3, Points: 30},
+	}}
+	if resp, err := client.SetStandings(ctx, &sReq); err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Setting standings failed: %v %v", err, resp)
+	}
+	for status, r := range map[int64]*pb.StandingsRequest{
+		http.StatusForbidden:  {LeagueID: lResp.LeagueId, UserId: userId2, Standings: sReq.Standings},
+		http.StatusNotFound:   {LeagueID: lResp.LeagueId, UserId: userId, Standings: []*pb.Standing{{FranchiseID: wrongLeagueId}}},
+		http.StatusBadRequest: {LeagueID: lResp.LeagueId, UserId: userId, Standings: []*pb.Standing{{FranchiseID: first.FranchiseId, Rank: 1}, {FranchiseID: second.FranchiseId}}},
+		http.StatusConflict:   {LeagueID: lResp.LeagueId, UserId: userId, Standings: []*pb.Standing{{FranchiseID: orphanID, Points: 50}}},
+	} {
+		if resp, _ := client.SetStandings(ctx, r); resp.Status != status {
+			t.Errorf("Http Status %d not equal to expected status %d: %s", resp.Status, status, resp.Error)
+		}
+	}
+
+	gResp, err := client.GetStandings(ctx, &pb.GetStandingsRequest{LeagueID: lResp.LeagueId})
+	if err != nil || gResp.Status != http.StatusOK || gResp.Year != 2024 || len(gResp.Result) != 2 {
+		t.Fatalf("Getting standings failed: %v %v", err, gResp)
+	}
+	if s := gResp.Result[0]; s.FranchiseID != second.FranchiseId || s.FranchiseName != franchiseName2 || s.Rank != 1 || s.Points != 30 {
+		t.Errorf("Standing %v not as expected", s)
+	}
+	if resp, _ := client.GetStandings(ctx, &pb.GetStandingsRequest{LeagueID: lResp.LeagueId, Year: 2023}); resp.Status != http.StatusOK || len(resp.Result) != 0 {
+		t.Errorf("Standings %v of another year not empty", resp)
+	}
+	if resp, _ := client.GetStandings(ctx, &pb.GetStandingsRequest{LeagueID: wrongLeagueId}); resp.Status != http.StatusNotFound {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusNotFound)
+	}
+	if resp, _ := client.GetStandings(ctx, &pb.GetStandingsRequest{LeagueID: "no-uuid"}); resp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusBadRequest)
+	}
+	if resp, _ := client.GetPowerRankings(ctx, &pb.PowerRankingsRequest{LeagueID: "no-uuid"}); resp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusBadRequest)
+	}
+
+	// the first franchise drafts the better prospect
+	prospects := []*pb.CreateProspect{{FullName: "Max Muster", FirstName: "Max", LastName: "Muster", PositionCode: "C", NhlDraftPickOverall: "1"}}
+	bResp, err := client.CreateProspectsBulk(ctx, &pb.CreateProspectsBulkRequest{Prospects: prospects})
+	if err != nil || bResp.Status != http.StatusCreated {
+		t.Fatalf("Create prospects failed: %v %v", err, bResp)
+	}
+	pReq := pb.CreateOrUpdatePicksRequest{LeagueID: lResp.LeagueId, UserId: userId, Picks: []*pb.CreateOrUpdatePick{
+		{Franchise: franchiseName, FranchiseID: first.FranchiseId, DraftYear: 2024, LotteryPosition: 1},
+		{Franchise: franchiseName2, FranchiseID: second.FranchiseId, DraftYear: 2024, LotteryPosition: 2},
+	}}
+	if resp, err := client.CreateOrUpdatePicks(ctx, &pReq); err != nil || resp.Status != http.StatusCreated {
+		t.Fatalf("Setting positions failed: %v %v", err, resp)
+	}
+	picks, _ := client.GetPicksByFranchise(ctx, &pb.GetPicksRequest{FranchiseID: first.FranchiseId, DraftYear: 2024, OrderBy: "draftRound"})
+	if resp, err := client.DraftProspect(ctx, &pb.DraftRequest{LeagueID: lResp.LeagueId, FranchiseID: first.FranchiseId, ProspectID: bResp.ProspectIds[0], PickID: picks.Picks[0].ID, UserId: userId}); err != nil || resp.Status != http.StatusOK {
+		t.Fatalf("Draft prospect failed: %v %v", err, resp)
+	}
+
+	pResp, err := client.GetPowerRankings(ctx, &pb.PowerRankingsRequest{LeagueID: lResp.LeagueId})
+	// the orphan franchise isn't ranked
+	if err != nil || pResp.Status != http.StatusOK || len(pResp.Result) != 2 {
+		t.Fatalf("Getting power rankings failed: %v %v", err, pResp)
+	}
+	top := pResp.Result[0]
+	if top.FranchiseID != first.FranchiseId || top.Rank != 1 || top.StandingsRank != 2 || top.StandingsScore != 66.7 || top.PipelineScore != 100 || top.Score != 83.3 {
+		t.Errorf("Power ranking %v not as expected", top)
+	}
+	// weighing the standings only follows them
+	pResp, _ = client.GetPowerRankings(ctx, &pb.PowerRankingsRequest{LeagueID: lResp.LeagueId, StandingsWeight: 1})
+	if pResp.Result[0].FranchiseID != second.FranchiseId || pResp.Result[0].Score != 100 {
+		t.Errorf("Power rankings %v not as expected", pResp.Result)
+	}
+	if resp, _ := client.GetPowerRankings(ctx, &pb.PowerRankingsRequest{LeagueID: lResp.LeagueId, StandingsWeight: 1.5}); resp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", resp.Status, http.StatusBadRequest)
+	}
+
+	// imported standings replace the entered ones
+	csv := fmt.Sprintf("franchise,w,l,pts\n%s,20,2,40\n%s,5,17,10\n", franchiseName, franchiseName2)
+	records, _ := importer.Read(strings.NewReader(csv), importer.CSV, importer.StandingFields, nil)
+	lId, _ := uuid.Parse(lResp.LeagueId)
+	if report, err := importer.ImportStandings(ctx, server.R, records, lId, 2024, false); err != nil || len(report.IDs(importer.Update)) != 2 {
+		t.Fatalf("Importing standings failed: %v %v", err, report)
+	}
+	gResp, _ = client.GetStandings(ctx, &pb.GetStandingsRequest{LeagueID: lResp.LeagueId})
+	if gResp.Result[0].FranchiseID != first.FranchiseId || gResp.Result[0].Points != 40 {
+		t.Errorf("Standings %v not as expected", gResp.Result)
+	}
+
+	// Clean up
+	cleanUp()
+}
+
+// Compatibility
+
+func TestLegacyAndTypedFields(t *testing.T) {
+	// Create league with the typed foundation year
+	req := pb.LeagueRequest{Admin: userName, AdminID: userId, Commissioner: userName, CommissionerID: userId, Name: leagueName, Founded: 2021, MaxFranchises: maxFranchises, MaxProspects: maxProspects, DraftRightsGoalie: draftRightsGoalie, DraftRightsSkater: draftRightsSkater}
+	lResp, err := client.CreateLeague(ctx, &req)
+	if err != nil {
+		t.Fatalf("League creation failed: %v", err)
+	}
+
+	getLeagueResp, err := client.GetLeague(ctx, &pb.GetLeagueRequest{LeagueId: lResp.LeagueId})
+	if err != nil {
+		t.Fatalf("Get league failed: %v", err)
+	}
+	if getLeagueResp.Result.Founded != 2021 || getLeagueResp.Result.FoundationYear != "2021" {
+		t.Errorf("Foundation year %d / %q not equal to expected 2021", getLeagueResp.Result.Founded, getLeagueResp.Result.FoundationYear)
+	}
+
+	// Create prospect with the legacy string fields
+	prospect := pb.CreateProspect{FullName: "Max Muster", FirstName: "Max", LastName: "Muster", NhlTeam: "EDM", Birthdate: "2004-05-12", Height: `6' 1"`, Weight: "190", DraftYear: "2022", NhlDraftRound: "1", NhlDraftPickOverall: "3", NhlDraftPickInRound: "3", PositionCode: "C"}
+	bulkResp, err := client.CreateProspectsBulk(ctx, &pb.CreateProspectsBulkRequest{Prospects: []*pb.CreateProspect{&prospect}})
+	if err != nil {
+		t.Fatalf("Create prospects failed: %v", err)
+	}
+	if bulkResp.Status != http.StatusCreated {
+		t.Fatalf("Http Status %d not equal to expected status %d: %s", bulkResp.Status, http.StatusCreated, bulkResp.Error)
+	}
+
+	searchResp, err := client.TextSearchProspects(ctx, &pb.TextSearchRequest{Text: "Muster", Filter: &pb.ProspectFilter{Position: pb.Position_POSITION_CENTER}})
+	if err != nil {
+		t.Fatalf("Search prospects failed: %v", err)
+	}
+	if len(searchResp.Prospects) != 1 {
+		t.Fatalf("Found %d prospects, expected %d", len(searchResp.Prospects), 1)
+	}
+	p := searchResp.Prospects[0]
+	if p.HeightInches != 73 || p.Height != `6' 1"` {
+		t.Errorf("Height %d / %q not equal to expected 73", p.HeightInches, p.Height)
+	}
+	if p.DateOfBirth.AsTime().Format("2006-01-02") != "2004-05-12" || p.Birthdate != "2004-05-12" {
+		t.Errorf("Birthdate %v / %q not equal to expected 2004-05-12", p.DateOfBirth.AsTime(), p.Birthdate)
+	}
+	if p.NhlDraft.Year != 2022 || p.NhlDraft.PickOverall != 3 || p.NhlDraftYear != "2022" {
+		t.Errorf("Nhl draft %+v / %q not equal to expected 2022, pick 3", p.NhlDraft, p.NhlDraftYear)
+	}
+	if p.Position != pb.Position_POSITION_CENTER || p.IsProtected || p.Protected != "no" {
+		t.Errorf("Position %v, protected %v / %q not as expected", p.Position, p.IsProtected, p.Protected)
+	}
+
+	// Invalid legacy values are rejected
+	invalid := pb.CreateProspect{FullName: "John Doe", Height: "tall"}
+	invalidResp, err := client.CreateProspectsBulk(ctx, &pb.CreateProspectsBulkRequest{Prospects: []*pb.CreateProspect{&invalid}})
+	if err != nil {
+		t.Fatalf("Create prospects failed: %v", err)
+	}
+	if invalidResp.Status != http.StatusBadRequest {
+		t.Errorf("Http Status %d not equal to expected status %d", invalidResp.Status, http.StatusBadRequest)
+	}
+
+	// Clean up
+	cleanUp()
 }
 
 /*